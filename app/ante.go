@@ -27,6 +27,7 @@ import (
 	feesharekeeper "github.com/CosmosContracts/juno/v23/x/feeshare/keeper"
 	globalfeeante "github.com/CosmosContracts/juno/v23/x/globalfee/ante"
 	globalfeekeeper "github.com/CosmosContracts/juno/v23/x/globalfee/keeper"
+	msgfilterkeeper "github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
 )
 
 // Lower back to 1 mil after https://github.com/cosmos/relayer/issues/1255
@@ -50,6 +51,9 @@ type HandlerOptions struct {
 
 	GlobalFeeKeeper globalfeekeeper.Keeper
 	StakingKeeper   stakingkeeper.Keeper
+	MsgFilterKeeper msgfilterkeeper.Keeper
+
+	RateLimitConfig decorators.RateLimitConfig
 
 	BuilderKeeper builderkeeper.Keeper
 	TxEncoder     sdk.TxEncoder
@@ -95,9 +99,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
 		wasmkeeper.NewCountTXDecorator(options.TxCounterStoreKey),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
-		decorators.MsgFilterDecorator{},
+		decorators.NewMsgFilterDecorator(options.MsgFilterKeeper),
 		ante.NewValidateBasicDecorator(),
-		decorators.NewChangeRateDecorator(&options.StakingKeeper),
+		decorators.NewChangeRateDecorator(options.MsgFilterKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
@@ -112,6 +116,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// RateLimitDecorator must be called after signature verification so a
+		// signer can not be limited by txs it did not sign
+		decorators.NewRateLimitDecorator(options.RateLimitConfig),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 		builderante.NewBuilderDecorator(options.BuilderKeeper, options.TxEncoder, options.Mempool),
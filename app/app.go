@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"os"
 	"reflect"
-	"strconv"
 	"strings"
 
 	wasm "github.com/CosmWasm/wasmd/x/wasm"
@@ -64,6 +63,8 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
+	"github.com/CosmosContracts/juno/v23/app/feeroute"
 	"github.com/CosmosContracts/juno/v23/app/keepers"
 	"github.com/CosmosContracts/juno/v23/app/openapiconsole"
 	upgrades "github.com/CosmosContracts/juno/v23/app/upgrades"
@@ -88,6 +89,7 @@ import (
 	v22 "github.com/CosmosContracts/juno/v23/app/upgrades/v22"
 	v23 "github.com/CosmosContracts/juno/v23/app/upgrades/v23"
 	"github.com/CosmosContracts/juno/v23/docs"
+	globalfeeante "github.com/CosmosContracts/juno/v23/x/globalfee/ante"
 )
 
 const (
@@ -192,8 +194,6 @@ func GetWasmOpts(appOpts servertypes.AppOptions) []wasmkeeper.Option {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
 	}
 
-	wasmOpts = append(wasmOpts, wasmkeeper.WithGasRegister(NewJunoWasmGasRegister()))
-
 	return wasmOpts
 }
 
@@ -240,6 +240,9 @@ type App struct {
 
 	// custom checkTx handler
 	checkTxHandler pobabci.CheckTx
+
+	// options of the ante handler, also used by the node services
+	anteOptions HandlerOptions
 }
 
 // New returns a reference to an initialized Juno.
@@ -292,12 +295,6 @@ func New(
 		os.Exit(1)
 	}
 
-	if maxSize := os.Getenv("MAX_WASM_SIZE"); maxSize != "" {
-		// https://github.com/CosmWasm/wasmd#compile-time-parameters
-		val, _ := strconv.ParseInt(maxSize, 10, 32)
-		wasmtypes.MaxWasmSize = int(val)
-	}
-
 	// upgrade handlers
 	app.configurator = module.NewConfigurator(appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 
@@ -349,6 +346,22 @@ func New(
 		panic("error while reading wasm config: " + err.Error())
 	}
 
+	rateLimitConfig, err := decorators.ReadRateLimitConfig(appOpts)
+	if err != nil {
+		panic("error while reading rate limit config: " + err.Error())
+	}
+
+	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
+		if err := decorators.RegisterRateLimitMetrics(prometheus.DefaultRegisterer); err != nil {
+			panic(err)
+		}
+	}
+
+	// The auction mempool orders non-bid txs by the priority the ante handler
+	// sets on the context, which is their gas price normalized to the bond
+	// denom with the global fee prices (see globalfeeante.GetTxPriority). The
+	// same priority is reported to CometBFT, so CheckTx and PrepareProposal
+	// agree on the ordering.
 	factory := pobmempool.NewDefaultAuctionFactory(app.txConfig.TxDecoder())
 	mempool := pobmempool.NewAuctionMempool(
 		app.txConfig.TxDecoder(),
@@ -358,35 +371,37 @@ func New(
 	)
 	app.SetMempool(mempool)
 
-	anteHandler, err := NewAnteHandler(
-		HandlerOptions{
-			HandlerOptions: ante.HandlerOptions{
-				AccountKeeper:   app.AppKeepers.AccountKeeper,
-				BankKeeper:      app.AppKeepers.BankKeeper,
-				FeegrantKeeper:  app.AppKeepers.FeeGrantKeeper,
-				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-			},
-
-			GovKeeper:         app.AppKeepers.GovKeeper,
-			IBCKeeper:         app.AppKeepers.IBCKeeper,
-			FeePayKeeper:      app.AppKeepers.FeePayKeeper,
-			FeeShareKeeper:    app.AppKeepers.FeeShareKeeper,
-			BankKeeper:        app.AppKeepers.BankKeeper,
-			TxCounterStoreKey: app.AppKeepers.GetKey(wasmtypes.StoreKey),
-			WasmConfig:        wasmConfig,
-			Cdc:               appCodec,
-
-			BypassMinFeeMsgTypes: GetDefaultBypassFeeMessages(),
-			GlobalFeeKeeper:      app.AppKeepers.GlobalFeeKeeper,
-			StakingKeeper:        *app.AppKeepers.StakingKeeper,
-
-			TxEncoder:     app.txConfig.TxEncoder(),
-			BuilderKeeper: app.AppKeepers.BuildKeeper,
-			Mempool:       mempool,
-			BondDenom:     app.GetChainBondDenom(),
+	app.anteOptions = HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AppKeepers.AccountKeeper,
+			BankKeeper:      app.AppKeepers.BankKeeper,
+			FeegrantKeeper:  app.AppKeepers.FeeGrantKeeper,
+			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
-	)
+
+		GovKeeper:         app.AppKeepers.GovKeeper,
+		IBCKeeper:         app.AppKeepers.IBCKeeper,
+		FeePayKeeper:      app.AppKeepers.FeePayKeeper,
+		FeeShareKeeper:    app.AppKeepers.FeeShareKeeper,
+		BankKeeper:        app.AppKeepers.BankKeeper,
+		TxCounterStoreKey: app.AppKeepers.GetKey(wasmtypes.StoreKey),
+		WasmConfig:        wasmConfig,
+		Cdc:               appCodec,
+
+		BypassMinFeeMsgTypes: GetDefaultBypassFeeMessages(),
+		GlobalFeeKeeper:      app.AppKeepers.GlobalFeeKeeper,
+		StakingKeeper:        *app.AppKeepers.StakingKeeper,
+		MsgFilterKeeper:      app.AppKeepers.MsgFilterKeeper,
+		RateLimitConfig:      rateLimitConfig,
+
+		TxEncoder:     app.txConfig.TxEncoder(),
+		BuilderKeeper: app.AppKeepers.BuildKeeper,
+		Mempool:       mempool,
+		BondDenom:     app.GetChainBondDenom(),
+	}
+
+	anteHandler, err := NewAnteHandler(app.anteOptions)
 	if err != nil {
 		panic(err)
 	}
@@ -465,6 +480,10 @@ func New(
 			tmos.Exit(fmt.Sprintf("app.AppKeepers.WasmKeeper failed initialize pinned codes %s", err))
 		}
 
+		// The wasm gas costs and size limits of the latest state apply to CheckTx
+		// until the next block applies them again
+		app.AppKeepers.WasmConfigKeeper.ApplyParams(ctx)
+
 		// Initialize and seal the capability keeper so all persistent capabilities
 		// are loaded in-memory and prevent any further modules from creating scoped
 		// sub-keepers.
@@ -610,6 +629,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register fee route gRPC service for grpc-gateway.
+	feeroute.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -635,6 +657,18 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *App) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+
+	feeDecorator := globalfeeante.NewFeeDecorator(
+		app.anteOptions.BypassMinFeeMsgTypes,
+		app.anteOptions.GlobalFeeKeeper,
+		app.anteOptions.StakingKeeper,
+		maxBypassMinFeeMsgGasUsage,
+		new(bool),
+	)
+	newAnteHandler := func() (sdk.AnteHandler, error) {
+		return NewAnteHandler(app.anteOptions)
+	}
+	feeroute.RegisterService(app.GRPCQueryRouter(), feeroute.NewQueryServer(app.txConfig.TxDecoder(), newAnteHandler, app.GetConsensusParams, feeDecorator))
 }
 
 // configure store loader that checks if version == upgradeHeight and applies store upgrades
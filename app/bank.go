@@ -0,0 +1,64 @@
+package app
+
+import (
+	"github.com/cosmos/gogoproto/grpc"
+	googlegrpc "google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v23/x/tokenfactory/keeper"
+)
+
+// bankModule is the x/bank module with its Msg service served by the keeper
+// calling the tokenfactory before send hooks. The x/bank module itself must be
+// given a bankkeeper.BaseKeeper, as it is required to register its migrations.
+type bankModule struct {
+	bank.AppModule
+
+	msgServer banktypes.MsgServer
+}
+
+// newBankModule returns the x/bank module. When keeper calls the tokenfactory
+// before send hooks, MsgSend and MsgMultiSend go through the hooks as well.
+func newBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, accountKeeper banktypes.AccountKeeper, ss bankexported.Subspace) module.AppModule {
+	hookedKeeper, ok := keeper.(tokenfactorykeeper.HookedBankKeeper)
+	if !ok {
+		return bank.NewAppModule(cdc, keeper, accountKeeper, ss)
+	}
+
+	return bankModule{
+		AppModule: bank.NewAppModule(cdc, hookedKeeper.Keeper, accountKeeper, ss),
+		msgServer: bankkeeper.NewMsgServerImpl(hookedKeeper),
+	}
+}
+
+// RegisterServices registers the x/bank services, replacing its Msg service
+// implementation with the hooked one.
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(bankConfigurator{Configurator: cfg, msgServer: am.msgServer})
+}
+
+type bankConfigurator struct {
+	module.Configurator
+
+	msgServer banktypes.MsgServer
+}
+
+func (c bankConfigurator) MsgServer() grpc.Server {
+	return bankMsgRegistrar{Server: c.Configurator.MsgServer(), msgServer: c.msgServer}
+}
+
+type bankMsgRegistrar struct {
+	grpc.Server
+
+	msgServer banktypes.MsgServer
+}
+
+func (r bankMsgRegistrar) RegisterService(sd *googlegrpc.ServiceDesc, _ interface{}) {
+	r.Server.RegisterService(sd, r.msgServer)
+}
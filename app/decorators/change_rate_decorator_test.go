@@ -69,7 +69,7 @@ func (s *AnteTestSuite) TestAnteCreateValidator() {
 		maxChangeRate := getChangeRate(i)
 
 		// Create change rate decorator
-		ante := decorators.NewChangeRateDecorator(s.stakingKeeper)
+		ante := decorators.NewChangeRateDecorator(s.app.AppKeepers.MsgFilterKeeper)
 
 		// Create validator params
 		_, msg, err := createValidatorMsg(maxChangeRate)
@@ -104,7 +104,7 @@ func (s *AnteTestSuite) TestAnteEditValidator() {
 		maxChangeRate := getChangeRate(i)
 
 		// Create change rate decorator
-		ante := decorators.NewChangeRateDecorator(s.stakingKeeper)
+		ante := decorators.NewChangeRateDecorator(s.app.AppKeepers.MsgFilterKeeper)
 
 		// Create validator
 		valPub, createMsg, err := createValidatorMsg("0.05")
@@ -1,35 +1,30 @@
 package decorators
 
 import (
-	"fmt"
-
-	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	msgfilterkeeper "github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
 )
 
-// MsgFilterDecorator defines an AnteHandler decorator for the v9 upgrade that
-// provide height-gated message filtering acceptance.
-type MsgFilterDecorator struct{}
+// MsgFilterDecorator defines an AnteHandler decorator that rejects the message
+// types blocked through the x/msgfilter module.
+type MsgFilterDecorator struct {
+	mfk msgfilterkeeper.Keeper
+}
 
-// AnteHandle performs an AnteHandler check that returns an error if the tx contains a message
-// that is blocked.
-// Right now, we block MsgTimeoutOnClose due to incorrect behavior that could occur if a packet is re-enabled.
-func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if hasInvalidMsgs(tx.GetMsgs()) {
-		currHeight := ctx.BlockHeight()
-		return ctx, fmt.Errorf("tx contains unsupported message types at height %d", currHeight)
+// NewMsgFilterDecorator creates a new MsgFilterDecorator
+func NewMsgFilterDecorator(mfk msgfilterkeeper.Keeper) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		mfk: mfk,
 	}
-
-	return next(ctx, tx, simulate)
 }
 
-func hasInvalidMsgs(msgs []sdk.Msg) bool {
-	for _, msg := range msgs {
-		if _, ok := msg.(*ibcchanneltypes.MsgTimeoutOnClose); ok {
-			return true
-		}
+// AnteHandle performs an AnteHandler check that returns an error if the tx contains a message,
+// or an authz message nesting a message, that is blocked at the current height.
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := mfd.mfk.AssertMsgsAllowed(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
 	}
 
-	return false
+	return next(ctx, tx, simulate)
 }
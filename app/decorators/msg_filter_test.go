@@ -0,0 +1,42 @@
+package decorators_test
+
+import (
+	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
+	msgfiltertypes "github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+// Test the msg filter decorator with the default blocked messages, blocks
+// added through x/msgfilter and authz nested messages
+func (s *AnteTestSuite) TestAnteMsgFilter() {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)))
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send})
+
+	ante := decorators.NewMsgFilterDecorator(s.app.AppKeepers.MsgFilterKeeper)
+
+	// MsgTimeoutOnClose is blocked by default
+	_, err := ante.AnteHandle(s.ctx, NewMockTx(&ibcchanneltypes.MsgTimeoutOnClose{}), false, EmptyAnte)
+	s.Require().ErrorIs(err, msgfiltertypes.ErrMessageBlocked)
+
+	_, err = ante.AnteHandle(s.ctx, NewMockTx(send, &exec), false, EmptyAnte)
+	s.Require().NoError(err)
+
+	s.app.AppKeepers.MsgFilterKeeper.SetBlockedMessage(s.ctx, msgfiltertypes.NewBlockedMessage(sdk.MsgTypeURL(send), 0, s.ctx.BlockHeight()+1))
+
+	_, err = ante.AnteHandle(s.ctx, NewMockTx(send), false, EmptyAnte)
+	s.Require().ErrorIs(err, msgfiltertypes.ErrMessageBlocked)
+
+	_, err = ante.AnteHandle(s.ctx, NewMockTx(&exec), false, EmptyAnte)
+	s.Require().ErrorIs(err, msgfiltertypes.ErrMessageBlocked)
+
+	// the block expired
+	_, err = ante.AnteHandle(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), NewMockTx(send, &exec), false, EmptyAnte)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,217 @@
+package decorators
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	rateLimitTxs = "txs"
+	rateLimitGas = "gas"
+)
+
+// rateLimitedTxs counts the txs rejected by the RateLimitDecorator, labeled by
+// the limit they exceeded.
+var rateLimitedTxs = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "juno",
+	Subsystem: "ante",
+	Name:      "rate_limited_txs_total",
+	Help:      "Number of txs rejected in CheckTx for exceeding the per signer rate limit.",
+}, []string{"limit"})
+
+// RegisterRateLimitMetrics registers the rate limit metrics with r. Registering
+// them more than once is a no-op.
+func RegisterRateLimitMetrics(r prometheus.Registerer) error {
+	if err := r.Register(rateLimitedTxs); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if !errors.As(err, &are) {
+			return err
+		}
+	}
+	return nil
+}
+
+type rateLimitEntry struct {
+	time time.Time
+	gas  uint64
+}
+
+// RateLimitDecorator defines an AnteHandler decorator that limits the number of
+// txs and the gas each signer can add to the mempool of the node within a
+// sliding window. The window slides with the block time, so all txs checked
+// against the same block are in the same window.
+//
+// The usage is kept in memory and only counted in CheckTx. ReCheckTx does not
+// count the txs already in the mempool again, and block execution is never
+// limited, so the decorator does not affect consensus.
+type RateLimitDecorator struct {
+	cfg    RateLimitConfig
+	exempt map[string]struct{}
+
+	mu        *sync.Mutex
+	usage     map[string][]rateLimitEntry
+	lastPrune *time.Time
+}
+
+// NewRateLimitDecorator creates a new RateLimitDecorator
+func NewRateLimitDecorator(cfg RateLimitConfig) RateLimitDecorator {
+	exempt := make(map[string]struct{}, len(cfg.ExemptAddresses))
+	for _, addr := range cfg.ExemptAddresses {
+		if acc, err := sdk.AccAddressFromBech32(addr); err == nil {
+			addr = acc.String()
+		}
+		exempt[addr] = struct{}{}
+	}
+
+	return RateLimitDecorator{
+		cfg:       cfg,
+		exempt:    exempt,
+		mu:        &sync.Mutex{},
+		usage:     make(map[string][]rateLimitEntry),
+		lastPrune: &time.Time{},
+	}
+}
+
+// AnteHandle rejects a new tx in CheckTx if any of its signers which is not
+// exempt would exceed the limits of the window. The tx is only counted if the
+// rest of the ante chain accepts it.
+func (rld RateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !rld.cfg.Enabled || simulate || !ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	signers := rld.limitedSigners(tx)
+	if len(signers) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	now := ctx.BlockTime()
+	gas := feeTx.GetGas()
+
+	if err := rld.checkLimits(now, signers, gas); err != nil {
+		return ctx, err
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	rld.record(now, signers, gas)
+
+	return newCtx, nil
+}
+
+// limitedSigners returns the unique signers of the tx which are not exempt.
+func (rld RateLimitDecorator) limitedSigners(tx sdk.Tx) []string {
+	var signers []string
+	seen := make(map[string]struct{})
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			addr := signer.String()
+			if _, ok := seen[addr]; ok {
+				continue
+			}
+			seen[addr] = struct{}{}
+
+			if _, ok := rld.exempt[addr]; ok {
+				continue
+			}
+			signers = append(signers, addr)
+		}
+	}
+
+	return signers
+}
+
+// checkLimits returns an error if one of the signers can not add a tx using gas
+// to the window ending at now.
+func (rld RateLimitDecorator) checkLimits(now time.Time, signers []string, gas uint64) error {
+	rld.mu.Lock()
+	defer rld.mu.Unlock()
+
+	rld.prune(now)
+
+	for _, signer := range signers {
+		entries := rld.entries(now, signer)
+
+		if rld.cfg.MaxTxs > 0 && uint64(len(entries)) >= rld.cfg.MaxTxs {
+			rateLimitedTxs.WithLabelValues(rateLimitTxs).Inc()
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "signer %s exceeded the limit of %d txs per %s", signer, rld.cfg.MaxTxs, rld.cfg.Window)
+		}
+
+		if rld.cfg.MaxGas > 0 {
+			used := gas
+			for _, e := range entries {
+				used += e.gas
+			}
+
+			if used > rld.cfg.MaxGas || used < gas {
+				rateLimitedTxs.WithLabelValues(rateLimitGas).Inc()
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "signer %s exceeded the limit of %d gas per %s", signer, rld.cfg.MaxGas, rld.cfg.Window)
+			}
+		}
+	}
+
+	return nil
+}
+
+// record adds a tx using gas to the window of each signer.
+func (rld RateLimitDecorator) record(now time.Time, signers []string, gas uint64) {
+	rld.mu.Lock()
+	defer rld.mu.Unlock()
+
+	for _, signer := range signers {
+		rld.usage[signer] = append(rld.entries(now, signer), rateLimitEntry{time: now, gas: gas})
+	}
+}
+
+// entries drops the entries of signer which are out of the window ending at now
+// and returns the remaining ones. The caller must hold the lock.
+func (rld RateLimitDecorator) entries(now time.Time, signer string) []rateLimitEntry {
+	entries := rld.usage[signer]
+
+	start := now.Add(-rld.cfg.Window)
+	i := 0
+	for i < len(entries) && !entries[i].time.After(start) {
+		i++
+	}
+
+	if i == len(entries) {
+		delete(rld.usage, signer)
+		return nil
+	}
+
+	entries = entries[i:]
+	rld.usage[signer] = entries
+
+	return entries
+}
+
+// prune drops the signers without entries in the window ending at now, at most
+// once per window, so signers which stopped sending txs do not stay in memory.
+// The caller must hold the lock.
+func (rld RateLimitDecorator) prune(now time.Time) {
+	if now.Sub(*rld.lastPrune) < rld.cfg.Window {
+		return
+	}
+
+	for signer := range rld.usage {
+		rld.entries(now, signer)
+	}
+
+	*rld.lastPrune = now
+}
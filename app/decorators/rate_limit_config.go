@@ -0,0 +1,134 @@
+package decorators
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagRateLimitEnabled         = "rate-limit.enabled"
+	flagRateLimitWindow          = "rate-limit.window"
+	flagRateLimitMaxTxs          = "rate-limit.max-txs"
+	flagRateLimitMaxGas          = "rate-limit.max-gas"
+	flagRateLimitExemptAddresses = "rate-limit.exempt-addresses"
+)
+
+// RateLimitConfig is the node local configuration of the RateLimitDecorator,
+// read from the [rate-limit] section of app.toml.
+type RateLimitConfig struct {
+	// Enabled turns the per signer rate limit on or off.
+	Enabled bool `mapstructure:"enabled"`
+	// Window is the length of the sliding window the limits apply to.
+	Window time.Duration `mapstructure:"window"`
+	// MaxTxs is the max number of txs a signer can add to the mempool within
+	// the window. Set to 0 for no limit.
+	MaxTxs uint64 `mapstructure:"max-txs"`
+	// MaxGas is the max sum of gas limits of the txs a signer can add to the
+	// mempool within the window. Set to 0 for no limit.
+	MaxGas uint64 `mapstructure:"max-gas"`
+	// ExemptAddresses are signers which are never limited, such as known relayers.
+	ExemptAddresses []string `mapstructure:"exempt-addresses"`
+}
+
+// DefaultRateLimitConfig returns the default settings for RateLimitConfig. The
+// rate limit is off by default, so that node operators opt in to it.
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enabled:         false,
+		Window:          time.Minute,
+		MaxTxs:          100,
+		MaxGas:          200_000_000,
+		ExemptAddresses: []string{},
+	}
+}
+
+// ReadRateLimitConfig reads the rate limit specific configuration, keeping
+// the defaults for the values which are not set.
+func ReadRateLimitConfig(opts servertypes.AppOptions) (RateLimitConfig, error) {
+	cfg := DefaultRateLimitConfig()
+	var err error
+	if v := opts.Get(flagRateLimitEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitWindow); v != nil {
+		if cfg.Window, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitMaxTxs); v != nil {
+		if cfg.MaxTxs, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitMaxGas); v != nil {
+		if cfg.MaxGas, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitExemptAddresses); v != nil {
+		if cfg.ExemptAddresses, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the window and the exempt addresses of an enabled config.
+func (c RateLimitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Window <= 0 {
+		return fmt.Errorf("rate limit window must be positive: %s", c.Window)
+	}
+
+	for _, addr := range c.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid rate limit exempt address %s: %w", addr, err)
+		}
+	}
+
+	return nil
+}
+
+// RateLimitConfigTemplate returns the app.toml section for the given config.
+func RateLimitConfigTemplate(c RateLimitConfig) string {
+	exempt := make([]string, len(c.ExemptAddresses))
+	for i, addr := range c.ExemptAddresses {
+		exempt[i] = fmt.Sprintf("%q", addr)
+	}
+
+	return fmt.Sprintf(`
+[rate-limit]
+# Limit the txs each signer can add to the mempool of this node within a
+# sliding window. Only applies to CheckTx, never to block execution. Off by
+# default.
+enabled = %t
+
+# Length of the sliding window, e.g. "1m0s"
+window = "%s"
+
+# Max number of txs per signer within the window. Set to 0 for no limit.
+max-txs = %d
+
+# Max sum of tx gas limits per signer within the window. Set to 0 for no limit.
+max-gas = %d
+
+# Signers which are never limited, e.g. known relayers
+exempt-addresses = [%s]
+`, c.Enabled, c.Window, c.MaxTxs, c.MaxGas, strings.Join(exempt, ", "))
+}
+
+// DefaultRateLimitConfigTemplate returns the app.toml section with the defaults.
+func DefaultRateLimitConfigTemplate() string {
+	return RateLimitConfigTemplate(DefaultRateLimitConfig())
+}
@@ -0,0 +1,188 @@
+package decorators_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
+)
+
+// Test the rate limit decorator limits txs and gas per signer in CheckTx only,
+// slides the window with the block time and skips exempt signers
+func (s *AnteTestSuite) TestAnteRateLimit() {
+	registry := prometheus.NewRegistry()
+	s.Require().NoError(decorators.RegisterRateLimitMetrics(registry))
+	limitedTxs, limitedGas := rateLimitedTxs(s, registry, "txs"), rateLimitedTxs(s, registry, "gas")
+
+	alice := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bob := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	sendTx := func(gas uint64, signers ...sdk.AccAddress) sdk.Tx {
+		msgs := make([]sdk.Msg, len(signers))
+		for i, signer := range signers {
+			msgs[i] = banktypes.NewMsgSend(signer, signer, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)))
+		}
+		return mockFeeTx{MockTx: NewMockTx(msgs...), gas: gas}
+	}
+
+	ante := decorators.NewRateLimitDecorator(decorators.RateLimitConfig{
+		Enabled:         true,
+		Window:          time.Minute,
+		MaxTxs:          2,
+		MaxGas:          500_000,
+		ExemptAddresses: []string{relayer.String()},
+	})
+
+	checkCtx := s.ctx.WithIsCheckTx(true)
+
+	// block execution is never limited
+	for i := 0; i < 3; i++ {
+		_, err := ante.AnteHandle(s.ctx, sendTx(100_000, alice), false, EmptyAnte)
+		s.Require().NoError(err)
+	}
+
+	// txs rejected later in the ante chain are not counted
+	failingAnte := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, errors.New("invalid signature")
+	}
+	_, err := ante.AnteHandle(checkCtx, sendTx(100_000, alice), false, failingAnte)
+	s.Require().Error(err)
+
+	for i := 0; i < 2; i++ {
+		_, err = ante.AnteHandle(checkCtx, sendTx(100_000, alice), false, EmptyAnte)
+		s.Require().NoError(err)
+	}
+
+	_, err = ante.AnteHandle(checkCtx, sendTx(100_000, alice), false, EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().Contains(err.Error(), "exceeded the limit of 2 txs")
+
+	// a tx is limited if any of its signers is
+	_, err = ante.AnteHandle(checkCtx, sendTx(100_000, bob, alice), false, EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// simulations and txs already in the mempool are not limited nor counted
+	_, err = ante.AnteHandle(checkCtx, sendTx(100_000, alice), true, EmptyAnte)
+	s.Require().NoError(err)
+	_, err = ante.AnteHandle(checkCtx.WithIsReCheckTx(true), sendTx(100_000, alice), false, EmptyAnte)
+	s.Require().NoError(err)
+
+	// the gas of the window is limited as well
+	_, err = ante.AnteHandle(checkCtx, sendTx(400_000, bob), false, EmptyAnte)
+	s.Require().NoError(err)
+	_, err = ante.AnteHandle(checkCtx, sendTx(100_001, bob), false, EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().Contains(err.Error(), "exceeded the limit of 500000 gas")
+
+	// exempt signers are never limited
+	for i := 0; i < 3; i++ {
+		_, err = ante.AnteHandle(checkCtx, sendTx(500_000, relayer), false, EmptyAnte)
+		s.Require().NoError(err)
+	}
+
+	// the window slides with the block time
+	nextCtx := checkCtx.WithBlockTime(checkCtx.BlockTime().Add(time.Minute))
+	_, err = ante.AnteHandle(nextCtx, sendTx(100_000, alice), false, EmptyAnte)
+	s.Require().NoError(err)
+	_, err = ante.AnteHandle(nextCtx, sendTx(500_000, bob), false, EmptyAnte)
+	s.Require().NoError(err)
+
+	s.Require().Equal(limitedTxs+2, rateLimitedTxs(s, registry, "txs"))
+	s.Require().Equal(limitedGas+1, rateLimitedTxs(s, registry, "gas"))
+
+	// a disabled decorator does not limit
+	disabled := decorators.NewRateLimitDecorator(decorators.RateLimitConfig{Enabled: false, Window: time.Minute, MaxTxs: 1})
+	for i := 0; i < 2; i++ {
+		_, err = disabled.AnteHandle(checkCtx, sendTx(100_000, alice), false, EmptyAnte)
+		s.Require().NoError(err)
+	}
+}
+
+// Test reading the rate limit config from the app options
+func (s *AnteTestSuite) TestReadRateLimitConfig() {
+	cfg, err := decorators.ReadRateLimitConfig(simtestutil.AppOptionsMap{})
+	s.Require().NoError(err)
+	s.Require().Equal(decorators.DefaultRateLimitConfig(), cfg)
+
+	// the rate limit is opt-in
+	s.Require().False(cfg.Enabled)
+
+	relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	cfg, err = decorators.ReadRateLimitConfig(simtestutil.AppOptionsMap{
+		"rate-limit.enabled":          "true",
+		"rate-limit.window":           "30s",
+		"rate-limit.max-txs":          "10",
+		"rate-limit.max-gas":          "0",
+		"rate-limit.exempt-addresses": []interface{}{relayer.String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(decorators.RateLimitConfig{
+		Enabled:         true,
+		Window:          30 * time.Second,
+		MaxTxs:          10,
+		MaxGas:          0,
+		ExemptAddresses: []string{relayer.String()},
+	}, cfg)
+
+	_, err = decorators.ReadRateLimitConfig(simtestutil.AppOptionsMap{
+		"rate-limit.enabled":          "true",
+		"rate-limit.exempt-addresses": []interface{}{"cosmos1invalid"},
+	})
+	s.Require().Error(err)
+
+	_, err = decorators.ReadRateLimitConfig(simtestutil.AppOptionsMap{
+		"rate-limit.enabled": "true",
+		"rate-limit.window":  "0s",
+	})
+	s.Require().Error(err)
+}
+
+func rateLimitedTxs(s *AnteTestSuite, registry *prometheus.Registry, limit string) float64 {
+	families, err := registry.Gather()
+	s.Require().NoError(err)
+
+	for _, family := range families {
+		if family.GetName() != "juno_ante_rate_limited_txs_total" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "limit" && label.GetValue() == limit {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+type mockFeeTx struct {
+	MockTx
+	gas uint64
+}
+
+func (tx mockFeeTx) GetGas() uint64 {
+	return tx.gas
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (tx mockFeeTx) FeePayer() sdk.AccAddress {
+	return nil
+}
+
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress {
+	return nil
+}
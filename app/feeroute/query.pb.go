@@ -0,0 +1,1157 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/feeroute/v1/query.proto
+
+package feeroute
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeRoute defines how the fees of a tx are paid.
+type FeeRoute int32
+
+const (
+	// The route is not known.
+	FEE_ROUTE_UNSPECIFIED FeeRoute = 0
+	// The fee payer of the tx pays the fees.
+	FEE_ROUTE_NORMAL FeeRoute = 1
+	// The fee granter of the tx pays the fees through x/feegrant.
+	FEE_ROUTE_FEEGRANT FeeRoute = 2
+	// A FeePay contract sponsors the fees.
+	FEE_ROUTE_FEEPAY FeeRoute = 3
+)
+
+var FeeRoute_name = map[int32]string{
+	0: "FEE_ROUTE_UNSPECIFIED",
+	1: "FEE_ROUTE_NORMAL",
+	2: "FEE_ROUTE_FEEGRANT",
+	3: "FEE_ROUTE_FEEPAY",
+}
+
+var FeeRoute_value = map[string]int32{
+	"FEE_ROUTE_UNSPECIFIED": 0,
+	"FEE_ROUTE_NORMAL":      1,
+	"FEE_ROUTE_FEEGRANT":    2,
+	"FEE_ROUTE_FEEPAY":      3,
+}
+
+func (x FeeRoute) String() string {
+	return proto.EnumName(FeeRoute_name, int32(x))
+}
+
+func (FeeRoute) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cf3dfc3d38343c4, []int{0}
+}
+
+// SimulateFeeRouteRequest is the request type for the Service/SimulateFeeRoute
+// RPC method.
+type SimulateFeeRouteRequest struct {
+	// The encoded tx, which does not need to be signed. Its gas limit is used
+	// to compute the fees.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *SimulateFeeRouteRequest) Reset()         { *m = SimulateFeeRouteRequest{} }
+func (m *SimulateFeeRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateFeeRouteRequest) ProtoMessage()    {}
+func (*SimulateFeeRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cf3dfc3d38343c4, []int{0}
+}
+func (m *SimulateFeeRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateFeeRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateFeeRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateFeeRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateFeeRouteRequest.Merge(m, src)
+}
+func (m *SimulateFeeRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateFeeRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateFeeRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateFeeRouteRequest proto.InternalMessageInfo
+
+func (m *SimulateFeeRouteRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// SimulateFeeRouteResponse is the response type for the
+// Service/SimulateFeeRoute RPC method.
+type SimulateFeeRouteResponse struct {
+	// How the fees of the tx would be paid.
+	Route FeeRoute `protobuf:"varint,1,opt,name=route,proto3,enum=juno.feeroute.v1.FeeRoute" json:"route,omitempty"`
+	// The account the fees would be deducted from, or the FeePay contract which
+	// would sponsor them.
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// The fees which would be paid for the tx.
+	PaidFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=paid_fees,json=paidFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_fees"`
+	// The minimum fee per accepted denom for the gas limit of the tx, combining
+	// the global fee and the minimum gas prices of the node. Paying one of them
+	// is enough.
+	RequiredFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=required_fees,json=requiredFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"required_fees"`
+	// Whether the tx only has messages which can bypass the minimum fee.
+	BypassMinFee bool `protobuf:"varint,5,opt,name=bypass_min_fee,json=bypassMinFee,proto3" json:"bypass_min_fee,omitempty"`
+	// The FeeShare payouts the fees of the tx would fund.
+	FeeSharePayouts []FeeSharePayout `protobuf:"bytes,6,rep,name=fee_share_payouts,json=feeSharePayouts,proto3" json:"fee_share_payouts"`
+}
+
+func (m *SimulateFeeRouteResponse) Reset()         { *m = SimulateFeeRouteResponse{} }
+func (m *SimulateFeeRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateFeeRouteResponse) ProtoMessage()    {}
+func (*SimulateFeeRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cf3dfc3d38343c4, []int{1}
+}
+func (m *SimulateFeeRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateFeeRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateFeeRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateFeeRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateFeeRouteResponse.Merge(m, src)
+}
+func (m *SimulateFeeRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateFeeRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateFeeRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateFeeRouteResponse proto.InternalMessageInfo
+
+func (m *SimulateFeeRouteResponse) GetRoute() FeeRoute {
+	if m != nil {
+		return m.Route
+	}
+	return FEE_ROUTE_UNSPECIFIED
+}
+
+func (m *SimulateFeeRouteResponse) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *SimulateFeeRouteResponse) GetPaidFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaidFees
+	}
+	return nil
+}
+
+func (m *SimulateFeeRouteResponse) GetRequiredFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequiredFees
+	}
+	return nil
+}
+
+func (m *SimulateFeeRouteResponse) GetBypassMinFee() bool {
+	if m != nil {
+		return m.BypassMinFee
+	}
+	return false
+}
+
+func (m *SimulateFeeRouteResponse) GetFeeSharePayouts() []FeeSharePayout {
+	if m != nil {
+		return m.FeeSharePayouts
+	}
+	return nil
+}
+
+// FeeSharePayout is the share of the fees of a tx paid to a contract withdrawer.
+type FeeSharePayout struct {
+	// The address receiving the payout.
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// The coins paid out.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FeeSharePayout) Reset()         { *m = FeeSharePayout{} }
+func (m *FeeSharePayout) String() string { return proto.CompactTextString(m) }
+func (*FeeSharePayout) ProtoMessage()    {}
+func (*FeeSharePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cf3dfc3d38343c4, []int{2}
+}
+func (m *FeeSharePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSharePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSharePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSharePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSharePayout.Merge(m, src)
+}
+func (m *FeeSharePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSharePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSharePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSharePayout proto.InternalMessageInfo
+
+func (m *FeeSharePayout) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *FeeSharePayout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("juno.feeroute.v1.FeeRoute", FeeRoute_name, FeeRoute_value)
+	proto.RegisterType((*SimulateFeeRouteRequest)(nil), "juno.feeroute.v1.SimulateFeeRouteRequest")
+	proto.RegisterType((*SimulateFeeRouteResponse)(nil), "juno.feeroute.v1.SimulateFeeRouteResponse")
+	proto.RegisterType((*FeeSharePayout)(nil), "juno.feeroute.v1.FeeSharePayout")
+}
+
+func init() { proto.RegisterFile("juno/feeroute/v1/query.proto", fileDescriptor_8cf3dfc3d38343c4) }
+
+var fileDescriptor_8cf3dfc3d38343c4 = []byte{
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x93, 0xfe, 0x48, 0x8e, 0x52, 0xdc, 0x53, 0x01, 0x37, 0x54, 0x6e, 0x14, 0x81, 0x14,
+	0x2a, 0x6a, 0xb7, 0x85, 0x89, 0x2d, 0x09, 0x36, 0xaa, 0x44, 0xdb, 0xc8, 0x69, 0x07, 0x58, 0xac,
+	0x4b, 0xf2, 0x25, 0x39, 0x68, 0x7c, 0xee, 0xdd, 0xb9, 0x6d, 0x56, 0x26, 0xc6, 0x4a, 0xac, 0x6c,
+	0x30, 0xf1, 0x4f, 0xb0, 0x76, 0xac, 0xc4, 0xc2, 0x04, 0xa8, 0xe5, 0x0f, 0x41, 0x67, 0xbb, 0x2d,
+	0x6d, 0x40, 0x62, 0xe8, 0xe4, 0xbb, 0xef, 0xbd, 0xf7, 0xbd, 0xf3, 0xf3, 0x7d, 0x46, 0xf3, 0xaf,
+	0xa3, 0x80, 0xd9, 0x5d, 0x00, 0xce, 0x22, 0x09, 0xf6, 0xde, 0x8a, 0xbd, 0x1b, 0x01, 0x1f, 0x5a,
+	0x21, 0x67, 0x92, 0x61, 0x5d, 0xa1, 0xd6, 0x19, 0x6a, 0xed, 0xad, 0x14, 0xcd, 0x36, 0x13, 0x03,
+	0x26, 0xec, 0x16, 0x11, 0x8a, 0xdd, 0x02, 0x49, 0x56, 0xec, 0x36, 0xa3, 0x41, 0xa2, 0x28, 0xce,
+	0xf6, 0x58, 0x8f, 0xc5, 0x4b, 0x5b, 0xad, 0xd2, 0xea, 0x7c, 0x8f, 0xb1, 0xde, 0x0e, 0xd8, 0x24,
+	0xa4, 0x36, 0x09, 0x02, 0x26, 0x89, 0xa4, 0x2c, 0x10, 0x09, 0x5a, 0x7e, 0x82, 0xee, 0x36, 0xe9,
+	0x20, 0xda, 0x21, 0x12, 0x5c, 0x00, 0x4f, 0x59, 0x79, 0xb0, 0x1b, 0x81, 0x90, 0x78, 0x0e, 0xe5,
+	0xe5, 0x81, 0xdf, 0x1a, 0x4a, 0x10, 0x86, 0x56, 0xd2, 0x2a, 0x53, 0xde, 0xa4, 0x3c, 0xa8, 0xa9,
+	0x6d, 0xf9, 0x4b, 0x0e, 0x19, 0xa3, 0x32, 0x11, 0xb2, 0x40, 0x00, 0x5e, 0x46, 0xe3, 0xf1, 0x91,
+	0x63, 0xd1, 0xf4, 0x6a, 0xd1, 0xba, 0xfa, 0x22, 0xd6, 0xb9, 0x24, 0x21, 0xe2, 0x7b, 0xa8, 0xd0,
+	0x05, 0xf0, 0x43, 0x32, 0x04, 0x6e, 0x64, 0x4b, 0x5a, 0xa5, 0xe0, 0xe5, 0xbb, 0x00, 0x0d, 0xb5,
+	0xc7, 0x7d, 0x54, 0x08, 0x09, 0xed, 0xf8, 0x5d, 0x00, 0x61, 0xe4, 0x4a, 0xb9, 0xca, 0x8d, 0xd5,
+	0x39, 0x2b, 0x49, 0xc2, 0x52, 0x49, 0x58, 0x69, 0x12, 0x56, 0x9d, 0xd1, 0xa0, 0xb6, 0x7c, 0xf4,
+	0x7d, 0x21, 0xf3, 0xf9, 0xc7, 0x42, 0xa5, 0x47, 0x65, 0x3f, 0x6a, 0x59, 0x6d, 0x36, 0xb0, 0xd3,
+	0xd8, 0x92, 0xc7, 0x92, 0xe8, 0xbc, 0xb1, 0xe5, 0x30, 0x04, 0x11, 0x0b, 0x84, 0x97, 0x57, 0xdd,
+	0x5d, 0x00, 0x81, 0x43, 0x74, 0x93, 0xc3, 0x6e, 0x44, 0x39, 0xa4, 0x6e, 0x63, 0xd7, 0xef, 0x36,
+	0x75, 0xe6, 0x10, 0x3b, 0xde, 0x47, 0xd3, 0xad, 0x61, 0x48, 0x84, 0xf0, 0x07, 0x34, 0x50, 0x9e,
+	0xc6, 0x78, 0x49, 0xab, 0xe4, 0xbd, 0xa9, 0xa4, 0xba, 0x4e, 0x03, 0x17, 0x00, 0x7b, 0x68, 0x46,
+	0xc5, 0x23, 0xfa, 0x84, 0xc7, 0x21, 0xb1, 0x48, 0x0a, 0x63, 0x22, 0x3e, 0x5b, 0xe9, 0xaf, 0xe1,
+	0x36, 0x15, 0xb3, 0x11, 0x13, 0x6b, 0x63, 0xea, 0x88, 0xde, 0xad, 0xee, 0xa5, 0xaa, 0x28, 0x7f,
+	0xd2, 0xd0, 0xf4, 0x65, 0x26, 0x5e, 0x42, 0x78, 0x9f, 0xca, 0x7e, 0x87, 0x93, 0x7d, 0xe0, 0x3e,
+	0xe9, 0x74, 0x38, 0x88, 0xe4, 0xcb, 0x17, 0xbc, 0x99, 0x0b, 0xa4, 0x9a, 0x00, 0xb8, 0x8d, 0x26,
+	0xc8, 0x80, 0x45, 0x81, 0x34, 0xb2, 0xd7, 0x1f, 0x53, 0xda, 0x7a, 0x91, 0xa1, 0xfc, 0xd9, 0x65,
+	0xc1, 0x73, 0xe8, 0xb6, 0xeb, 0x38, 0xbe, 0xb7, 0xb9, 0xbd, 0xe5, 0xf8, 0xdb, 0x1b, 0xcd, 0x86,
+	0x53, 0x5f, 0x73, 0xd7, 0x9c, 0x67, 0x7a, 0x06, 0xcf, 0x22, 0xfd, 0x02, 0xda, 0xd8, 0xf4, 0xd6,
+	0xab, 0x2f, 0x74, 0x0d, 0xdf, 0x41, 0xf8, 0xa2, 0xea, 0x3a, 0xce, 0x73, 0xaf, 0xba, 0xb1, 0xa5,
+	0x67, 0x2f, 0xb3, 0x5d, 0xc7, 0x69, 0x54, 0x5f, 0xea, 0xb9, 0xe2, 0xd8, 0xbb, 0x8f, 0x66, 0x66,
+	0xf5, 0x83, 0x86, 0x26, 0x9b, 0xc0, 0xf7, 0x68, 0x1b, 0xf0, 0xa1, 0x86, 0xf4, 0xab, 0xb7, 0x1c,
+	0x3f, 0x1c, 0x4d, 0xfc, 0x1f, 0x03, 0x54, 0x5c, 0xfc, 0x1f, 0x6a, 0x32, 0x34, 0xe5, 0x07, 0x6f,
+	0xbf, 0xfe, 0x7a, 0x9f, 0x5d, 0x78, 0xaa, 0x2d, 0x96, 0x8b, 0xf6, 0xc8, 0x7f, 0x41, 0xa4, 0xb2,
+	0x9a, 0x7b, 0x74, 0x62, 0x6a, 0xc7, 0x27, 0xa6, 0xf6, 0xf3, 0xc4, 0xd4, 0x0e, 0x4f, 0xcd, 0xcc,
+	0xf1, 0xa9, 0x99, 0xf9, 0x76, 0x6a, 0x66, 0x5e, 0x3d, 0xfa, 0x23, 0xdb, 0x7a, 0x1c, 0x6a, 0x9d,
+	0x05, 0x92, 0x93, 0xb6, 0x14, 0x49, 0x3f, 0x12, 0x86, 0xe7, 0x3d, 0x5b, 0x13, 0xf1, 0xf4, 0x3f,
+	0xfe, 0x3d, 0x00, 0x07, 0x6e, 0x23, 0x15, 0x83, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// SimulateFeeRoute runs the ante handler in simulate mode on a tx, against
+	// the latest state of the node, and reports how its fees would be paid.
+	SimulateFeeRoute(ctx context.Context, in *SimulateFeeRouteRequest, opts ...grpc.CallOption) (*SimulateFeeRouteResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) SimulateFeeRoute(ctx context.Context, in *SimulateFeeRouteRequest, opts ...grpc.CallOption) (*SimulateFeeRouteResponse, error) {
+	out := new(SimulateFeeRouteResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeroute.v1.Service/SimulateFeeRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// SimulateFeeRoute runs the ante handler in simulate mode on a tx, against
+	// the latest state of the node, and reports how its fees would be paid.
+	SimulateFeeRoute(context.Context, *SimulateFeeRouteRequest) (*SimulateFeeRouteResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) SimulateFeeRoute(ctx context.Context, req *SimulateFeeRouteRequest) (*SimulateFeeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFeeRoute not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_SimulateFeeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateFeeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateFeeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeroute.v1.Service/SimulateFeeRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateFeeRoute(ctx, req.(*SimulateFeeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.feeroute.v1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SimulateFeeRoute",
+			Handler:    _Service_SimulateFeeRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/feeroute/v1/query.proto",
+}
+
+func (m *SimulateFeeRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateFeeRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateFeeRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateFeeRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateFeeRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateFeeRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeSharePayouts) > 0 {
+		for iNdEx := len(m.FeeSharePayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSharePayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BypassMinFee {
+		i--
+		if m.BypassMinFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RequiredFees) > 0 {
+		for iNdEx := len(m.RequiredFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PaidFees) > 0 {
+		for iNdEx := len(m.PaidFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Route != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Route))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSharePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSharePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSharePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SimulateFeeRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateFeeRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != 0 {
+		n += 1 + sovQuery(uint64(m.Route))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PaidFees) > 0 {
+		for _, e := range m.PaidFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RequiredFees) > 0 {
+		for _, e := range m.RequiredFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BypassMinFee {
+		n += 2
+	}
+	if len(m.FeeSharePayouts) > 0 {
+		for _, e := range m.FeeSharePayouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSharePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SimulateFeeRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateFeeRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateFeeRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateFeeRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateFeeRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateFeeRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			m.Route = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Route |= FeeRoute(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidFees = append(m.PaidFees, types.Coin{})
+			if err := m.PaidFees[len(m.PaidFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredFees = append(m.RequiredFees, types.Coin{})
+			if err := m.RequiredFees[len(m.RequiredFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassMinFee = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSharePayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSharePayouts = append(m.FeeSharePayouts, FeeSharePayout{})
+			if err := m.FeeSharePayouts[len(m.FeeSharePayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSharePayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSharePayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSharePayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
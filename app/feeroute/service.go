@@ -0,0 +1,170 @@
+package feeroute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	feeshareante "github.com/CosmosContracts/juno/v23/x/feeshare/ante"
+	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
+	globalfeeante "github.com/CosmosContracts/juno/v23/x/globalfee/ante"
+)
+
+// AnteHandlerFactory returns a new ante handler of the app. A new one is used
+// per simulation since the fee decorators share state for the tx being handled.
+type AnteHandlerFactory func() (sdk.AnteHandler, error)
+
+// ConsensusParamsGetter returns the consensus params of the chain, which the
+// ante handler reads like in a tx.
+type ConsensusParamsGetter func(ctx sdk.Context) *tmproto.ConsensusParams
+
+// RegisterService registers the fee route gRPC service on the provided gRPC router.
+func RegisterService(server gogogrpc.Server, srv ServiceServer) {
+	RegisterServiceServer(server, srv)
+}
+
+// RegisterGRPCGatewayRoutes mounts the fee route gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	txDecoder       sdk.TxDecoder
+	newAnteHandler  AnteHandlerFactory
+	consensusParams ConsensusParamsGetter
+	feeDecorator    globalfeeante.FeeDecorator
+}
+
+// NewQueryServer returns a ServiceServer running the ante handlers built by
+// newAnteHandler, and computing the required fees with feeDecorator.
+func NewQueryServer(txDecoder sdk.TxDecoder, newAnteHandler AnteHandlerFactory, consensusParams ConsensusParamsGetter, feeDecorator globalfeeante.FeeDecorator) ServiceServer {
+	return queryServer{
+		txDecoder:       txDecoder,
+		newAnteHandler:  newAnteHandler,
+		consensusParams: consensusParams,
+		feeDecorator:    feeDecorator,
+	}
+}
+
+// SimulateFeeRoute runs the ante handler in simulate mode on a cache of the
+// query context, so the state of the node is never changed.
+func (s queryServer) SimulateFeeRoute(ctx context.Context, req *SimulateFeeRouteRequest) (*SimulateFeeRouteResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty tx")
+	}
+
+	tx, err := s.txDecoder(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode tx: %v", err)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "tx must be a FeeTx")
+	}
+
+	anteHandler, err := s.newAnteHandler()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+	cacheCtx = cacheCtx.WithConsensusParams(s.consensusParams(cacheCtx))
+
+	if err := runAnteHandler(cacheCtx, anteHandler, tx); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tx rejected: %v", err)
+	}
+
+	res := &SimulateFeeRouteResponse{
+		Route:    FEE_ROUTE_NORMAL,
+		FeePayer: feeTx.FeePayer().String(),
+		PaidFees: feeTx.GetFee(),
+	}
+
+	if granter := feeTx.FeeGranter(); granter != nil && !granter.Equals(feeTx.FeePayer()) {
+		res.Route = FEE_ROUTE_FEEGRANT
+		res.FeePayer = granter.String()
+	}
+
+	events := cacheCtx.EventManager().Events()
+	for _, event := range events {
+		switch event.Type {
+		case feepaytypes.EventTypeSponsorFee:
+			res.Route = FEE_ROUTE_FEEPAY
+			res.FeePayer = attribute(event, feepaytypes.AttributeKeyContract)
+			if res.PaidFees, err = sdk.ParseCoinsNormalized(attribute(event, feepaytypes.AttributeKeyFee)); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		case feesharetypes.EventTypePayoutFeeShare:
+			payouts, err := parseFeeSharePayouts(attribute(event, feesharetypes.AttributeWithdrawPayouts))
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			res.FeeSharePayouts = append(res.FeeSharePayouts, payouts...)
+		}
+	}
+
+	globalFees, err := s.feeDecorator.GetGlobalFee(sdkCtx, feeTx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.RequiredFees = globalfeeante.CombinedFeeRequirement(globalFees, globalfeeante.GetMinGasPrice(sdkCtx, int64(feeTx.GetGas())))
+	res.BypassMinFee = s.feeDecorator.ContainsOnlyBypassMinFeeMsgs(tx.GetMsgs()) &&
+		feeTx.GetGas() <= s.feeDecorator.MaxTotalBypassMinFeeMsgGasUsage
+
+	return res, nil
+}
+
+// runAnteHandler runs the ante handler in simulate mode, returning the out of
+// gas panics as errors.
+func runAnteHandler(ctx sdk.Context, anteHandler sdk.AnteHandler, tx sdk.Tx) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic in ante handler: %v", r)
+		}
+	}()
+
+	_, err = anteHandler(ctx, tx, true)
+	return err
+}
+
+func attribute(event sdk.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func parseFeeSharePayouts(value string) ([]FeeSharePayout, error) {
+	var outputs []feeshareante.FeeSharePayoutEventOutput
+	if err := json.Unmarshal([]byte(value), &outputs); err != nil {
+		return nil, err
+	}
+
+	payouts := make([]FeeSharePayout, len(outputs))
+	for i, output := range outputs {
+		payouts[i] = FeeSharePayout{
+			WithdrawerAddress: output.WithdrawAddress.String(),
+			Amount:            output.FeesPaid,
+		}
+	}
+
+	return payouts, nil
+}
@@ -0,0 +1,141 @@
+package feeroute_test
+
+import (
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/app/feeroute"
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
+	globalfeetypes "github.com/CosmosContracts/juno/v23/x/globalfee/types"
+)
+
+func TestSimulateFeeRoute(t *testing.T) {
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(true, tmproto.Header{Height: 2, ChainID: "testing", Time: time.Now().UTC()})
+	txConfig := app.MakeEncodingConfig().TxConfig
+
+	junoApp.RegisterNodeService(client.Context{})
+	handler := junoApp.GRPCQueryRouter().Route("/juno.feeroute.v1.Service/SimulateFeeRoute")
+	require.NotNil(t, handler)
+
+	bondDenom := junoApp.GetChainBondDenom()
+	require.NoError(t, junoApp.AppKeepers.GlobalFeeKeeper.SetParams(ctx, globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, sdk.NewDecWithPrec(75, 3))),
+	}))
+
+	userKey := secp256k1.GenPrivKey()
+	user := sdk.AccAddress(userKey.PubKey().Address())
+	granter := sdk.AccAddress([]byte("feeroute-granter____"))
+	withdrawer := sdk.AccAddress([]byte("feeroute-withdrawer_"))
+	sponsored := sdk.AccAddress([]byte("feeroute-feepay_____"))
+	shared := sdk.AccAddress([]byte("feeroute-feeshare___"))
+
+	for _, addr := range []sdk.AccAddress{user, granter} {
+		require.NoError(t, banktestutil.FundAccount(junoApp.AppKeepers.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+	}
+	require.NoError(t, banktestutil.FundModuleAccount(junoApp.AppKeepers.BankKeeper, ctx, feepaytypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+
+	require.NoError(t, junoApp.AppKeepers.FeeGrantKeeper.GrantAllowance(ctx, granter, user, &feegrant.BasicAllowance{}))
+	junoApp.AppKeepers.FeePayKeeper.SetFeePayContract(ctx, feepaytypes.FeePayContract{
+		ContractAddress: sponsored.String(),
+		Balance:         1_000_000,
+		WalletLimit:     1,
+	})
+	junoApp.AppKeepers.FeeShareKeeper.SetFeeShare(ctx, feesharetypes.NewFeeShare(shared, granter, withdrawer))
+
+	simulate := func(contract sdk.AccAddress, fee sdk.Coins, feeGranter sdk.AccAddress) (*feeroute.SimulateFeeRouteResponse, error) {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&wasmtypes.MsgExecuteContract{
+			Sender:   user.String(),
+			Contract: contract.String(),
+			Msg:      []byte(`{}`),
+		}))
+		builder.SetGasLimit(200_000)
+		builder.SetFeeAmount(fee)
+		builder.SetFeeGranter(feeGranter)
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   userKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: 0,
+		}))
+
+		txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		reqBz, err := (&feeroute.SimulateFeeRouteRequest{TxBytes: txBytes}).Marshal()
+		require.NoError(t, err)
+
+		resp, err := handler(ctx, abci.RequestQuery{Data: reqBz})
+		if err != nil {
+			return nil, err
+		}
+
+		var res feeroute.SimulateFeeRouteResponse
+		require.NoError(t, res.Unmarshal(resp.Value))
+		return &res, nil
+	}
+
+	requiredFees := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 15_000))
+
+	// the user pays and half of the fees go to the contract withdrawer
+	res, err := simulate(shared, requiredFees, nil)
+	require.NoError(t, err)
+	require.Equal(t, feeroute.FEE_ROUTE_NORMAL, res.Route)
+	require.Equal(t, user.String(), res.FeePayer)
+	require.Equal(t, requiredFees, res.PaidFees)
+	require.Equal(t, requiredFees, res.RequiredFees)
+	require.False(t, res.BypassMinFee)
+	require.Equal(t, []feeroute.FeeSharePayout{{
+		WithdrawerAddress: withdrawer.String(),
+		Amount:            sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 7_500)),
+	}}, res.FeeSharePayouts)
+
+	// the granter pays through its fee allowance
+	res, err = simulate(shared, requiredFees, granter)
+	require.NoError(t, err)
+	require.Equal(t, feeroute.FEE_ROUTE_FEEGRANT, res.Route)
+	require.Equal(t, granter.String(), res.FeePayer)
+
+	// the FeePay contract sponsors a zero fee tx, which pays no FeeShare
+	res, err = simulate(sponsored, sdk.Coins{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, feeroute.FEE_ROUTE_FEEPAY, res.Route)
+	require.Equal(t, sponsored.String(), res.FeePayer)
+	require.Equal(t, requiredFees, res.PaidFees)
+	require.Empty(t, res.FeeSharePayouts)
+
+	// simulating does not change the state, so the wallet limit is not used
+	require.False(t, junoApp.AppKeepers.FeePayKeeper.HasWalletExceededUsageLimit(ctx, &feepaytypes.FeePayContract{
+		ContractAddress: sponsored.String(),
+		WalletLimit:     1,
+	}, user.String()))
+	res, err = simulate(sponsored, sdk.Coins{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, feeroute.FEE_ROUTE_FEEPAY, res.Route)
+
+	// a zero fee tx without a sponsor reports the fees it must pay
+	res, err = simulate(shared, sdk.Coins{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, feeroute.FEE_ROUTE_NORMAL, res.Route)
+	require.Empty(t, res.PaidFees)
+	require.Equal(t, requiredFees, res.RequiredFees)
+
+	// the fees can not be paid
+	_, err = simulate(shared, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2_000_000)), nil)
+	require.ErrorContains(t, err, "insufficient funds")
+}
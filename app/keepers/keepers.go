@@ -42,7 +42,6 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibcclient "github.com/cosmos/ibc-go/v7/modules/core/02-client"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
-	ibcconnectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
@@ -73,7 +72,6 @@ import (
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -90,14 +88,21 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	junoburn "github.com/CosmosContracts/juno/v23/x/burn"
+	burnkeeper "github.com/CosmosContracts/juno/v23/x/burn/keeper"
+	burntypes "github.com/CosmosContracts/juno/v23/x/burn/types"
+	clockbindings "github.com/CosmosContracts/juno/v23/x/clock/bindings"
 	clockkeeper "github.com/CosmosContracts/juno/v23/x/clock/keeper"
 	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
+	cwhooks "github.com/CosmosContracts/juno/v23/x/cw-hooks"
+	cwhooksbindings "github.com/CosmosContracts/juno/v23/x/cw-hooks/bindings"
 	cwhookskeeper "github.com/CosmosContracts/juno/v23/x/cw-hooks/keeper"
 	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
 	dripkeeper "github.com/CosmosContracts/juno/v23/x/drip/keeper"
 	driptypes "github.com/CosmosContracts/juno/v23/x/drip/types"
+	feepaybindings "github.com/CosmosContracts/juno/v23/x/feepay/bindings"
 	feepaykeeper "github.com/CosmosContracts/juno/v23/x/feepay/keeper"
 	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	feesharebindings "github.com/CosmosContracts/juno/v23/x/feeshare/bindings"
 	feesharekeeper "github.com/CosmosContracts/juno/v23/x/feeshare/keeper"
 	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
 	"github.com/CosmosContracts/juno/v23/x/globalfee"
@@ -105,9 +110,13 @@ import (
 	globalfeetypes "github.com/CosmosContracts/juno/v23/x/globalfee/types"
 	mintkeeper "github.com/CosmosContracts/juno/v23/x/mint/keeper"
 	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+	msgfilterkeeper "github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
+	msgfiltertypes "github.com/CosmosContracts/juno/v23/x/msgfilter/types"
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings"
 	tokenfactorykeeper "github.com/CosmosContracts/juno/v23/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+	wasmconfigkeeper "github.com/CosmosContracts/juno/v23/x/wasmconfig/keeper"
+	wasmconfigtypes "github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
 )
 
 var (
@@ -117,6 +126,7 @@ var (
 		tokenfactorytypes.EnableBurnFrom,
 		tokenfactorytypes.EnableForceTransfer,
 		tokenfactorytypes.EnableSetMetadata,
+		tokenfactorytypes.EnableFreeze,
 	}
 )
 
@@ -124,7 +134,7 @@ var (
 var maccPerms = map[string][]string{
 	authtypes.FeeCollectorName:     nil,
 	distrtypes.ModuleName:          nil,
-	minttypes.ModuleName:           {authtypes.Minter},
+	minttypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	govtypes.ModuleName:            {authtypes.Burner},
@@ -138,7 +148,8 @@ var maccPerms = map[string][]string{
 	globalfee.ModuleName:           nil,
 	buildertypes.ModuleName:        nil,
 	feepaytypes.ModuleName:         nil,
-	junoburn.ModuleName:            {authtypes.Burner},
+	burntypes.ModuleName:           {authtypes.Burner},
+	driptypes.ModuleName:           nil,
 }
 
 type AppKeepers struct {
@@ -149,7 +160,7 @@ type AppKeepers struct {
 
 	// keepers
 	AccountKeeper       authkeeper.AccountKeeper
-	BankKeeper          bankkeeper.Keeper
+	BankKeeper          tokenfactorykeeper.HookedBankKeeper
 	BuildKeeper         builderkeeper.Keeper
 	CapabilityKeeper    *capabilitykeeper.Keeper
 	StakingKeeper       *stakingkeeper.Keeper
@@ -196,6 +207,10 @@ type AppKeepers struct {
 	TokenFactoryKeeper tokenfactorykeeper.Keeper
 
 	DripKeeper dripkeeper.Keeper
+	BurnKeeper burnkeeper.Keeper
+
+	MsgFilterKeeper  msgfilterkeeper.Keeper
+	WasmConfigKeeper wasmconfigkeeper.Keeper
 
 	// Middleware wrapper
 	Ics20WasmHooks   *ibc_hooks.WasmHooks
@@ -257,13 +272,15 @@ func NewAppKeepers(
 		govModAddress,
 	)
 
-	appKeepers.BankKeeper = bankkeeper.NewBaseKeeper(
+	// Tokenfactory before send hooks are set once the wasm keeper is created
+	hookedBankKeeper := tokenfactorykeeper.NewHookedBankKeeper(bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		appKeepers.AccountKeeper,
 		BlockedAddresses(),
 		govModAddress,
-	)
+	))
+	appKeepers.BankKeeper = hookedBankKeeper
 
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec,
@@ -272,21 +289,22 @@ func NewAppKeepers(
 		appKeepers.BankKeeper,
 		govModAddress,
 	)
-	appKeepers.MintKeeper = mintkeeper.NewKeeper(
+	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[minttypes.StoreKey],
-		stakingKeeper,
+		appKeepers.keys[distrtypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		stakingKeeper,
 		authtypes.FeeCollectorName,
 		govModAddress,
 	)
-	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
+	appKeepers.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[distrtypes.StoreKey],
+		appKeepers.keys[minttypes.StoreKey],
+		stakingKeeper,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
-		stakingKeeper,
+		appKeepers.DistrKeeper,
 		authtypes.FeeCollectorName,
 		govModAddress,
 	)
@@ -437,6 +455,14 @@ func NewAppKeepers(
 		govModAddress,
 	)
 
+	appKeepers.MsgFilterKeeper = msgfilterkeeper.NewKeeper(
+		appKeepers.keys[msgfiltertypes.StoreKey],
+		appCodec,
+		bApp.MsgServiceRouter(),
+		stakingKeeper,
+		govModAddress,
+	)
+
 	appKeepers.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[icahosttypes.StoreKey],
@@ -446,7 +472,8 @@ func NewAppKeepers(
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
 		scopedICAHostKeeper,
-		bApp.MsgServiceRouter(),
+		// blocked messages cannot be executed by interchain accounts either
+		msgfilterkeeper.NewMessageRouter(appKeepers.MsgFilterKeeper, bApp.MsgServiceRouter()),
 	)
 	appKeepers.ICAHostKeeper.WithQueryRouter(bApp.GRPCQueryRouter())
 
@@ -499,32 +526,15 @@ func NewAppKeepers(
 	tfOpts := bindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)
 	wasmOpts = append(wasmOpts, tfOpts...)
 
+	// Custom msgs for contracts to manage their own feepay, feeshare, clock and cw-hooks registrations.
+	// These keepers are created after the wasm keeper, so they are passed by reference.
+	wasmOpts = append(wasmOpts, feepaybindings.RegisterCustomPlugins(&appKeepers.FeePayKeeper)...)
+	wasmOpts = append(wasmOpts, feesharebindings.RegisterCustomPlugins(&appKeepers.FeeShareKeeper)...)
+	wasmOpts = append(wasmOpts, clockbindings.RegisterCustomPlugins(&appKeepers.ClockKeeper)...)
+	wasmOpts = append(wasmOpts, cwhooksbindings.RegisterCustomPlugins(&appKeepers.CWHooksKeeper)...)
+
 	// Stargate Queries
-	acceptedStargateQueries := wasmkeeper.AcceptedStargateQueries{
-		// ibc
-		"/ibc.core.client.v1.Query/ClientState":    &ibcclienttypes.QueryClientStateResponse{},
-		"/ibc.core.client.v1.Query/ConsensusState": &ibcclienttypes.QueryConsensusStateResponse{},
-		"/ibc.core.connection.v1.Query/Connection": &ibcconnectiontypes.QueryConnectionResponse{},
-
-		// governance
-		"/cosmos.gov.v1beta1.Query/Vote": &govv1.QueryVoteResponse{},
-
-		// distribution
-		"/cosmos.distribution.v1beta1.Query/DelegationRewards": &distrtypes.QueryDelegationRewardsResponse{},
-
-		// staking
-		"/cosmos.staking.v1beta1.Query/Delegation":          &stakingtypes.QueryDelegationResponse{},
-		"/cosmos.staking.v1beta1.Query/Redelegations":       &stakingtypes.QueryRedelegationsResponse{},
-		"/cosmos.staking.v1beta1.Query/UnbondingDelegation": &stakingtypes.QueryUnbondingDelegationResponse{},
-		"/cosmos.staking.v1beta1.Query/Validator":           &stakingtypes.QueryValidatorResponse{},
-		"/cosmos.staking.v1beta1.Query/Params":              &stakingtypes.QueryParamsResponse{},
-		"/cosmos.staking.v1beta1.Query/Pool":                &stakingtypes.QueryPoolResponse{},
-
-		// token factory
-		"/osmosis.tokenfactory.v1beta1.Query/Params":                 &tokenfactorytypes.QueryParamsResponse{},
-		"/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata": &tokenfactorytypes.QueryDenomAuthorityMetadataResponse{},
-		"/osmosis.tokenfactory.v1beta1.Query/DenomsFromCreator":      &tokenfactorytypes.QueryDenomsFromCreatorResponse{},
-	}
+	acceptedStargateQueries := AcceptedStargateQueries()
 
 	querierOpts := wasmkeeper.WithQueryPlugins(
 		&wasmkeeper.QueryPlugins{
@@ -532,7 +542,15 @@ func NewAppKeepers(
 		})
 	wasmOpts = append(wasmOpts, querierOpts)
 
-	junoBurnerPlugin := junoburn.NewBurnerPlugin(appKeepers.BankKeeper, appKeepers.MintKeeper)
+	appKeepers.BurnKeeper = burnkeeper.NewKeeper(
+		appKeepers.keys[burntypes.StoreKey],
+		appCodec,
+		appKeepers.BankKeeper,
+		appKeepers.MintKeeper,
+		govModAddress,
+	)
+
+	junoBurnerPlugin := junoburn.NewBurnerPlugin(appKeepers.BankKeeper, appKeepers.MintKeeper, appKeepers.BurnKeeper)
 
 	// ref: https://github.com/CosmWasm/wasmd/issues/1735
 	burnMessageHandler := wasmkeeper.WithMessageHandlerDecorator(func(nested wasmkeeper.Messenger) wasmkeeper.Messenger {
@@ -544,6 +562,14 @@ func NewAppKeepers(
 
 	wasmOpts = append(wasmOpts, burnMessageHandler)
 
+	// The wasm gas costs are set by governance and applied at the beginning of each block
+	appKeepers.WasmConfigKeeper = wasmconfigkeeper.NewKeeper(
+		appKeepers.keys[wasmconfigtypes.StoreKey],
+		appCodec,
+		govModAddress,
+	)
+	wasmOpts = append(wasmOpts, wasmkeeper.WithGasRegister(appKeepers.WasmConfigKeeper.GetGasRegister()))
+
 	mainWasmer, err := wasmvm.NewVM(path.Join(dataDir, "wasm"), wasmCapabilities, 32, wasmConfig.ContractDebugMode, wasmConfig.MemoryCacheSize)
 	if err != nil {
 		panic(fmt.Sprintf("failed to create juno wasm vm: %s", err))
@@ -614,6 +640,10 @@ func NewAppKeepers(
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(&appKeepers.WasmKeeper)
 	appKeepers.Ics20WasmHooks.ContractKeeper = &appKeepers.WasmKeeper
 
+	// set the contract keeper for the tokenfactory before send hooks
+	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.ContractKeeper)
+	hookedBankKeeper.SetHooks(appKeepers.TokenFactoryKeeper)
+
 	appKeepers.FeeShareKeeper = feesharekeeper.NewKeeper(
 		appKeepers.keys[feesharetypes.StoreKey],
 		appCodec,
@@ -634,6 +664,8 @@ func NewAppKeepers(
 		appKeepers.keys[driptypes.StoreKey],
 		appCodec,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		stakingKeeper,
 		authtypes.FeeCollectorName,
 		govModAddress,
 	)
@@ -689,6 +721,8 @@ func NewAppKeepers(
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
+	// outermost so the contracts registered for a channel see every packet of the transfer port
+	transferStack = cwhooks.NewIBCMiddleware(transferStack, appKeepers.CWHooksKeeper)
 
 	// initialize ICA module with mock module as the authentication module on the controller side
 	var icaControllerStack porttypes.IBCModule
@@ -32,6 +32,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	burntypes "github.com/CosmosContracts/juno/v23/x/burn/types"
 	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
 	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
 	driptypes "github.com/CosmosContracts/juno/v23/x/drip/types"
@@ -39,7 +40,9 @@ import (
 	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
 	globalfeetypes "github.com/CosmosContracts/juno/v23/x/globalfee/types"
 	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+	msgfiltertypes "github.com/CosmosContracts/juno/v23/x/msgfilter/types"
 	tokenfactorytypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+	wasmconfigtypes "github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -64,6 +67,9 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		driptypes.StoreKey,
 		clocktypes.StoreKey,
 		cwhookstypes.StoreKey,
+		burntypes.StoreKey,
+		msgfiltertypes.StoreKey,
+		wasmconfigtypes.StoreKey,
 	)
 
 	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
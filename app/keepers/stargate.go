@@ -0,0 +1,100 @@
+package keepers
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
+	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+	driptypes "github.com/CosmosContracts/juno/v23/x/drip/types"
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
+	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+// AcceptedStargateQueries returns the gRPC queries contracts can run through
+// stargate queries, with their response types. Only deterministic queries,
+// which track their gas usage, can be added.
+func AcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		// ibc
+		"/ibc.core.client.v1.Query/ClientState":    &ibcclienttypes.QueryClientStateResponse{},
+		"/ibc.core.client.v1.Query/ConsensusState": &ibcclienttypes.QueryConsensusStateResponse{},
+		"/ibc.core.connection.v1.Query/Connection": &ibcconnectiontypes.QueryConnectionResponse{},
+
+		// auth
+		"/cosmos.auth.v1beta1.Query/Account": &authtypes.QueryAccountResponse{},
+
+		// bank
+		"/cosmos.bank.v1beta1.Query/Balance":       &banktypes.QueryBalanceResponse{},
+		"/cosmos.bank.v1beta1.Query/SupplyOf":      &banktypes.QuerySupplyOfResponse{},
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomMetadataResponse{},
+
+		// governance
+		"/cosmos.gov.v1beta1.Query/Vote": &govv1.QueryVoteResponse{},
+
+		// distribution
+		"/cosmos.distribution.v1beta1.Query/DelegationRewards": &distrtypes.QueryDelegationRewardsResponse{},
+
+		// staking
+		"/cosmos.staking.v1beta1.Query/Delegation":          &stakingtypes.QueryDelegationResponse{},
+		"/cosmos.staking.v1beta1.Query/Redelegations":       &stakingtypes.QueryRedelegationsResponse{},
+		"/cosmos.staking.v1beta1.Query/UnbondingDelegation": &stakingtypes.QueryUnbondingDelegationResponse{},
+		"/cosmos.staking.v1beta1.Query/Validator":           &stakingtypes.QueryValidatorResponse{},
+		"/cosmos.staking.v1beta1.Query/Params":              &stakingtypes.QueryParamsResponse{},
+		"/cosmos.staking.v1beta1.Query/Pool":                &stakingtypes.QueryPoolResponse{},
+
+		// token factory
+		"/osmosis.tokenfactory.v1beta1.Query/Params":                 &tokenfactorytypes.QueryParamsResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata": &tokenfactorytypes.QueryDenomAuthorityMetadataResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomsFromCreator":      &tokenfactorytypes.QueryDenomsFromCreatorResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomMaxSupply":         &tokenfactorytypes.QueryDenomMaxSupplyResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/BeforeSendHookAddress":  &tokenfactorytypes.QueryBeforeSendHookAddressResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomRoles":             &tokenfactorytypes.QueryDenomRolesResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomPaused":            &tokenfactorytypes.QueryDenomPausedResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/FrozenAccounts":         &tokenfactorytypes.QueryFrozenAccountsResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/AllDenoms":              &tokenfactorytypes.QueryAllDenomsResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomCapabilities":      &tokenfactorytypes.QueryDenomCapabilitiesResponse{},
+		"/osmosis.tokenfactory.v1beta1.Query/DenomAdmins":            &tokenfactorytypes.QueryDenomAdminsResponse{},
+
+		// feepay
+		"/juno.feepay.v1.Query/Params":                 &feepaytypes.QueryParamsResponse{},
+		"/juno.feepay.v1.Query/FeePayContract":         &feepaytypes.QueryFeePayContractResponse{},
+		"/juno.feepay.v1.Query/FeePayContractUses":     &feepaytypes.QueryFeePayContractUsesResponse{},
+		"/juno.feepay.v1.Query/FeePayWalletIsEligible": &feepaytypes.QueryFeePayWalletIsEligibleResponse{},
+
+		// feeshare
+		"/juno.feeshare.v1.Query/Params":              &feesharetypes.QueryParamsResponse{},
+		"/juno.feeshare.v1.Query/FeeShare":            &feesharetypes.QueryFeeShareResponse{},
+		"/juno.feeshare.v1.Query/DeployerFeeShares":   &feesharetypes.QueryDeployerFeeSharesResponse{},
+		"/juno.feeshare.v1.Query/WithdrawerFeeShares": &feesharetypes.QueryWithdrawerFeeSharesResponse{},
+
+		// clock
+		"/juno.clock.v1.Query/Params":        &clocktypes.QueryParamsResponse{},
+		"/juno.clock.v1.Query/ClockContract": &clocktypes.QueryClockContractResponse{},
+
+		// cw-hooks
+		"/juno.cwhooks.v1.Query/Params":              &cwhookstypes.QueryParamsResponse{},
+		"/juno.cwhooks.v1.Query/StakingContracts":    &cwhookstypes.QueryStakingContractsResponse{},
+		"/juno.cwhooks.v1.Query/GovernanceContracts": &cwhookstypes.QueryGovernanceContractsResponse{},
+
+		// drip
+		"/juno.drip.v1.Query/Params": &driptypes.QueryParamsResponse{},
+
+		// mint
+		"/juno.mint.Query/Params":           &minttypes.QueryParamsResponse{},
+		"/juno.mint.Query/Inflation":        &minttypes.QueryInflationResponse{},
+		"/juno.mint.Query/AnnualProvisions": &minttypes.QueryAnnualProvisionsResponse{},
+		"/juno.mint.Query/TargetSupply":     &minttypes.QueryTargetSupplyResponse{},
+		"/juno.mint.Query/Tokenomics":       &minttypes.QueryTokenomicsResponse{},
+	}
+}
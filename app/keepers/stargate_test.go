@@ -0,0 +1,134 @@
+package keepers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/app/keepers"
+	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
+	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+	driptypes "github.com/CosmosContracts/juno/v23/x/drip/types"
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
+	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+// TestAcceptedStargateQueries ensures every whitelisted path is routed by the
+// app and answers identically, including gas, when queried twice from a
+// contract. Juno, bank and auth queries must also succeed against live state.
+func TestAcceptedStargateQueries(t *testing.T) {
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{Height: 9})
+
+	bondDenom := junoApp.AppKeepers.StakingKeeper.BondDenom(ctx)
+	holder := sdk.AccAddress([]byte("stargate-holder_____"))
+	contract := sdk.AccAddress([]byte("stargate-contract___"))
+
+	junoApp.AppKeepers.AccountKeeper.SetAccount(ctx, junoApp.AppKeepers.AccountKeeper.NewAccountWithAddress(ctx, holder))
+	junoApp.AppKeepers.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    bondDenom,
+		Display: bondDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: bondDenom, Exponent: 0},
+		},
+	})
+	junoApp.AppKeepers.FeePayKeeper.SetFeePayContract(ctx, feepaytypes.FeePayContract{
+		ContractAddress: contract.String(),
+		Balance:         1_000,
+		WalletLimit:     5,
+	})
+	junoApp.AppKeepers.FeeShareKeeper.SetFeeShare(ctx, feesharetypes.NewFeeShare(contract, holder, holder))
+	junoApp.AppKeepers.FeeShareKeeper.SetDeployerMap(ctx, holder, contract)
+	junoApp.AppKeepers.FeeShareKeeper.SetWithdrawerMap(ctx, holder, contract)
+	require.NoError(t, junoApp.AppKeepers.ClockKeeper.SetClockContract(ctx, clocktypes.ClockContract{
+		ContractAddress: contract.String(),
+	}))
+
+	requests := map[string]proto.Message{
+		"/cosmos.auth.v1beta1.Query/Account":       &authtypes.QueryAccountRequest{Address: holder.String()},
+		"/cosmos.bank.v1beta1.Query/Balance":       &banktypes.QueryBalanceRequest{Address: holder.String(), Denom: bondDenom},
+		"/cosmos.bank.v1beta1.Query/SupplyOf":      &banktypes.QuerySupplyOfRequest{Denom: bondDenom},
+		"/cosmos.bank.v1beta1.Query/DenomMetadata": &banktypes.QueryDenomMetadataRequest{Denom: bondDenom},
+
+		"/juno.feepay.v1.Query/Params":                 &feepaytypes.QueryParamsRequest{},
+		"/juno.feepay.v1.Query/FeePayContract":         &feepaytypes.QueryFeePayContract{ContractAddress: contract.String()},
+		"/juno.feepay.v1.Query/FeePayContractUses":     &feepaytypes.QueryFeePayContractUses{ContractAddress: contract.String(), WalletAddress: holder.String()},
+		"/juno.feepay.v1.Query/FeePayWalletIsEligible": &feepaytypes.QueryFeePayWalletIsEligible{ContractAddress: contract.String(), WalletAddress: holder.String()},
+
+		"/juno.feeshare.v1.Query/Params":              &feesharetypes.QueryParamsRequest{},
+		"/juno.feeshare.v1.Query/FeeShare":            &feesharetypes.QueryFeeShareRequest{ContractAddress: contract.String()},
+		"/juno.feeshare.v1.Query/DeployerFeeShares":   &feesharetypes.QueryDeployerFeeSharesRequest{DeployerAddress: holder.String()},
+		"/juno.feeshare.v1.Query/WithdrawerFeeShares": &feesharetypes.QueryWithdrawerFeeSharesRequest{WithdrawerAddress: holder.String()},
+
+		"/juno.clock.v1.Query/Params":        &clocktypes.QueryParamsRequest{},
+		"/juno.clock.v1.Query/ClockContract": &clocktypes.QueryClockContract{ContractAddress: contract.String()},
+
+		"/juno.cwhooks.v1.Query/Params":              &cwhookstypes.QueryParamsRequest{},
+		"/juno.cwhooks.v1.Query/StakingContracts":    &cwhookstypes.QueryStakingContractsRequest{},
+		"/juno.cwhooks.v1.Query/GovernanceContracts": &cwhookstypes.QueryGovernanceContractsRequest{},
+
+		"/juno.drip.v1.Query/Params": &driptypes.QueryParamsRequest{},
+
+		"/juno.mint.Query/Params":           &minttypes.QueryParamsRequest{},
+		"/juno.mint.Query/Inflation":        &minttypes.QueryInflationRequest{},
+		"/juno.mint.Query/AnnualProvisions": &minttypes.QueryAnnualProvisionsRequest{},
+		"/juno.mint.Query/TargetSupply":     &minttypes.QueryTargetSupplyRequest{},
+		"/juno.mint.Query/Tokenomics":       &minttypes.QueryTokenomicsRequest{},
+	}
+
+	accepted := keepers.AcceptedStargateQueries()
+	querier := wasmkeeper.AcceptListStargateQuerier(accepted, junoApp.GRPCQueryRouter(), junoApp.AppCodec())
+
+	for path := range requests {
+		require.Contains(t, accepted, path)
+	}
+
+	for path := range accepted {
+		path := path
+		t.Run(path, func(t *testing.T) {
+			require.NotNil(t, junoApp.GRPCQueryRouter().Route(path), "path is not routed")
+
+			if strings.HasPrefix(path, "/juno.") {
+				require.Contains(t, requests, path, "juno query has no request in this test")
+			}
+
+			var data []byte
+			if req, ok := requests[path]; ok {
+				bz, err := proto.Marshal(req)
+				require.NoError(t, err)
+				data = bz
+			}
+
+			query := func() ([]byte, sdk.Gas, error) {
+				cacheCtx, _ := ctx.CacheContext()
+				cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				res, err := querier(cacheCtx, &wasmvmtypes.StargateQuery{Path: path, Data: data})
+				return res, cacheCtx.GasMeter().GasConsumed(), err
+			}
+
+			res, gas, err := query()
+			resAgain, gasAgain, errAgain := query()
+			require.Equal(t, res, resAgain)
+			require.Equal(t, err, errAgain)
+			require.Equal(t, gas, gasAgain)
+
+			if _, ok := requests[path]; ok {
+				require.NoError(t, err)
+				require.NotEmpty(t, res)
+			}
+		})
+	}
+}
@@ -62,6 +62,8 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	encparams "github.com/CosmosContracts/juno/v23/app/params"
+	"github.com/CosmosContracts/juno/v23/x/burn"
+	burntypes "github.com/CosmosContracts/juno/v23/x/burn/types"
 	"github.com/CosmosContracts/juno/v23/x/clock"
 	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
 	cwhooks "github.com/CosmosContracts/juno/v23/x/cw-hooks"
@@ -74,8 +76,12 @@ import (
 	"github.com/CosmosContracts/juno/v23/x/globalfee"
 	"github.com/CosmosContracts/juno/v23/x/mint"
 	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter"
+	msgfiltertypes "github.com/CosmosContracts/juno/v23/x/msgfilter/types"
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory"
 	tokenfactorytypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig"
+	wasmconfigtypes "github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
 )
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -111,6 +117,9 @@ var ModuleBasics = module.NewBasicManager(
 	feegrantmodule.AppModuleBasic{},
 	tokenfactory.AppModuleBasic{},
 	drip.AppModuleBasic{},
+	burn.AppModuleBasic{},
+	msgfilter.AppModuleBasic{},
+	wasmconfig.AppModuleBasic{},
 	feepay.AppModuleBasic{},
 	feeshare.AppModuleBasic{},
 	globalfee.AppModuleBasic{},
@@ -139,7 +148,7 @@ func appModules(
 		),
 		auth.NewAppModule(appCodec, app.AppKeepers.AccountKeeper, nil, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper),
-		bank.NewAppModule(appCodec, app.AppKeepers.BankKeeper, app.AppKeepers.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		newBankModule(appCodec, app.AppKeepers.BankKeeper, app.AppKeepers.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.AppKeepers.CapabilityKeeper, false),
 		feegrantmodule.NewAppModule(appCodec, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.AppKeepers.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.AppKeepers.GovKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
@@ -165,6 +174,9 @@ func appModules(
 		crisis.NewAppModule(app.AppKeepers.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		buildermodule.NewAppModule(appCodec, app.AppKeepers.BuildKeeper),
 		drip.NewAppModule(app.AppKeepers.DripKeeper, app.AppKeepers.AccountKeeper),
+		burn.NewAppModule(app.AppKeepers.BurnKeeper),
+		msgfilter.NewAppModule(app.AppKeepers.MsgFilterKeeper),
+		wasmconfig.NewAppModule(app.AppKeepers.WasmConfigKeeper),
 		clock.NewAppModule(appCodec, app.AppKeepers.ClockKeeper),
 		cwhooks.NewAppModule(appCodec, app.AppKeepers.CWHooksKeeper),
 		// IBC modules
@@ -240,6 +252,9 @@ func orderBeginBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		burntypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		wasmconfigtypes.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -281,6 +296,9 @@ func orderEndBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		burntypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		wasmconfigtypes.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -322,6 +340,9 @@ func orderInitBlockers() []string {
 		icqtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		driptypes.ModuleName,
+		burntypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		wasmconfigtypes.ModuleName,
 		feepaytypes.ModuleName,
 		feesharetypes.ModuleName,
 		globalfee.ModuleName,
@@ -155,7 +155,7 @@ func setup(t *testing.T, withGenesis bool, opts ...wasmkeeper.Option) (*App, Gen
 		db,
 		nil,
 		true,
-		EmptyAppOptions{},
+		appOptions,
 		opts,
 		bam.SetChainID("testing"),
 		bam.SetSnapshot(snapshotStore, snapshottypes.SnapshotOptions{KeepRecent: 2}),
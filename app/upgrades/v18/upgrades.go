@@ -9,7 +9,6 @@ import (
 
 	"github.com/CosmosContracts/juno/v23/app/keepers"
 	"github.com/CosmosContracts/juno/v23/app/upgrades"
-	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
 	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
 )
 
@@ -33,8 +32,9 @@ func CreateV18UpgradeHandler(
 		logger.Info(fmt.Sprintf("post migrate version map: %v", versionMap))
 
 		// x/cw-hooks
-		gasLimit := uint64(250_000)
-		if err := k.CWHooksKeeper.SetParams(ctx, cwhookstypes.NewParams(gasLimit)); err != nil {
+		cwHooksParams := k.CWHooksKeeper.GetParams(ctx)
+		cwHooksParams.ContractGasLimit = uint64(250_000)
+		if err := k.CWHooksKeeper.SetParams(ctx, cwHooksParams); err != nil {
 			return nil, err
 		}
 
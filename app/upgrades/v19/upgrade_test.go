@@ -12,7 +12,6 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmosContracts/juno/v23/app/apptesting"
-	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
 	v19 "github.com/CosmosContracts/juno/v23/app/upgrades/v19"
 )
 
@@ -114,6 +113,6 @@ func postUpgradeChecks(s *UpgradeTestSuite) {
 	// Ensure all validators have a max change rate of 5%
 	validators := s.App.AppKeepers.StakingKeeper.GetAllValidators(s.Ctx)
 	for _, validator := range validators {
-		s.Require().True(validator.Commission.MaxChangeRate.LTE(sdk.MustNewDecFromStr(decorators.MaxChangeRate)))
+		s.Require().True(validator.Commission.MaxChangeRate.LTE(sdk.MustNewDecFromStr("0.05")))
 	}
 }
@@ -13,7 +13,6 @@ import (
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
-	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
 	"github.com/CosmosContracts/juno/v23/app/keepers"
 	"github.com/CosmosContracts/juno/v23/app/upgrades"
 	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
@@ -40,7 +39,7 @@ func CreateV19UpgradeHandler(
 
 		// Change Rate Decorator Migration
 		// Ensure all Validators have a max change rate of 5%
-		maxChangeRate := sdk.MustNewDecFromStr(decorators.MaxChangeRate)
+		maxChangeRate := sdk.MustNewDecFromStr("0.05")
 		validators := k.StakingKeeper.GetAllValidators(ctx)
 
 		for _, validator := range validators {
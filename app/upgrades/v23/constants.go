@@ -4,6 +4,9 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/CosmosContracts/juno/v23/app/upgrades"
+	burntypes "github.com/CosmosContracts/juno/v23/x/burn/types"
+	msgfiltertypes "github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+	wasmconfigtypes "github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the upgrade.
@@ -12,5 +15,11 @@ const UpgradeName = "v23"
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateV23UpgradeHandler,
-	StoreUpgrades:        store.StoreUpgrades{},
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			burntypes.StoreKey,
+			msgfiltertypes.StoreKey,
+			wasmconfigtypes.StoreKey,
+		},
+	},
 }
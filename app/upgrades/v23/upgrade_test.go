@@ -5,8 +5,12 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	"github.com/CosmosContracts/juno/v23/app/apptesting"
 	v23 "github.com/CosmosContracts/juno/v23/app/upgrades/v23"
+	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+	wasmconfigtypes "github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
 )
 
 type UpgradeTestSuite struct {
@@ -32,8 +36,17 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 	postUpgradeChecks(s)
 }
 
-func preUpgradeChecks(_ *UpgradeTestSuite) {
+func preUpgradeChecks(s *UpgradeTestSuite) {
+	// the stored mint module account predates the burner permission
+	acc := s.App.AppKeepers.AccountKeeper.GetModuleAccount(s.Ctx, minttypes.ModuleName).(*authtypes.ModuleAccount)
+	acc.Permissions = []string{authtypes.Minter}
+	s.App.AppKeepers.AccountKeeper.SetModuleAccount(s.Ctx, acc)
 }
 
-func postUpgradeChecks(_ *UpgradeTestSuite) {
+func postUpgradeChecks(s *UpgradeTestSuite) {
+	acc := s.App.AppKeepers.AccountKeeper.GetModuleAccount(s.Ctx, minttypes.ModuleName)
+	s.Require().True(acc.HasPermission(authtypes.Minter))
+	s.Require().True(acc.HasPermission(authtypes.Burner))
+
+	s.Require().Equal(wasmconfigtypes.DefaultParams(), s.App.AppKeepers.WasmConfigKeeper.GetParams(s.Ctx))
 }
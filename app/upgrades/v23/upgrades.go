@@ -13,6 +13,7 @@ import (
 
 	"github.com/CosmosContracts/juno/v23/app/keepers"
 	"github.com/CosmosContracts/juno/v23/app/upgrades"
+	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
 )
 
 type IndividualAccount struct {
@@ -64,7 +65,9 @@ func CreateV23UpgradeHandler(
 			}
 		}
 
-		// Run migrations
+		// Run migrations. The modules added by this upgrade are initialized with
+		// their default genesis, which seeds the x/wasmconfig params, including
+		// the x/wasm size limits, with the wasmd defaults the chain enforced.
 		logger.Info(fmt.Sprintf("pre migrate version map: %v", vm))
 		versionMap, err := mm.RunMigrations(ctx, cfg, vm)
 		if err != nil {
@@ -73,6 +76,11 @@ func CreateV23UpgradeHandler(
 
 		logger.Info(fmt.Sprintf("post migrate version map: %v", versionMap))
 
+		// the mint module burns the burn share of the minted tokens
+		if err := addMintBurnerPermission(ctx, keepers); err != nil {
+			return nil, err
+		}
+
 		// convert pob builder account to an actual module account
 		// during upgrade from v15 to v16 it wasn't correctly created, and since it received tokens on mainnet is now a base account
 		// it's like this on both mainnet and uni
@@ -104,6 +112,22 @@ func CreateV23UpgradeHandler(
 	}
 }
 
+// addMintBurnerPermission adds the burner permission to the stored x/mint
+// module account, which was created with the minter permission only.
+func addMintBurnerPermission(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	acc, ok := keepers.AccountKeeper.GetModuleAccount(ctx, minttypes.ModuleName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("%s module account not found", minttypes.ModuleName)
+	}
+
+	if !acc.HasPermission(authtypes.Burner) {
+		acc.Permissions = append(acc.Permissions, authtypes.Burner)
+		keepers.AccountKeeper.SetModuleAccount(ctx, acc)
+	}
+
+	return nil
+}
+
 // Migrate balances from the Core-1 vesting accounts to the Council SubDAO.
 func migrateCore1VestingAccounts(ctx sdk.Context, keepers *keepers.AppKeepers, bondDenom string) error {
 	for _, account := range Core1VestingAccounts {
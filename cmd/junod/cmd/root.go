@@ -38,6 +38,7 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"github.com/CosmosContracts/juno/v23/app"
+	decorators "github.com/CosmosContracts/juno/v23/app/decorators"
 	"github.com/CosmosContracts/juno/v23/app/params"
 )
 
@@ -129,7 +130,8 @@ func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config
 
-		Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+		Wasm      wasmtypes.WasmConfig       `mapstructure:"wasm"`
+		RateLimit decorators.RateLimitConfig `mapstructure:"rate-limit"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -138,11 +140,12 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.MinGasPrices = "0ujuno,0ujunox" // GlobalFee handles
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultWasmConfig(),
+		Config:    *srvCfg,
+		Wasm:      wasmtypes.DefaultWasmConfig(),
+		RateLimit: decorators.DefaultRateLimitConfig(),
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate()
+	customAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate() + decorators.DefaultRateLimitConfigTemplate()
 
 	return customAppTemplate, customAppConfig
 }
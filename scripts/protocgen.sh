@@ -32,6 +32,7 @@ buf generate
 # move proto files to the right places
 cp -r ./github.com/CosmosContracts/juno/x/* x/
 cp -r ./github.com/cosmos/gaia/x/* x/
+cp -r ./github.com/CosmosContracts/juno/app/* app/
 
 
 rm -rf ./github.com
\ No newline at end of file
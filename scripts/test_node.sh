@@ -5,7 +5,7 @@
 # CHAIN_ID="local-1" HOME_DIR="~/.juno1" TIMEOUT_COMMIT="500ms" CLEAN=true sh scripts/test_node.sh
 # CHAIN_ID="local-2" HOME_DIR="~/.juno2" CLEAN=true RPC=36657 REST=2317 PROFF=6061 P2P=36656 GRPC=8090 GRPC_WEB=8091 ROSETTA=8081 TIMEOUT_COMMIT="500ms" sh scripts/test_node.sh
 #
-# To use unoptomized wasm files up to ~5mb, add: MAX_WASM_SIZE=5000000
+# To use unoptomized wasm files up to ~5mb, add: MAX_WASM_SIZE=5000000 (sets the max_wasm_size genesis param of x/wasmconfig)
 
 export KEY="juno1"
 export KEY2="juno2"
@@ -83,6 +83,11 @@ from_scratch () {
   # FeeShare
   update_test_genesis '.app_state["feeshare"]["params"]["allowed_denoms"]=["ujuno"]'
 
+  # WasmConfig
+  if [ -n "$MAX_WASM_SIZE" ]; then
+    update_test_genesis ".app_state[\"junowasmconfig\"][\"params\"][\"max_wasm_size\"]=\"$MAX_WASM_SIZE\""
+  fi
+
   # Builder keeper genesis state
 	update_test_genesis '.app_state["builder"]["params"]["front_running_protection"]=false'
 	update_test_genesis '.app_state["builder"]["params"]["max_bundle_size"]="4"'
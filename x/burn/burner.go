@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/CosmosContracts/juno/v23/x/burn/keeper"
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
 	mintkeeper "github.com/CosmosContracts/juno/v23/x/mint/keeper"
 )
 
@@ -14,37 +16,34 @@ import (
 type BurnerWasmPlugin struct {
 	bk bankkeeper.Keeper
 	mk mintkeeper.Keeper
+	k  keeper.Keeper
 }
 
 var _ wasmtypes.Burner = &BurnerWasmPlugin{}
 
-func NewBurnerPlugin(bk bankkeeper.Keeper, mk mintkeeper.Keeper) *BurnerWasmPlugin {
-	return &BurnerWasmPlugin{bk: bk, mk: mk}
+func NewBurnerPlugin(bk bankkeeper.Keeper, mk mintkeeper.Keeper, k keeper.Keeper) *BurnerWasmPlugin {
+	return &BurnerWasmPlugin{bk: bk, mk: mk, k: k}
 }
 
+// BurnCoins burns the coins sent to the module account by
+// SendCoinsFromAccountToModule, reducing the x/mint target supply and
+// updating the per-denom burn statistics.
 func (k *BurnerWasmPlugin) BurnCoins(ctx sdk.Context, _ string, amt sdk.Coins) error {
-	// first, try to burn the coins on bank module
-	err := k.bk.BurnCoins(ctx, ModuleName, amt)
-	if err != nil {
+	return k.k.BurnModuleCoins(ctx, amt)
+}
+
+// SendCoinsFromAccountToModule is only called by wasmd right before BurnCoins
+// when a contract burns tokens, so it is where the burning contract is known
+// and the burn is recorded. Both calls are part of the same message, so the
+// record is reverted if burning the coins fails.
+func (k *BurnerWasmPlugin) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
+	if err := k.k.AssertBurnable(ctx, amt); err != nil {
 		return err
 	}
 
-	// get mint params
-	params := k.mk.GetParams(ctx)
-
-	// loop the burned coins
-	for _, amount := range amt {
-		// if we are burning mint denom, reduce the target staking supply
-		if amount.Denom == params.MintDenom {
-			if err := k.mk.ReduceTargetSupply(ctx, amount); err != nil {
-				return err
-			}
-		}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, amt); err != nil {
+		return err
 	}
 
-	return nil
-}
-
-func (k *BurnerWasmPlugin) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, _ string, amt sdk.Coins) error {
-	return k.bk.SendCoinsFromAccountToModule(ctx, senderAddr, ModuleName, amt)
+	return k.mk.RecordBurn(ctx, senderAddr, amt)
 }
@@ -1,5 +0,0 @@
-package burn
-
-const (
-	ModuleName = "junoburn"
-)
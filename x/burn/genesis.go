@@ -0,0 +1,31 @@
+package burn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/keeper"
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, burn := range data.DenomBurns {
+		k.SetDenomBurn(ctx, burn)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		DenomBurns: k.GetAllDenomBurns(ctx),
+	}
+}
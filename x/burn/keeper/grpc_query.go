@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/burn keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns the burn module params
+func (q Querier) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomBurn returns the cumulative burn statistics of a denom
+func (q Querier) DenomBurn(
+	c context.Context,
+	req *types.QueryDenomBurnRequest,
+) (*types.QueryDenomBurnResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s", req.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDenomBurnResponse{
+		DenomBurn: q.GetDenomBurn(ctx, req.Denom),
+	}, nil
+}
+
+// DenomBurns returns the cumulative burn statistics of all burned denoms
+func (q Querier) DenomBurns(
+	c context.Context,
+	req *types.QueryDenomBurnsRequest,
+) (*types.QueryDenomBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	burns, pageRes, err := q.GetDenomBurns(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomBurnsResponse{
+		DenomBurns: burns,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+func (s *IntegrationTestSuite) TestBurnQueries() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.AppKeepers.BurnKeeper
+
+	params := types.NewParams(nil, []string{"ibc/"})
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	paramsRes, err := s.queryClient.Params(goCtx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, paramsRes.Params)
+
+	k.AddDenomBurn(s.ctx, sdk.NewCoin("stake", sdk.NewInt(10)))
+	k.AddDenomBurn(s.ctx, sdk.NewCoin("utoken", sdk.NewInt(20)))
+
+	burnRes, err := s.queryClient.DenomBurn(goCtx, &types.QueryDenomBurnRequest{Denom: "utoken"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(20), burnRes.DenomBurn.Amount)
+	s.Require().Equal(uint64(1), burnRes.DenomBurn.BurnCount)
+
+	// a denom that was never burned returns empty statistics
+	burnRes, err = s.queryClient.DenomBurn(goCtx, &types.QueryDenomBurnRequest{Denom: "unburned"})
+	s.Require().NoError(err)
+	s.Require().True(burnRes.DenomBurn.Amount.IsZero())
+
+	_, err = s.queryClient.DenomBurn(goCtx, &types.QueryDenomBurnRequest{Denom: "!"})
+	s.Require().Error(err)
+
+	burnsRes, err := s.queryClient.DenomBurns(goCtx, &types.QueryDenomBurnsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(burnsRes.DenomBurns, 1)
+	s.Require().Equal(uint64(2), burnsRes.Pagination.Total)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+// Keeper of this module burns tokens and keeps the x/mint supply targets and
+// the burn statistics in sync.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	bankKeeper types.BankKeeper
+	mintKeeper types.MintKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates new instances of the Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	bk types.BankKeeper,
+	mk types.MintKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		bankKeeper: bk,
+		mintKeeper: mk,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the x/burn module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// AssertBurnable returns an error if any of the denoms in amount cannot be
+// burned according to the module params.
+func (k Keeper) AssertBurnable(ctx sdk.Context, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range amount {
+		if !params.IsDenomBurnable(coin.Denom) {
+			return types.ErrDenomNotBurnable.Wrapf("denom: %s", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// BurnModuleCoins burns amt from the module account. Burning the x/mint denom
+// reduces the target supply, and the burn statistics of every burned denom
+// are updated.
+func (k Keeper) BurnModuleCoins(ctx sdk.Context, amt sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt); err != nil {
+		return err
+	}
+
+	mintDenom := k.mintKeeper.GetParams(ctx).MintDenom
+	for _, coin := range amt {
+		// if we are burning mint denom, reduce the target staking supply
+		if coin.Denom == mintDenom {
+			if err := k.mintKeeper.ReduceTargetSupply(ctx, coin); err != nil {
+				return err
+			}
+		}
+
+		k.AddDenomBurn(ctx, coin)
+	}
+
+	return nil
+}
+
+// BurnFromAccount transfers amt from the sender to the module account and
+// burns it. Contract burns are recorded by the x/mint keeper from the wasm
+// burner plugin instead, so only the x/mint total is updated here.
+func (k Keeper) BurnFromAccount(ctx sdk.Context, sender sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.AssertBurnable(ctx, amt); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amt); err != nil {
+		return err
+	}
+
+	if err := k.BurnModuleCoins(ctx, amt); err != nil {
+		return err
+	}
+
+	k.mintKeeper.AddTotalBurned(ctx, amt)
+	return nil
+}
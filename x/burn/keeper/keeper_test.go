@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/burn/keeper"
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	app           *app.App
+	bankKeeper    bankkeeper.Keeper
+	queryClient   types.QueryClient
+	burnMsgServer types.MsgServer
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	isCheckTx := false
+	s.app = app.Setup(s.T())
+
+	s.ctx = s.app.BaseApp.NewContext(isCheckTx, tmproto.Header{
+		ChainID: "testing",
+		Height:  9,
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.app.AppKeepers.BurnKeeper))
+
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.bankKeeper = s.app.AppKeepers.BankKeeper
+	s.burnMsgServer = s.app.AppKeepers.BurnKeeper
+}
+
+func (s *IntegrationTestSuite) FundAccount(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		return err
+	}
+
+	return s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, amounts)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) TestAssertBurnable() {
+	err := s.app.AppKeepers.BurnKeeper.SetParams(s.ctx, types.NewParams([]string{"ujuno"}, []string{"ibc/"}))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc    string
+		coins   sdk.Coins
+		success bool
+	}{
+		{"Success - allowed denom", sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1))), true},
+		{"Success - denom sharing the prefix of a denied denom", sdk.NewCoins(sdk.NewCoin("ujunox", sdk.NewInt(1))), true},
+		{"Fail - denied denom", sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1))), false},
+		{"Fail - denied prefix", sdk.NewCoins(sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewInt(1))), false},
+		{"Fail - one of the denoms is denied", sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1)), sdk.NewCoin("ujuno", sdk.NewInt(1))), false},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			err := s.app.AppKeepers.BurnKeeper.AssertBurnable(s.ctx, tc.coins)
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrDenomNotBurnable)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAddDenomBurn() {
+	k := s.app.AppKeepers.BurnKeeper
+
+	burn := k.GetDenomBurn(s.ctx, "stake")
+	s.Require().True(burn.Amount.IsZero())
+	s.Require().Zero(burn.BurnCount)
+
+	k.AddDenomBurn(s.ctx, sdk.NewCoin("stake", sdk.NewInt(100)))
+	k.AddDenomBurn(s.ctx.WithBlockHeight(12), sdk.NewCoin("stake", sdk.NewInt(50)))
+
+	burn = k.GetDenomBurn(s.ctx, "stake")
+	s.Require().Equal(sdk.NewInt(150), burn.Amount)
+	s.Require().Equal(uint64(2), burn.BurnCount)
+	s.Require().Equal(int64(12), burn.LastBurnHeight)
+
+	s.Require().Len(k.GetAllDenomBurns(s.ctx), 1)
+}
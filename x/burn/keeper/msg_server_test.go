@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+func (s *IntegrationTestSuite) TestBurnMsg() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	mintDenom := s.app.AppKeepers.MintKeeper.GetParams(s.ctx).MintDenom
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(
+		sdk.NewCoin(mintDenom, sdk.NewInt(1_000_000)),
+		sdk.NewCoin("utoken", sdk.NewInt(1_000_000)),
+		sdk.NewCoin(ibcDenom, sdk.NewInt(1_000_000)),
+	))
+
+	err := s.app.AppKeepers.BurnKeeper.SetParams(s.ctx, types.NewParams(nil, []string{"ibc/"}))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc       string
+		senderAddr string
+		coins      sdk.Coins
+		success    bool
+	}{
+		{
+			desc:       "Success - burn mint denom",
+			senderAddr: sender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin(mintDenom, sdk.NewInt(1_000))),
+			success:    true,
+		},
+		{
+			desc:       "Success - burn any other denom",
+			senderAddr: sender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("utoken", sdk.NewInt(1_000))),
+			success:    true,
+		},
+		{
+			desc:       "Fail - denied denom prefix",
+			senderAddr: sender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.NewInt(1_000))),
+			success:    false,
+		},
+		{
+			desc:       "Fail - insufficient funds",
+			senderAddr: sender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("notarealtoken", sdk.NewInt(1))),
+			success:    false,
+		},
+		{
+			desc:       "Fail - empty tokens",
+			senderAddr: sender.String(),
+			coins:      sdk.NewCoins(),
+			success:    false,
+		},
+		{
+			desc:       "Fail - no sender",
+			senderAddr: "",
+			coins:      sdk.NewCoins(sdk.NewCoin(mintDenom, sdk.NewInt(1))),
+			success:    false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			supplyBefore := s.app.AppKeepers.BankKeeper.GetSupply(s.ctx, "utoken")
+			targetBefore := s.app.AppKeepers.MintKeeper.GetMinter(s.ctx).TargetSupply
+
+			msg := types.MsgBurn{
+				SenderAddress: tc.senderAddr,
+				Amount:        tc.coins,
+			}
+
+			_, err := s.burnMsgServer.Burn(s.ctx, &msg)
+			if !tc.success {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			for _, coin := range tc.coins {
+				if coin.Denom == mintDenom {
+					s.Require().Equal(targetBefore.Sub(coin.Amount), s.app.AppKeepers.MintKeeper.GetMinter(s.ctx).TargetSupply)
+				} else {
+					s.Require().Equal(supplyBefore.Sub(coin), s.app.AppKeepers.BankKeeper.GetSupply(s.ctx, coin.Denom))
+				}
+
+				s.Require().Equal(coin.Amount, s.app.AppKeepers.BurnKeeper.GetDenomBurn(s.ctx, coin.Denom).Amount)
+				s.Require().Equal(coin.Amount, s.app.AppKeepers.MintKeeper.GetTotalBurnedAmount(s.ctx, coin.Denom))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestUpdateParams() {
+	govModuleAddr := s.app.AppKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+
+	for _, tc := range []struct {
+		desc      string
+		authority string
+		params    types.Params
+		success   bool
+	}{
+		{
+			desc:      "Success - gov authority",
+			authority: govModuleAddr.String(),
+			params:    types.NewParams([]string{"ujuno"}, []string{"ibc/"}),
+			success:   true,
+		},
+		{
+			desc:      "Fail - invalid authority",
+			authority: authtypes.NewModuleAddress("not_gov").String(),
+			params:    types.DefaultParams(),
+			success:   false,
+		},
+		{
+			desc:      "Fail - blank denied prefix",
+			authority: govModuleAddr.String(),
+			params:    types.NewParams(nil, []string{""}),
+			success:   false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.burnMsgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    tc.params,
+			})
+			if !tc.success {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.params, s.app.AppKeepers.BurnKeeper.GetParams(s.ctx))
+		})
+	}
+}
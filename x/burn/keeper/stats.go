@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/burn/types"
+)
+
+// GetDenomBurn returns the cumulative burn statistics of a denom.
+func (k Keeper) GetDenomBurn(ctx sdk.Context, denom string) types.DenomBurn {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBurnKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.NewDenomBurn(denom)
+	}
+
+	var burn types.DenomBurn
+	k.cdc.MustUnmarshal(bz, &burn)
+	return burn
+}
+
+// SetDenomBurn stores the cumulative burn statistics of a denom.
+func (k Keeper) SetDenomBurn(ctx sdk.Context, burn types.DenomBurn) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBurnKeyPrefix)
+	bz := k.cdc.MustMarshal(&burn)
+	store.Set([]byte(burn.Denom), bz)
+}
+
+// AddDenomBurn adds a burn of coin to the burn statistics of its denom.
+func (k Keeper) AddDenomBurn(ctx sdk.Context, coin sdk.Coin) {
+	burn := k.GetDenomBurn(ctx, coin.Denom)
+	burn.Amount = burn.Amount.Add(coin.Amount)
+	burn.BurnCount++
+	burn.LastBurnHeight = ctx.BlockHeight()
+	k.SetDenomBurn(ctx, burn)
+}
+
+// GetAllDenomBurns returns the cumulative burn statistics of all burned
+// denoms.
+func (k Keeper) GetAllDenomBurns(ctx sdk.Context) []types.DenomBurn {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomBurnKeyPrefix)
+	defer iterator.Close()
+
+	burns := []types.DenomBurn{}
+	for ; iterator.Valid(); iterator.Next() {
+		var burn types.DenomBurn
+		k.cdc.MustUnmarshal(iterator.Value(), &burn)
+		burns = append(burns, burn)
+	}
+
+	return burns
+}
+
+// GetDenomBurns returns a page of the cumulative burn statistics of all
+// burned denoms.
+func (k Keeper) GetDenomBurns(ctx sdk.Context, pag *query.PageRequest) ([]types.DenomBurn, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomBurnKeyPrefix)
+
+	burns := []types.DenomBurn{}
+	pageRes, err := query.Paginate(store, pag, func(_, value []byte) error {
+		var burn types.DenomBurn
+		if err := k.cdc.Unmarshal(value, &burn); err != nil {
+			return err
+		}
+
+		burns = append(burns, burn)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return burns, pageRes, nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomBurn returns empty burn statistics for a denom.
+func NewDenomBurn(denom string) DenomBurn {
+	return DenomBurn{
+		Denom:  denom,
+		Amount: sdk.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the burn statistics.
+func (b DenomBurn) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return ErrInvalidDenom.Wrapf("%s: %s", b.Denom, err)
+	}
+
+	if b.Amount.IsNil() || b.Amount.IsNegative() {
+		return fmt.Errorf("burned amount of %s cannot be negative", b.Denom)
+	}
+
+	if b.LastBurnHeight < 0 {
+		return fmt.Errorf("last burn height of %s cannot be negative: %d", b.Denom, b.LastBurnHeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/burn/v1/burn.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the burn module params
+type Params struct {
+	// denied_denoms defines the denoms that cannot be burned
+	DeniedDenoms []string `protobuf:"bytes,1,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty"`
+	// denied_denom_prefixes defines the denom prefixes that cannot be burned,
+	// e.g. "ibc/" to require IBC vouchers to be returned to their source chain
+	DeniedDenomPrefixes []string `protobuf:"bytes,2,rep,name=denied_denom_prefixes,json=deniedDenomPrefixes,proto3" json:"denied_denom_prefixes,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44343d7917152f5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedDenomPrefixes() []string {
+	if m != nil {
+		return m.DeniedDenomPrefixes
+	}
+	return nil
+}
+
+// DenomBurn defines the cumulative burn statistics of a denom
+type DenomBurn struct {
+	// denom is the burned denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount of the denom burned
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// burn_count is the number of burns of the denom
+	BurnCount uint64 `protobuf:"varint,3,opt,name=burn_count,json=burnCount,proto3" json:"burn_count,omitempty"`
+	// last_burn_height is the height of the latest burn of the denom
+	LastBurnHeight int64 `protobuf:"varint,4,opt,name=last_burn_height,json=lastBurnHeight,proto3" json:"last_burn_height,omitempty"`
+}
+
+func (m *DenomBurn) Reset()         { *m = DenomBurn{} }
+func (m *DenomBurn) String() string { return proto.CompactTextString(m) }
+func (*DenomBurn) ProtoMessage()    {}
+func (*DenomBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f44343d7917152f5, []int{1}
+}
+func (m *DenomBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBurn.Merge(m, src)
+}
+func (m *DenomBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBurn proto.InternalMessageInfo
+
+func (m *DenomBurn) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomBurn) GetBurnCount() uint64 {
+	if m != nil {
+		return m.BurnCount
+	}
+	return 0
+}
+
+func (m *DenomBurn) GetLastBurnHeight() int64 {
+	if m != nil {
+		return m.LastBurnHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "juno.burn.v1.Params")
+	proto.RegisterType((*DenomBurn)(nil), "juno.burn.v1.DenomBurn")
+}
+
+func init() { proto.RegisterFile("juno/burn/v1/burn.proto", fileDescriptor_f44343d7917152f5) }
+
+var fileDescriptor_f44343d7917152f5 = []byte{
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
+	0x14, 0x85, 0x3b, 0xc0, 0x4f, 0xd2, 0x09, 0xbf, 0x31, 0x15, 0x62, 0x25, 0xb1, 0x34, 0xb8, 0x69,
+	0xa2, 0xb6, 0x41, 0xdf, 0x80, 0x1a, 0x23, 0x3b, 0xd2, 0xa5, 0x9b, 0x66, 0x68, 0xc7, 0xb6, 0x6a,
+	0x67, 0x48, 0x67, 0x4a, 0xf0, 0x2d, 0x7c, 0x13, 0x37, 0x3e, 0x04, 0x4b, 0xe2, 0xca, 0xb8, 0x20,
+	0x06, 0x5e, 0xc4, 0xcc, 0x1d, 0x16, 0xac, 0x66, 0xee, 0x77, 0xce, 0x9d, 0x9c, 0xb9, 0x17, 0x9f,
+	0x3e, 0xd7, 0x8c, 0x07, 0xb3, 0xba, 0x62, 0xc1, 0x62, 0x04, 0xa7, 0x3f, 0xaf, 0xb8, 0xe4, 0x56,
+	0x47, 0x09, 0x3e, 0x80, 0xc5, 0xa8, 0xdf, 0xcd, 0x78, 0xc6, 0x41, 0x08, 0xd4, 0x4d, 0x7b, 0xfa,
+	0x67, 0x09, 0x17, 0x25, 0x17, 0xb1, 0x16, 0x74, 0xa1, 0xa5, 0x21, 0xc1, 0xed, 0x29, 0xa9, 0x48,
+	0x29, 0xac, 0x0b, 0xfc, 0x3f, 0xa5, 0xac, 0xa0, 0x69, 0x9c, 0x52, 0xc6, 0x4b, 0x61, 0x23, 0xb7,
+	0xe9, 0x99, 0x51, 0x47, 0xc3, 0x3b, 0x60, 0xd6, 0x0d, 0xee, 0x1d, 0x9a, 0xe2, 0x79, 0x45, 0x9f,
+	0x8a, 0x25, 0x15, 0x76, 0x03, 0xcc, 0x27, 0x07, 0xe6, 0xe9, 0x5e, 0x1a, 0x7e, 0x20, 0x6c, 0x02,
+	0x19, 0xd7, 0x15, 0xb3, 0xba, 0xf8, 0x1f, 0xb4, 0xda, 0xc8, 0x45, 0x9e, 0x19, 0xe9, 0xc2, 0x0a,
+	0x71, 0x9b, 0x94, 0xbc, 0x66, 0xd2, 0x6e, 0x28, 0x3c, 0xbe, 0x5c, 0x6d, 0x06, 0xc6, 0xcf, 0x66,
+	0xd0, 0xd3, 0x61, 0x45, 0xfa, 0xe2, 0x17, 0x3c, 0x28, 0x89, 0xcc, 0xfd, 0x09, 0x93, 0x5f, 0x9f,
+	0xd7, 0x78, 0xff, 0x8b, 0x09, 0x93, 0xd1, 0xbe, 0xd5, 0x3a, 0xc7, 0x58, 0xcd, 0x21, 0x4e, 0xe0,
+	0xa1, 0xa6, 0x8b, 0xbc, 0x56, 0x64, 0x2a, 0x12, 0x82, 0xec, 0xe1, 0xe3, 0x57, 0x22, 0x64, 0x0c,
+	0x9e, 0x9c, 0x16, 0x59, 0x2e, 0xed, 0x96, 0x8b, 0xbc, 0x66, 0x74, 0xa4, 0xb8, 0x4a, 0xf7, 0x00,
+	0x74, 0x7c, 0xbf, 0xda, 0x3a, 0x68, 0xbd, 0x75, 0xd0, 0xef, 0xd6, 0x41, 0xef, 0x3b, 0xc7, 0x58,
+	0xef, 0x1c, 0xe3, 0x7b, 0xe7, 0x18, 0x8f, 0x57, 0x59, 0x21, 0xf3, 0x7a, 0xe6, 0x27, 0xbc, 0x0c,
+	0x42, 0x48, 0x10, 0x72, 0x26, 0x2b, 0x92, 0x48, 0x11, 0xc0, 0x86, 0x96, 0x7a, 0x47, 0xf2, 0x6d,
+	0x4e, 0xc5, 0xac, 0x0d, 0x33, 0xbe, 0xfd, 0x1b, 0x00, 0x96, 0x1e, 0xb1, 0x20, 0xbd, 0x01, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenomPrefixes) > 0 {
+		for iNdEx := len(m.DeniedDenomPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenomPrefixes[iNdEx])
+			copy(dAtA[i:], m.DeniedDenomPrefixes[iNdEx])
+			i = encodeVarintBurn(dAtA, i, uint64(len(m.DeniedDenomPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintBurn(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBurnHeight != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.LastBurnHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BurnCount != 0 {
+		i = encodeVarintBurn(dAtA, i, uint64(m.BurnCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBurn(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBurn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	if len(m.DeniedDenomPrefixes) > 0 {
+		for _, s := range m.DeniedDenomPrefixes {
+			l = len(s)
+			n += 1 + l + sovBurn(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBurn(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBurn(uint64(l))
+	if m.BurnCount != 0 {
+		n += 1 + sovBurn(uint64(m.BurnCount))
+	}
+	if m.LastBurnHeight != 0 {
+		n += 1 + sovBurn(uint64(m.LastBurnHeight))
+	}
+	return n
+}
+
+func sovBurn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBurn(x uint64) (n int) {
+	return sovBurn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenomPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenomPrefixes = append(m.DeniedDenomPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnCount", wireType)
+			}
+			m.BurnCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBurnHeight", wireType)
+			}
+			m.LastBurnHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBurnHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBurn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBurn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBurn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBurn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBurn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBurn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBurn = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrDenomNotBurnable = errorsmod.Register(ModuleName, 1, "denom cannot be burned")
+	ErrInvalidDenom     = errorsmod.Register(ModuleName, 2, "invalid denom")
+	ErrDuplicate        = errorsmod.Register(ModuleName, 3, "duplicate")
+)
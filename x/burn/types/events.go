@@ -0,0 +1,7 @@
+package types
+
+const (
+	EventTypeBurn = "burn_tokens"
+
+	AttributeKeyBurner = "burner"
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	minttypes "github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+// BankKeeper defines the expected interface needed to burn tokens.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// MintKeeper defines the expected interface needed to keep the x/mint supply
+// targets and burn statistics in sync with burned tokens.
+type MintKeeper interface {
+	GetParams(ctx sdk.Context) minttypes.Params
+	ReduceTargetSupply(ctx sdk.Context, burnCoin sdk.Coin) error
+	AddTotalBurned(ctx sdk.Context, amount sdk.Coins)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	denomBurns []DenomBurn,
+) GenesisState {
+	return GenesisState{
+		Params:     params,
+		DenomBurns: denomBurns,
+	}
+}
+
+// DefaultGenesisState sets default burn genesis state with default params and
+// no burn statistics.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:     DefaultParams(),
+		DenomBurns: []DenomBurn{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, burn := range gs.DenomBurns {
+		if seenDenoms[burn.Denom] {
+			return fmt.Errorf("duplicate burn statistics for %s", burn.Denom)
+		}
+
+		if err := burn.Validate(); err != nil {
+			return err
+		}
+
+		seenDenoms[burn.Denom] = true
+	}
+
+	return gs.Params.Validate()
+}
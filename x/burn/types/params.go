@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	DefaultDeniedDenoms        = []string(nil) // every denom can be burned
+	DefaultDeniedDenomPrefixes = []string(nil)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	deniedDenoms []string,
+	deniedDenomPrefixes []string,
+) Params {
+	return Params{
+		DeniedDenoms:        deniedDenoms,
+		DeniedDenomPrefixes: deniedDenomPrefixes,
+	}
+}
+
+// DefaultParams returns default x/burn module parameters.
+func DefaultParams() Params {
+	return Params{
+		DeniedDenoms:        DefaultDeniedDenoms,
+		DeniedDenomPrefixes: DefaultDeniedDenomPrefixes,
+	}
+}
+
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]struct{}, len(p.DeniedDenoms))
+	for _, denom := range p.DeniedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return ErrInvalidDenom.Wrapf("denied denom %s: %s", denom, err)
+		}
+		if _, exists := seenDenoms[denom]; exists {
+			return ErrDuplicate.Wrapf("denied denom: %s", denom)
+		}
+		seenDenoms[denom] = struct{}{}
+	}
+
+	seenPrefixes := make(map[string]struct{}, len(p.DeniedDenomPrefixes))
+	for _, prefix := range p.DeniedDenomPrefixes {
+		if strings.TrimSpace(prefix) == "" {
+			return fmt.Errorf("denied denom prefix cannot be blank")
+		}
+		if _, exists := seenPrefixes[prefix]; exists {
+			return ErrDuplicate.Wrapf("denied denom prefix: %s", prefix)
+		}
+		seenPrefixes[prefix] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsDenomBurnable returns false if the denom is denied or starts with one of
+// the denied prefixes.
+func (p Params) IsDenomBurnable(denom string) bool {
+	for _, denied := range p.DeniedDenoms {
+		if denom == denied {
+			return false
+		}
+	}
+
+	for _, prefix := range p.DeniedDenomPrefixes {
+		if strings.HasPrefix(denom, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
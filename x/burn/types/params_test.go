@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid: denied denoms and prefixes", NewParams([]string{"ujuno"}, []string{"ibc/", "factory/"}), false},
+		{"invalid: malformed denied denom", NewParams([]string{"!"}, nil), true},
+		{"invalid: duplicated denied denom", NewParams([]string{"ujuno", "ujuno"}, nil), true},
+		{"invalid: blank denied prefix", NewParams(nil, []string{" "}), true},
+		{"invalid: duplicated denied prefix", NewParams(nil, []string{"ibc/", "ibc/"}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsIsDenomBurnable(t *testing.T) {
+	params := NewParams([]string{"ujuno"}, []string{"ibc/"})
+
+	require.True(t, DefaultParams().IsDenomBurnable("ibc/ABCD"))
+	require.True(t, params.IsDenomBurnable("stake"))
+	require.True(t, params.IsDenomBurnable("ujunox"))
+	require.False(t, params.IsDenomBurnable("ujuno"))
+	require.False(t, params.IsDenomBurnable("ibc/ABCD"))
+}
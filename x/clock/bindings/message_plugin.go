@@ -0,0 +1,106 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/clock/bindings/types"
+	clockkeeper "github.com/CosmosContracts/juno/v23/x/clock/keeper"
+	clocktypes "github.com/CosmosContracts/juno/v23/x/clock/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(clock *clockkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			clock:   clock,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	clock   *clockkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		// only handle the clock messages, leave everything else for the wrapped version
+		var contractMsg bindingstypes.ClockMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "clock msg")
+		}
+
+		if contractMsg.RegisterClockContract != nil {
+			return m.registerClockContract(ctx, contractAddr, contractMsg.RegisterClockContract)
+		}
+		if contractMsg.UnregisterClockContract != nil {
+			return m.unregisterClockContract(ctx, contractAddr, contractMsg.UnregisterClockContract)
+		}
+		if contractMsg.UnjailClockContract != nil {
+			return m.unjailClockContract(ctx, contractAddr, contractMsg.UnjailClockContract)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// registerClockContract registers a contract administered by the calling contract.
+func (m *CustomMessenger) registerClockContract(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterClockContract) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &clocktypes.MsgRegisterClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: register.ContractAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterClockContract")
+	}
+
+	msgServer := clockkeeper.NewMsgServerImpl(*m.clock)
+	if _, err := msgServer.RegisterClockContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering clock contract")
+	}
+	return nil, nil, nil
+}
+
+// unregisterClockContract unregisters a contract administered by the calling contract.
+func (m *CustomMessenger) unregisterClockContract(ctx sdk.Context, contractAddr sdk.AccAddress, unregister *bindingstypes.UnregisterClockContract) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &clocktypes.MsgUnregisterClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: unregister.ContractAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnregisterClockContract")
+	}
+
+	msgServer := clockkeeper.NewMsgServerImpl(*m.clock)
+	if _, err := msgServer.UnregisterClockContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unregistering clock contract")
+	}
+	return nil, nil, nil
+}
+
+// unjailClockContract unjails a contract administered by the calling contract.
+func (m *CustomMessenger) unjailClockContract(ctx sdk.Context, contractAddr sdk.AccAddress, unjail *bindingstypes.UnjailClockContract) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &clocktypes.MsgUnjailClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: unjail.ContractAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnjailClockContract")
+	}
+
+	msgServer := clockkeeper.NewMsgServerImpl(*m.clock)
+	if _, err := msgServer.UnjailClockContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unjailing clock contract")
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,116 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/clock/bindings"
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/clock/bindings/types"
+)
+
+func setupCustomApp(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "testing", Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress([]byte("clock-creator_______"))
+	wasmCode, err := os.ReadFile("../keeper/testdata/clock_example.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	return junoApp, ctx, creator
+}
+
+// instantiateContract creates a contract administered by admin, or by the
+// contract itself when admin is nil.
+func instantiateContract(t *testing.T, ctx sdk.Context, junoApp *app.App, creator, admin sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	addr, _, err := contractKeeper.Instantiate(ctx, 1, creator, creator, []byte("{}"), "clock contract", nil)
+	require.NoError(t, err)
+
+	if admin == nil {
+		admin = addr
+	}
+	require.NoError(t, contractKeeper.UpdateContractAdmin(ctx, addr, creator, admin))
+
+	return addr
+}
+
+func dispatch(t *testing.T, ctx sdk.Context, messenger wasmkeeper.Messenger, contract sdk.AccAddress, msg bindingstypes.ClockMsg) error {
+	t.Helper()
+
+	customBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
+
+func TestClockMsgs(t *testing.T) {
+	junoApp, ctx, creator := setupCustomApp(t)
+	clockKeeper := junoApp.AppKeepers.ClockKeeper
+
+	var wrappedCalled bool
+	messenger := bindings.CustomMessageDecorator(&junoApp.AppKeepers.ClockKeeper)(
+		wasmkeeper.MessageHandlerFunc(func(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+			wrappedCalled = true
+			return nil, nil, nil
+		}),
+	)
+
+	dao := instantiateContract(t, ctx, junoApp, creator, nil)
+	other := instantiateContract(t, ctx, junoApp, creator, creator)
+
+	// the calling contract only manages contracts it administers
+	err := dispatch(t, ctx, messenger, dao, bindingstypes.ClockMsg{
+		RegisterClockContract: &bindingstypes.RegisterClockContract{ContractAddress: other.String()},
+	})
+	require.Error(t, err)
+	require.False(t, clockKeeper.IsClockContract(ctx, other.String()))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.ClockMsg{
+		RegisterClockContract: &bindingstypes.RegisterClockContract{ContractAddress: dao.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, clockKeeper.IsClockContract(ctx, dao.String()))
+
+	// the calling contract recovers after being jailed
+	require.NoError(t, clockKeeper.SetJailStatus(ctx, dao.String(), true))
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.ClockMsg{
+		UnjailClockContract: &bindingstypes.UnjailClockContract{ContractAddress: dao.String()},
+	})
+	require.NoError(t, err)
+
+	contract, err := clockKeeper.GetClockContract(ctx, dao.String())
+	require.NoError(t, err)
+	require.False(t, contract.IsJailed)
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.ClockMsg{
+		UnregisterClockContract: &bindingstypes.UnregisterClockContract{ContractAddress: dao.String()},
+	})
+	require.NoError(t, err)
+	require.False(t, clockKeeper.IsClockContract(ctx, dao.String()))
+
+	// other custom messages are left to the wrapped messenger
+	require.False(t, wrappedCalled)
+	_, _, err = messenger.DispatchMsg(ctx, dao, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"sun"}}`)})
+	require.NoError(t, err)
+	require.True(t, wrappedCalled)
+}
@@ -0,0 +1,23 @@
+package types
+
+type ClockMsg struct {
+	/// Contracts can register a contract they administer to be sudo-called
+	/// at the start and end of every block.
+	RegisterClockContract *RegisterClockContract `json:"register_clock_contract,omitempty"`
+	/// Contracts can unregister a contract they administer.
+	UnregisterClockContract *UnregisterClockContract `json:"unregister_clock_contract,omitempty"`
+	/// Contracts can unjail a contract they administer.
+	UnjailClockContract *UnjailClockContract `json:"unjail_clock_contract,omitempty"`
+}
+
+type RegisterClockContract struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type UnregisterClockContract struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type UnjailClockContract struct {
+	ContractAddress string `json:"contract_address"`
+}
@@ -0,0 +1,17 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	clockkeeper "github.com/CosmosContracts/juno/v23/x/clock/keeper"
+)
+
+func RegisterCustomPlugins(clock *clockkeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(clock),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,170 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/bindings/types"
+	cwhookskeeper "github.com/CosmosContracts/juno/v23/x/cw-hooks/keeper"
+	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(cwHooks *cwhookskeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			cwHooks: cwHooks,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	cwHooks *cwhookskeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		// only handle the cw-hooks messages, leave everything else for the wrapped version
+		var contractMsg bindingstypes.CwHooksMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "cw-hooks msg")
+		}
+
+		if contractMsg.RegisterStaking != nil {
+			return m.registerStaking(ctx, contractAddr, contractMsg.RegisterStaking)
+		}
+		if contractMsg.UnregisterStaking != nil {
+			return m.unregisterStaking(ctx, contractAddr, contractMsg.UnregisterStaking)
+		}
+		if contractMsg.RegisterGovernance != nil {
+			return m.registerGovernance(ctx, contractAddr, contractMsg.RegisterGovernance)
+		}
+		if contractMsg.UnregisterGovernance != nil {
+			return m.unregisterGovernance(ctx, contractAddr, contractMsg.UnregisterGovernance)
+		}
+		if contractMsg.RegisterIBC != nil {
+			return m.registerIBC(ctx, contractAddr, contractMsg.RegisterIBC)
+		}
+		if contractMsg.UnregisterIBC != nil {
+			return m.unregisterIBC(ctx, contractAddr, contractMsg.UnregisterIBC)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// registerStaking registers a contract created and administered by the calling contract for staking hooks.
+func (m *CustomMessenger) registerStaking(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterStaking) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgRegisterStaking{
+		ContractAddress: register.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterStaking")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.RegisterStaking(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering staking hooks")
+	}
+	return nil, nil, nil
+}
+
+// unregisterStaking unregisters a contract created and administered by the calling contract from staking hooks.
+func (m *CustomMessenger) unregisterStaking(ctx sdk.Context, contractAddr sdk.AccAddress, unregister *bindingstypes.UnregisterStaking) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgUnregisterStaking{
+		ContractAddress: unregister.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnregisterStaking")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.UnregisterStaking(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unregistering staking hooks")
+	}
+	return nil, nil, nil
+}
+
+// registerGovernance registers a contract created and administered by the calling contract for governance hooks.
+func (m *CustomMessenger) registerGovernance(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterGovernance) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgRegisterGovernance{
+		ContractAddress: register.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterGovernance")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.RegisterGovernance(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering governance hooks")
+	}
+	return nil, nil, nil
+}
+
+// unregisterGovernance unregisters a contract created and administered by the calling contract from governance hooks.
+func (m *CustomMessenger) unregisterGovernance(ctx sdk.Context, contractAddr sdk.AccAddress, unregister *bindingstypes.UnregisterGovernance) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgUnregisterGovernance{
+		ContractAddress: unregister.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnregisterGovernance")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.UnregisterGovernance(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unregistering governance hooks")
+	}
+	return nil, nil, nil
+}
+
+// registerIBC registers a contract created and administered by the calling contract for the packets of an IBC channel.
+func (m *CustomMessenger) registerIBC(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterIBC) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgRegisterIBC{
+		ContractAddress: register.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+		PortId:          register.PortID,
+		ChannelId:       register.ChannelID,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterIBC")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.RegisterIBC(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering ibc hooks")
+	}
+	return nil, nil, nil
+}
+
+// unregisterIBC unregisters a contract created and administered by the calling contract from the packets of an IBC channel.
+func (m *CustomMessenger) unregisterIBC(ctx sdk.Context, contractAddr sdk.AccAddress, unregister *bindingstypes.UnregisterIBC) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &cwhookstypes.MsgUnregisterIBC{
+		ContractAddress: unregister.ContractAddress,
+		RegisterAddress: contractAddr.String(),
+		PortId:          unregister.PortID,
+		ChannelId:       unregister.ChannelID,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnregisterIBC")
+	}
+
+	msgServer := cwhookskeeper.NewMsgServerImpl(*m.cwHooks)
+	if _, err := msgServer.UnregisterIBC(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unregistering ibc hooks")
+	}
+	return nil, nil, nil
+}
@@ -0,0 +1,132 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/bindings"
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/bindings/types"
+	cwhookstypes "github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+func setupCustomApp(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "testing", Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress([]byte("cwhooks-creator_____"))
+	wasmCode, err := os.ReadFile("../keeper/contract/juno_staking_hooks_example.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	return junoApp, ctx, creator
+}
+
+// instantiateContract creates a contract administered by admin, or by the
+// contract itself when admin is nil.
+func instantiateContract(t *testing.T, ctx sdk.Context, junoApp *app.App, creator, admin sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	addr, _, err := contractKeeper.Instantiate(ctx, 1, creator, creator, []byte("{}"), "cwhooks contract", nil)
+	require.NoError(t, err)
+
+	if admin == nil {
+		admin = addr
+	}
+	require.NoError(t, contractKeeper.UpdateContractAdmin(ctx, addr, creator, admin))
+
+	return addr
+}
+
+func dispatch(t *testing.T, ctx sdk.Context, messenger wasmkeeper.Messenger, contract sdk.AccAddress, msg bindingstypes.CwHooksMsg) error {
+	t.Helper()
+
+	customBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
+
+func TestCwHooksMsgs(t *testing.T) {
+	junoApp, ctx, creator := setupCustomApp(t)
+	cwHooksKeeper := junoApp.AppKeepers.CWHooksKeeper
+
+	var wrappedCalled bool
+	messenger := bindings.CustomMessageDecorator(&junoApp.AppKeepers.CWHooksKeeper)(
+		wasmkeeper.MessageHandlerFunc(func(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+			wrappedCalled = true
+			return nil, nil, nil
+		}),
+	)
+
+	dao := instantiateContract(t, ctx, junoApp, creator, nil)
+	other := instantiateContract(t, ctx, junoApp, creator, creator)
+	module := instantiateContract(t, ctx, junoApp, dao, dao)
+
+	// the calling contract only manages contracts it created and administers
+	err := dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		RegisterStaking: &bindingstypes.RegisterStaking{ContractAddress: other.String()},
+	})
+	require.Error(t, err)
+	require.False(t, cwHooksKeeper.IsContractRegistered(ctx, cwhookstypes.KeyPrefixStaking, other))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		RegisterStaking: &bindingstypes.RegisterStaking{ContractAddress: module.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, cwHooksKeeper.IsContractRegistered(ctx, cwhookstypes.KeyPrefixStaking, module))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		RegisterGovernance: &bindingstypes.RegisterGovernance{ContractAddress: module.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, cwHooksKeeper.IsContractRegistered(ctx, cwhookstypes.KeyPrefixGov, module))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		UnregisterStaking: &bindingstypes.UnregisterStaking{ContractAddress: module.String()},
+	})
+	require.NoError(t, err)
+	require.False(t, cwHooksKeeper.IsContractRegistered(ctx, cwhookstypes.KeyPrefixStaking, module))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		UnregisterGovernance: &bindingstypes.UnregisterGovernance{ContractAddress: module.String()},
+	})
+	require.NoError(t, err)
+	require.False(t, cwHooksKeeper.IsContractRegistered(ctx, cwhookstypes.KeyPrefixGov, module))
+
+	ibcPrefix := cwhookstypes.IBCChannelKeyPrefix("transfer", "channel-0")
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		RegisterIBC: &bindingstypes.RegisterIBC{ContractAddress: module.String(), PortID: "transfer", ChannelID: "channel-0"},
+	})
+	require.NoError(t, err)
+	require.True(t, cwHooksKeeper.IsContractRegistered(ctx, ibcPrefix, module))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.CwHooksMsg{
+		UnregisterIBC: &bindingstypes.UnregisterIBC{ContractAddress: module.String(), PortID: "transfer", ChannelID: "channel-0"},
+	})
+	require.NoError(t, err)
+	require.False(t, cwHooksKeeper.IsContractRegistered(ctx, ibcPrefix, module))
+
+	// other custom messages are left to the wrapped messenger
+	require.False(t, wrappedCalled)
+	_, _, err = messenger.DispatchMsg(ctx, dao, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"sun"}}`)})
+	require.NoError(t, err)
+	require.True(t, wrappedCalled)
+}
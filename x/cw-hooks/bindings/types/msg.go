@@ -0,0 +1,50 @@
+package types
+
+type CwHooksMsg struct {
+	/// Contracts can register a contract they created and administer to be
+	/// sudo-called on staking events.
+	RegisterStaking *RegisterStaking `json:"register_staking,omitempty"`
+	/// Contracts can unregister a contract they created and administer from
+	/// staking events.
+	UnregisterStaking *UnregisterStaking `json:"unregister_staking,omitempty"`
+	/// Contracts can register a contract they created and administer to be
+	/// sudo-called on governance events.
+	RegisterGovernance *RegisterGovernance `json:"register_governance,omitempty"`
+	/// Contracts can unregister a contract they created and administer from
+	/// governance events.
+	UnregisterGovernance *UnregisterGovernance `json:"unregister_governance,omitempty"`
+	/// Contracts can register a contract they created and administer to be
+	/// sudo-called on the packets of an IBC channel.
+	RegisterIBC *RegisterIBC `json:"register_ibc,omitempty"`
+	/// Contracts can unregister a contract they created and administer from
+	/// the packets of an IBC channel.
+	UnregisterIBC *UnregisterIBC `json:"unregister_ibc,omitempty"`
+}
+
+type RegisterStaking struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type UnregisterStaking struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type RegisterGovernance struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type UnregisterGovernance struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type RegisterIBC struct {
+	ContractAddress string `json:"contract_address"`
+	PortID          string `json:"port_id"`
+	ChannelID       string `json:"channel_id"`
+}
+
+type UnregisterIBC struct {
+	ContractAddress string `json:"contract_address"`
+	PortID          string `json:"port_id"`
+	ChannelID       string `json:"channel_id"`
+}
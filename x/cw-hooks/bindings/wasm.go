@@ -0,0 +1,17 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	cwhookskeeper "github.com/CosmosContracts/juno/v23/x/cw-hooks/keeper"
+)
+
+func RegisterCustomPlugins(cwHooks *cwhookskeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(cwHooks),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
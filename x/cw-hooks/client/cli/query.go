@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdParams(),
 		GetStakingContracts(),
 		GetGovernanceContracts(),
+		GetIBCContracts(),
 	)
 	return queryCmd
 }
@@ -96,3 +97,30 @@ func GetGovernanceContracts() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetIBCContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-contracts [port] [channel]",
+		Short: "Show all contracts registered for the packets of an IBC channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IBCContracts(cmd.Context(), &types.QueryIBCContractsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
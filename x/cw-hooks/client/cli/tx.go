@@ -33,9 +33,9 @@ func NewTxCmd() *cobra.Command {
 
 func NewRegister() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [staking|governance] [contract]",
+		Use:   "register [staking|governance|ibc] [contract] [port (ibc only)] [channel (ibc only)]",
 		Short: "Register a contract for sudo message updates",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.RangeArgs(2, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -59,6 +59,16 @@ func NewRegister() *cobra.Command {
 					ContractAddress: contract,
 					RegisterAddress: deployer.String(),
 				}
+			case "ibc":
+				if len(args) != 4 {
+					return fmt.Errorf("ibc registrations require a port and a channel")
+				}
+				msg = &types.MsgRegisterIBC{
+					ContractAddress: contract,
+					RegisterAddress: deployer.String(),
+					PortId:          args[2],
+					ChannelId:       args[3],
+				}
 			default:
 				return fmt.Errorf("invalid register type: %s", registerType)
 			}
@@ -77,9 +87,9 @@ func NewRegister() *cobra.Command {
 
 func NewUnregister() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unregister [staking|governance] [contract]",
+		Use:   "unregister [staking|governance|ibc] [contract] [port (ibc only)] [channel (ibc only)]",
 		Short: "Remove a contract from receiving sudo message updates",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.RangeArgs(2, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -103,6 +113,16 @@ func NewUnregister() *cobra.Command {
 					ContractAddress: contract,
 					RegisterAddress: deployer.String(),
 				}
+			case "ibc":
+				if len(args) != 4 {
+					return fmt.Errorf("ibc registrations require a port and a channel")
+				}
+				msg = &types.MsgUnregisterIBC{
+					ContractAddress: contract,
+					RegisterAddress: deployer.String(),
+					PortId:          args[2],
+					ChannelId:       args[3],
+				}
 			default:
 				return fmt.Errorf("invalid register type: %s", registerType)
 			}
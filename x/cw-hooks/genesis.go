@@ -2,6 +2,7 @@ package cwhooks
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,17 +12,18 @@ import (
 )
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params types.Params, stakingContracts, govContracts []string) *types.GenesisState {
+func NewGenesisState(params types.Params, stakingContracts, govContracts []string, ibcContracts []types.IBCContract) *types.GenesisState {
 	return &types.GenesisState{
 		Params:                   params,
 		StakingContractAddresses: stakingContracts,
 		GovContractAddresses:     govContracts,
+		IbcContracts:             ibcContracts,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *types.GenesisState {
-	return NewGenesisState(types.DefaultParams(), []string{}, []string{})
+	return NewGenesisState(types.DefaultParams(), []string{}, []string{}, []types.IBCContract{})
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -49,6 +51,19 @@ func ValidateGenesis(data types.GenesisState) error {
 		}
 	}
 
+	perChannel := make(map[string]uint64)
+	for _, v := range data.IbcContracts {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+
+		channel := v.PortId + "/" + v.ChannelId
+		perChannel[channel]++
+		if perChannel[channel] > data.Params.MaxIbcContractsPerChannel {
+			return fmt.Errorf("too many contracts registered for ibc channel %s: max %d", channel, data.Params.MaxIbcContractsPerChannel)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
@@ -83,6 +98,15 @@ func InitGenesis(
 
 		k.SetContract(ctx, types.KeyPrefixGov, accAddr)
 	}
+
+	for _, v := range data.IbcContracts {
+		accAddr, err := sdk.AccAddressFromBech32(v.ContractAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetContract(ctx, types.IBCChannelKeyPrefix(v.PortId, v.ChannelId), accAddr)
+	}
 }
 
 // ExportGenesis export module state
@@ -91,5 +115,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:                   k.GetParams(ctx),
 		StakingContractAddresses: k.GetAllContractsBech32(ctx, types.KeyPrefixStaking),
 		GovContractAddresses:     k.GetAllContractsBech32(ctx, types.KeyPrefixGov),
+		IbcContracts:             k.GetAllIBCContracts(ctx),
 	}
 }
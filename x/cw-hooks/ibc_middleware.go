@@ -0,0 +1,65 @@
+package cwhooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware calls the contracts registered for the channel of a packet once
+// the wrapped app handled the packet. The contracts cannot change the outcome
+// of the packet.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping app
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The state changes of a
+// receive which failed are reverted, so the contracts are only called for the
+// packets received successfully or acknowledged asynchronously.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack != nil && !ack.Success() {
+		return ack
+	}
+
+	im.keeper.AfterIBCPacketReceived(ctx, packet, ack, relayer)
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.AfterIBCPacketAcknowledged(ctx, packet, acknowledgement, relayer)
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.AfterIBCPacketTimedOut(ctx, packet, relayer)
+
+	return nil
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	helpers "github.com/CosmosContracts/juno/v23/app/helpers"
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
 )
 
 func (k Keeper) SetContract(ctx sdk.Context, keyPrefix []byte, contractAddr sdk.AccAddress) {
@@ -54,6 +57,26 @@ func (k Keeper) GetAllContractsBech32(ctx sdk.Context, keyPrefix []byte) []strin
 	return list
 }
 
+// GetAllIBCContracts returns the contracts registered for the packets of every
+// channel.
+func (k Keeper) GetAllIBCContracts(ctx sdk.Context) (list []types.IBCContract) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixIBC)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// port and channel identifiers cannot contain a '/', unlike the address
+		parts := bytes.SplitN(iterator.Key()[len(types.KeyPrefixIBC):], []byte("/"), 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		list = append(list, types.NewIBCContract(sdk.AccAddress(parts[2]), string(parts[0]), string(parts[1])))
+	}
+
+	return list
+}
+
 func (k Keeper) DeleteContract(ctx sdk.Context, keyPrefix []byte, contractAddr sdk.AccAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
 	store.Delete(contractAddr)
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+type TimeoutHeight struct {
+	RevisionNumber uint64 `json:"revision_number"`
+	RevisionHeight uint64 `json:"revision_height"`
+}
+
+type IBCPacket struct {
+	Sequence           uint64        `json:"sequence"`
+	SourcePort         string        `json:"source_port"`
+	SourceChannel      string        `json:"source_channel"`
+	DestinationPort    string        `json:"destination_port"`
+	DestinationChannel string        `json:"destination_channel"`
+	Data               []byte        `json:"data"`
+	TimeoutHeight      TimeoutHeight `json:"timeout_height"`
+	TimeoutTimestamp   uint64        `json:"timeout_timestamp"`
+}
+
+func NewIBCPacket(packet channeltypes.Packet) IBCPacket {
+	return IBCPacket{
+		Sequence:           packet.Sequence,
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Data:               packet.Data,
+		TimeoutHeight: TimeoutHeight{
+			RevisionNumber: packet.TimeoutHeight.RevisionNumber,
+			RevisionHeight: packet.TimeoutHeight.RevisionHeight,
+		},
+		TimeoutTimestamp: packet.TimeoutTimestamp,
+	}
+}
+
+// IBCPacketReceive is sent when a packet is received on the channel. The
+// acknowledgement is null when it is written asynchronously.
+type IBCPacketReceive struct {
+	Packet          IBCPacket `json:"packet"`
+	Acknowledgement []byte    `json:"acknowledgement"`
+	Relayer         string    `json:"relayer"`
+}
+
+// IBCPacketAcknowledgement is sent when a packet sent on the channel is
+// acknowledged by the counterparty.
+type IBCPacketAcknowledgement struct {
+	Packet          IBCPacket `json:"packet"`
+	Acknowledgement []byte    `json:"acknowledgement"`
+	Success         bool      `json:"success"`
+	Relayer         string    `json:"relayer"`
+}
+
+// IBCPacketTimeout is sent when a packet sent on the channel times out.
+type IBCPacketTimeout struct {
+	Packet  IBCPacket `json:"packet"`
+	Relayer string    `json:"relayer"`
+}
+
+type SudoMsgIBCPacketReceive struct {
+	IBCPacketReceive IBCPacketReceive `json:"ibc_packet_receive"`
+}
+
+type SudoMsgIBCPacketAcknowledgement struct {
+	IBCPacketAcknowledgement IBCPacketAcknowledgement `json:"ibc_packet_acknowledgement"`
+}
+
+type SudoMsgIBCPacketTimeout struct {
+	IBCPacketTimeout IBCPacketTimeout `json:"ibc_packet_timeout"`
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	helpers "github.com/CosmosContracts/juno/v23/app/helpers"
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+// AfterIBCPacketReceived calls the contracts registered for the channel the
+// packet was received on. The ack is nil when it is written asynchronously.
+func (k Keeper) AfterIBCPacketReceived(ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement, relayer sdk.AccAddress) {
+	var ackBz []byte
+	if ack != nil {
+		ackBz = ack.Acknowledgement()
+	}
+
+	msgBz, err := json.Marshal(SudoMsgIBCPacketReceive{
+		IBCPacketReceive: IBCPacketReceive{
+			Packet:          NewIBCPacket(packet),
+			Acknowledgement: ackBz,
+			Relayer:         relayer.String(),
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	k.ExecuteMessageOnIBCContracts(ctx, packet.DestinationPort, packet.DestinationChannel, msgBz)
+}
+
+// AfterIBCPacketAcknowledged calls the contracts registered for the channel the
+// packet was sent on.
+func (k Keeper) AfterIBCPacketAcknowledged(ctx sdk.Context, packet channeltypes.Packet, ackBz []byte, relayer sdk.AccAddress) {
+	var ack channeltypes.Acknowledgement
+	success := channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack) == nil && ack.Success()
+
+	msgBz, err := json.Marshal(SudoMsgIBCPacketAcknowledgement{
+		IBCPacketAcknowledgement: IBCPacketAcknowledgement{
+			Packet:          NewIBCPacket(packet),
+			Acknowledgement: ackBz,
+			Success:         success,
+			Relayer:         relayer.String(),
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	k.ExecuteMessageOnIBCContracts(ctx, packet.SourcePort, packet.SourceChannel, msgBz)
+}
+
+// AfterIBCPacketTimedOut calls the contracts registered for the channel the
+// packet was sent on.
+func (k Keeper) AfterIBCPacketTimedOut(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) {
+	msgBz, err := json.Marshal(SudoMsgIBCPacketTimeout{
+		IBCPacketTimeout: IBCPacketTimeout{
+			Packet:  NewIBCPacket(packet),
+			Relayer: relayer.String(),
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	k.ExecuteMessageOnIBCContracts(ctx, packet.SourcePort, packet.SourceChannel, msgBz)
+}
+
+// ExecuteMessageOnIBCContracts calls the contracts registered for a channel.
+// Each contract runs in its own cached context, so a failing contract is
+// reverted and logged without failing the packet or the other contracts. The
+// gas used by each contract, up to the contract gas limit, is charged to the
+// transaction of the relayer.
+func (k Keeper) ExecuteMessageOnIBCContracts(ctx sdk.Context, portID, channelID string, msgBz []byte) {
+	p := k.GetParams(ctx)
+
+	for _, c := range k.GetAllContracts(ctx, types.IBCChannelKeyPrefix(portID, channelID)) {
+		cacheCtx, write := ctx.CacheContext()
+		gasLimitCtx := cacheCtx.WithGasMeter(sdk.NewGasMeter(p.ContractGasLimit))
+		addr := sdk.AccAddress(c.Bytes())
+
+		var err error
+		helpers.ExecuteContract(k.GetContractKeeper(), gasLimitCtx, addr, msgBz, &err)
+		ctx.GasMeter().ConsumeGas(gasLimitCtx.GasMeter().GasConsumedToLimit(), "cw-hooks ibc hook")
+		if err != nil {
+			k.Logger(ctx).Error("failed to execute the IBC hook", "contract", addr.String(), "port", portID, "channel", channelID, "error", err)
+			continue
+		}
+
+		write()
+	}
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+func (s *IntegrationTestSuite) TestRegisterIBCContracts() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, notAuthorizedAcc := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "")
+
+	for _, tc := range []struct {
+		desc string
+
+		ContractAddress string
+		RegisterAddress string
+		PortID          string
+		ChannelID       string
+
+		shouldErr bool
+	}{
+		{
+			desc:            "Invalid port",
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+			PortID:          "",
+			ChannelID:       "channel-0",
+			shouldErr:       true,
+		},
+		{
+			desc:            "Invalid channel",
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+			PortID:          "transfer",
+			ChannelID:       "channel/0",
+			shouldErr:       true,
+		},
+		{
+			desc:            "Invalid not authorized creator",
+			ContractAddress: contractAddress,
+			RegisterAddress: notAuthorizedAcc.String(),
+			PortID:          "transfer",
+			ChannelID:       "channel-0",
+			shouldErr:       true,
+		},
+		{
+			desc:            "Success",
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+			PortID:          "transfer",
+			ChannelID:       "channel-0",
+			shouldErr:       false,
+		},
+		{
+			desc:            "Failure register same channel",
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+			PortID:          "transfer",
+			ChannelID:       "channel-0",
+			shouldErr:       true,
+		},
+		{
+			desc:            "Success register another channel",
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+			PortID:          "transfer",
+			ChannelID:       "channel-1",
+			shouldErr:       false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			goCtx := sdk.WrapSDKContext(s.ctx)
+			_, err := s.msgServer.RegisterIBC(goCtx, &types.MsgRegisterIBC{
+				ContractAddress: tc.ContractAddress,
+				RegisterAddress: tc.RegisterAddress,
+				PortId:          tc.PortID,
+				ChannelId:       tc.ChannelID,
+			})
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	resp, err := s.queryClient.IBCContracts(goCtx, &types.QueryIBCContractsRequest{PortId: "transfer", ChannelId: "channel-0"})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{contractAddress}, resp.Contracts)
+
+	resp, err = s.queryClient.IBCContracts(goCtx, &types.QueryIBCContractsRequest{PortId: "transfer", ChannelId: "channel-2"})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Contracts)
+
+	_, err = s.queryClient.IBCContracts(goCtx, &types.QueryIBCContractsRequest{PortId: "transfer", ChannelId: ""})
+	s.Require().Error(err)
+
+	s.Require().ElementsMatch([]types.IBCContract{
+		types.NewIBCContract(sdk.MustAccAddressFromBech32(contractAddress), "transfer", "channel-0"),
+		types.NewIBCContract(sdk.MustAccAddressFromBech32(contractAddress), "transfer", "channel-1"),
+	}, s.app.AppKeepers.CWHooksKeeper.GetAllIBCContracts(s.ctx))
+
+	// unregistering only removes the contract from the given channel
+	_, err = s.msgServer.UnregisterIBC(goCtx, &types.MsgUnregisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UnregisterIBC(goCtx, &types.MsgUnregisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	})
+	s.Require().Error(err)
+
+	s.Require().Equal([]types.IBCContract{
+		types.NewIBCContract(sdk.MustAccAddressFromBech32(contractAddress), "transfer", "channel-1"),
+	}, s.app.AppKeepers.CWHooksKeeper.GetAllIBCContracts(s.ctx))
+}
+
+func (s *IntegrationTestSuite) TestIBCHooksDoNotFailPackets() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, relayer := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	// the example contract does not handle the IBC sudo messages
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	_, err := s.msgServer.RegisterIBC(sdk.WrapSDKContext(s.ctx), &types.MsgRegisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	})
+	s.Require().NoError(err)
+
+	packet := channeltypes.NewPacket([]byte(`{}`), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	k := s.app.AppKeepers.CWHooksKeeper
+
+	s.Require().NotPanics(func() {
+		k.AfterIBCPacketReceived(s.ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}), relayer)
+		k.AfterIBCPacketReceived(s.ctx, packet, nil, relayer)
+		k.AfterIBCPacketAcknowledged(s.ctx, packet, channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement(), relayer)
+		k.AfterIBCPacketTimedOut(s.ctx, packet, relayer)
+	})
+
+	// the gas used by the contract is charged to the relayer, even on failure
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.AfterIBCPacketTimedOut(ctx, packet, relayer)
+
+	unregisteredPacket := channeltypes.NewPacket([]byte(`{}`), 1, "transfer", "channel-1", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	unregisteredCtx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.AfterIBCPacketTimedOut(unregisteredCtx, unregisteredPacket, relayer)
+
+	s.Require().Greater(ctx.GasMeter().GasConsumed(), unregisteredCtx.GasMeter().GasConsumed())
+}
+
+func (s *IntegrationTestSuite) TestRegisterIBCContractsLimit() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	k := s.app.AppKeepers.CWHooksKeeper
+	keyPrefix := types.IBCChannelKeyPrefix("transfer", "channel-0")
+	params := k.GetParams(s.ctx)
+	params.MaxIbcContractsPerChannel = 3
+	s.Require().NoError(k.SetParams(s.ctx, params))
+	for i := 0; i < 3; i++ {
+		k.SetContract(s.ctx, keyPrefix, sdk.AccAddress([]byte(fmt.Sprintf("contract_%011d", i))))
+	}
+
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	msg := &types.MsgRegisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	}
+
+	_, err := s.msgServer.RegisterIBC(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorContains(err, "already has 3 contracts registered")
+
+	// the limit applies per channel
+	msg.ChannelId = "channel-1"
+	_, err = s.msgServer.RegisterIBC(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestGovernanceEvictsIBCContract() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, notAuthorizedAcc := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	k := s.app.AppKeepers.CWHooksKeeper
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	_, err := s.msgServer.RegisterIBC(sdk.WrapSDKContext(s.ctx), &types.MsgRegisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	})
+	s.Require().NoError(err)
+
+	msg := &types.MsgUnregisterIBC{
+		ContractAddress: contractAddress,
+		RegisterAddress: notAuthorizedAcc.String(),
+		PortId:          "transfer",
+		ChannelId:       "channel-0",
+	}
+	_, err = s.msgServer.UnregisterIBC(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	// the governance authority is not the contract creator or admin
+	msg.RegisterAddress = k.GetAuthority()
+	_, err = s.msgServer.UnregisterIBC(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	keyPrefix := types.IBCChannelKeyPrefix("transfer", "channel-0")
+	s.Require().False(k.IsContractRegistered(s.ctx, keyPrefix, sdk.MustAccAddressFromBech32(contractAddress)))
+
+	_, err = s.msgServer.UnregisterIBC(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorContains(err, "contract is not registered")
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -79,6 +80,59 @@ func (k msgServer) UnregisterStaking(goCtx context.Context, req *types.MsgUnregi
 	return &types.MsgUnregisterStakingResponse{}, nil
 }
 
+func (k msgServer) RegisterIBC(goCtx context.Context, req *types.MsgRegisterIBC) (*types.MsgRegisterIBCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateIBCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	// the limit bounds the work added to each packet of the channel
+	keyPrefix := types.IBCChannelKeyPrefix(req.PortId, req.ChannelId)
+	maxContracts := k.GetParams(ctx).MaxIbcContractsPerChannel
+	if uint64(len(k.GetAllContracts(ctx, keyPrefix))) >= maxContracts {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ibc channel %s/%s already has %d contracts registered", req.PortId, req.ChannelId, maxContracts)
+	}
+
+	if err := k.handleContractRegister(ctx, req.RegisterAddress, req.ContractAddress, keyPrefix, fmt.Sprintf("ibc channel %s/%s", req.PortId, req.ChannelId)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterIBCResponse{}, nil
+}
+
+func (k msgServer) UnregisterIBC(goCtx context.Context, req *types.MsgUnregisterIBC) (*types.MsgUnregisterIBCResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := types.ValidateIBCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	keyPrefix := types.IBCChannelKeyPrefix(req.PortId, req.ChannelId)
+
+	// governance can evict any contract to free a slot of the channel
+	if req.RegisterAddress == k.authority {
+		contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+		}
+
+		if !k.IsContractRegistered(ctx, keyPrefix, contract) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract is not registered for ibc channel %s/%s", req.PortId, req.ChannelId)
+		}
+
+		k.DeleteContract(ctx, keyPrefix, contract)
+
+		return &types.MsgUnregisterIBCResponse{}, nil
+	}
+
+	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, keyPrefix, fmt.Sprintf("ibc channel %s/%s", req.PortId, req.ChannelId)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterIBCResponse{}, nil
+}
+
 func (k msgServer) isContractSenderAuthorized(ctx sdk.Context, sender string, contract sdk.AccAddress) error {
 	if ok := k.GetWasmKeeper().HasContractInfo(ctx, contract); !ok {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "contract does not exist: %s", contract)
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
@@ -46,3 +49,19 @@ func (q Querier) GovernanceContracts(stdCtx context.Context, _ *types.QueryGover
 		Contracts: q.keeper.GetAllContractsBech32(ctx, types.KeyPrefixGov),
 	}, nil
 }
+
+func (q Querier) IBCContracts(stdCtx context.Context, req *types.QueryIBCContractsRequest) (*types.QueryIBCContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateIBCChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryIBCContractsResponse{
+		Contracts: q.keeper.GetAllContractsBech32(ctx, types.IBCChannelKeyPrefix(req.PortId, req.ChannelId)),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+const (
+	ModuleName = "cw-hooks"
+
+	// MaxIBCContractsPerChannel is the per channel limit that was previously
+	// hard-coded in the module.
+	MaxIBCContractsPerChannel = 10
+)
+
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/cw-hooks module state from the consensus version 1 to
+// version 2. Specifically, it moves the previously hard-coded limit of
+// contracts registered per IBC channel into the module params.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.MaxIbcContractsPerChannel = MaxIBCContractsPerChannel
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	cwhooks "github.com/CosmosContracts/juno/v23/x/cw-hooks"
+	v2 "github.com/CosmosContracts/juno/v23/x/cw-hooks/migrations/v2"
+	"github.com/CosmosContracts/juno/v23/x/cw-hooks/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(cwhooks.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v2.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	store.Set(v2.ParamsKey, cdc.MustMarshal(&types.Params{
+		ContractGasLimit: 250_000,
+	}))
+	require.NoError(t, v2.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v2.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.Params{
+		ContractGasLimit:          250_000,
+		MaxIbcContractsPerChannel: 10,
+	}, res)
+}
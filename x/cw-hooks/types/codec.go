@@ -36,6 +36,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterGovernance{}, "cwhooks/MsgRegisterGovernance")
 	legacy.RegisterAminoMsg(cdc, &MsgUnregisterGovernance{}, "cwhooks/MsgUnregisterGovernance")
 	legacy.RegisterAminoMsg(cdc, &MsgUnregisterStaking{}, "cwhooks/MsgUnregisterStaking")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterIBC{}, "cwhooks/MsgRegisterIBC")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterIBC{}, "cwhooks/MsgUnregisterIBC")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterGovernance{},
 		&MsgRegisterStaking{},
+		&MsgRegisterIBC{},
+		&MsgUnregisterIBC{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
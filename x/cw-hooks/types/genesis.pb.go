@@ -32,6 +32,8 @@ type GenesisState struct {
 	StakingContractAddresses []string `protobuf:"bytes,2,rep,name=staking_contract_addresses,json=stakingContractAddresses,proto3" json:"staking_contract_addresses,omitempty" yaml:"staking_contract_addresses"`
 	// gov_contract_addresses
 	GovContractAddresses []string `protobuf:"bytes,3,rep,name=gov_contract_addresses,json=govContractAddresses,proto3" json:"gov_contract_addresses,omitempty" yaml:"gov_contract_addresses"`
+	// ibc_contracts
+	IbcContracts []IBCContract `protobuf:"bytes,4,rep,name=ibc_contracts,json=ibcContracts,proto3" json:"ibc_contracts,omitempty" yaml:"ibc_contracts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,17 +90,90 @@ func (m *GenesisState) GetGovContractAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetIbcContracts() []IBCContract {
+	if m != nil {
+		return m.IbcContracts
+	}
+	return nil
+}
+
+// IBCContract is a contract registered for the packets of an IBC channel.
+type IBCContract struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// port_id of the channel end on this chain
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id of the channel end on this chain
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *IBCContract) Reset()         { *m = IBCContract{} }
+func (m *IBCContract) String() string { return proto.CompactTextString(m) }
+func (*IBCContract) ProtoMessage()    {}
+func (*IBCContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d384a01656df5cd8, []int{1}
+}
+func (m *IBCContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCContract.Merge(m, src)
+}
+func (m *IBCContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCContract proto.InternalMessageInfo
+
+func (m *IBCContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *IBCContract) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IBCContract) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// contract_gas_limit is the contract call gas limit
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty" yaml:"contract_gas_limit"`
+	// max_ibc_contracts_per_channel is the maximum number of contracts that can
+	// be registered for IBC hooks on a single channel. 0 stops new registrations.
+	MaxIbcContractsPerChannel uint64 `protobuf:"varint,2,opt,name=max_ibc_contracts_per_channel,json=maxIbcContractsPerChannel,proto3" json:"max_ibc_contracts_per_channel,omitempty" yaml:"max_ibc_contracts_per_channel"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d384a01656df5cd8, []int{1}
+	return fileDescriptor_d384a01656df5cd8, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,40 +209,57 @@ func (m *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxIbcContractsPerChannel() uint64 {
+	if m != nil {
+		return m.MaxIbcContractsPerChannel
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.cwhooks.v1.GenesisState")
+	proto.RegisterType((*IBCContract)(nil), "juno.cwhooks.v1.IBCContract")
 	proto.RegisterType((*Params)(nil), "juno.cwhooks.v1.Params")
 }
 
 func init() { proto.RegisterFile("juno/cwhooks/v1/genesis.proto", fileDescriptor_d384a01656df5cd8) }
 
 var fileDescriptor_d384a01656df5cd8 = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x18, 0x85, 0x93, 0xf6, 0x52, 0xb8, 0xb9, 0x17, 0x2c, 0xa1, 0x68, 0x2c, 0x36, 0xa9, 0xc1, 0x45,
-	0x17, 0x9a, 0x31, 0xba, 0x13, 0x5c, 0x98, 0x22, 0x45, 0x51, 0x90, 0xb8, 0x73, 0x53, 0x26, 0xe9,
-	0x30, 0x8d, 0x6d, 0x32, 0x21, 0x33, 0x4d, 0x2d, 0x3e, 0x82, 0x1b, 0x1f, 0xab, 0xcb, 0x2e, 0x5d,
-	0x05, 0x69, 0x5d, 0x65, 0xe9, 0x13, 0x48, 0x33, 0xa9, 0x52, 0xdb, 0xee, 0x86, 0xff, 0x3b, 0xf3,
-	0x9f, 0xc3, 0xcf, 0x91, 0x6a, 0x8f, 0x83, 0x80, 0x00, 0x77, 0xd8, 0x25, 0xa4, 0x47, 0x41, 0x6c,
-	0x02, 0x8c, 0x02, 0x44, 0x3d, 0x6a, 0x84, 0x11, 0x61, 0x44, 0xde, 0x9a, 0x63, 0x23, 0xc7, 0x46,
-	0x6c, 0x56, 0x2b, 0x98, 0x60, 0x92, 0x31, 0x30, 0x7f, 0x71, 0x59, 0x55, 0x75, 0x09, 0xf5, 0x09,
-	0x05, 0x0e, 0xa4, 0x08, 0xc4, 0xa6, 0x83, 0x18, 0x34, 0x81, 0x4b, 0xbc, 0x80, 0x73, 0xfd, 0xa3,
-	0x20, 0xfd, 0x6f, 0xf1, 0xc5, 0xf7, 0x0c, 0x32, 0x24, 0x5f, 0x49, 0xa5, 0x10, 0x46, 0xd0, 0xa7,
-	0x8a, 0x58, 0x17, 0x1b, 0xff, 0x4e, 0x76, 0x8c, 0x5f, 0x46, 0xc6, 0x5d, 0x86, 0x2d, 0x65, 0x9c,
-	0x68, 0x42, 0x9a, 0x68, 0x65, 0x2e, 0x3f, 0x24, 0xbe, 0xc7, 0x90, 0x1f, 0xb2, 0x91, 0x9d, 0x2f,
-	0x90, 0x5f, 0x44, 0xa9, 0x4a, 0x19, 0xec, 0x79, 0x01, 0x6e, 0xbb, 0x24, 0x60, 0x11, 0x74, 0x59,
-	0x1b, 0x76, 0x3a, 0x11, 0xa2, 0x14, 0x51, 0xa5, 0x50, 0x2f, 0x36, 0xfe, 0x5a, 0xb7, 0x69, 0xa2,
-	0x1d, 0x6c, 0x56, 0xfd, 0xac, 0xfd, 0x4c, 0xb4, 0xfd, 0x11, 0xf4, 0xfb, 0x67, 0xfa, 0x66, 0xb5,
-	0x6e, 0x2b, 0x39, 0x6c, 0xe6, 0xec, 0x62, 0x81, 0xe4, 0x67, 0x69, 0x1b, 0x93, 0x78, 0x5d, 0x90,
-	0x62, 0x16, 0xe4, 0x32, 0x4d, 0xb4, 0xfa, 0x7a, 0xc5, 0x52, 0x88, 0x1a, 0x0f, 0xb1, 0x5e, 0xa9,
-	0xdb, 0x15, 0x4c, 0xe2, 0x15, 0x73, 0x7d, 0x20, 0x95, 0xf8, 0xd9, 0xe4, 0x9e, 0x24, 0x7f, 0x7f,
-	0xc3, 0x90, 0xb6, 0xfb, 0x9e, 0xef, 0xb1, 0xec, 0xd6, 0x7f, 0xac, 0xf3, 0x34, 0xd1, 0xf6, 0x56,
-	0xe9, 0x92, 0xfd, 0x2e, 0xb7, 0x5f, 0x55, 0xe9, 0x76, 0x79, 0x31, 0x6c, 0x41, 0x7a, 0x33, 0x1f,
-	0x59, 0xd7, 0xe3, 0xa9, 0x2a, 0x4e, 0xa6, 0xaa, 0xf8, 0x3e, 0x55, 0xc5, 0xd7, 0x99, 0x2a, 0x4c,
-	0x66, 0xaa, 0xf0, 0x36, 0x53, 0x85, 0x87, 0x63, 0xec, 0xb1, 0xee, 0xc0, 0x31, 0x5c, 0xe2, 0x83,
-	0x66, 0x56, 0x91, 0x45, 0x68, 0x0a, 0xb2, 0xe2, 0x3d, 0x01, 0x77, 0x78, 0xc4, 0xbb, 0xc7, 0x46,
-	0x21, 0xa2, 0x4e, 0x29, 0x2b, 0xcc, 0xe9, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x85, 0xab,
-	0x8c, 0x98, 0x02, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0xe3, 0x26, 0x64, 0x44, 0xe9, 0x68, 0x31, 0x61, 0x71, 0x43, 0x63, 0x67, 0xa6, 0xb0,
+	0x0c, 0x36, 0x7b, 0xe9, 0x0e, 0x83, 0xc1, 0x0e, 0x73, 0x18, 0x25, 0x63, 0x83, 0xe2, 0xde, 0x76,
+	0x31, 0xb2, 0x22, 0x1c, 0x2d, 0xb1, 0x65, 0x2c, 0x35, 0x4d, 0xb6, 0x8f, 0x30, 0x06, 0xbb, 0xec,
+	0x3b, 0xf5, 0xd8, 0xe3, 0x4e, 0xa6, 0x24, 0xb7, 0x1c, 0xf7, 0x09, 0x46, 0x24, 0xd7, 0xab, 0x9b,
+	0xb4, 0x37, 0x4b, 0xbf, 0xff, 0x7b, 0xef, 0xff, 0x9e, 0xfd, 0x0c, 0xda, 0x5f, 0xcf, 0x23, 0x6a,
+	0xa3, 0x8b, 0x11, 0xa5, 0x63, 0x66, 0x4f, 0x7b, 0x76, 0x80, 0x23, 0xcc, 0x08, 0xb3, 0xe2, 0x84,
+	0x72, 0xaa, 0xee, 0xad, 0xb1, 0x95, 0x61, 0x6b, 0xda, 0x6b, 0x35, 0x02, 0x1a, 0x50, 0xc1, 0xec,
+	0xf5, 0x93, 0x94, 0xb5, 0x74, 0x44, 0x59, 0x48, 0x99, 0xed, 0x43, 0x86, 0xed, 0x69, 0xcf, 0xc7,
+	0x1c, 0xf6, 0x6c, 0x44, 0x49, 0x24, 0xb9, 0x79, 0x5d, 0x06, 0xbb, 0x27, 0x32, 0xf1, 0x19, 0x87,
+	0x1c, 0xab, 0x03, 0x50, 0x8d, 0x61, 0x02, 0x43, 0xa6, 0x29, 0x1d, 0xa5, 0x5b, 0x3f, 0x6e, 0x5a,
+	0x77, 0x0a, 0x59, 0xa7, 0x02, 0x3b, 0xda, 0x65, 0x6a, 0x94, 0x56, 0xa9, 0xb1, 0x2f, 0xe5, 0x2f,
+	0x68, 0x48, 0x38, 0x0e, 0x63, 0x3e, 0x77, 0xb3, 0x04, 0xea, 0x0f, 0x05, 0xb4, 0x18, 0x87, 0x63,
+	0x12, 0x05, 0x1e, 0xa2, 0x11, 0x4f, 0x20, 0xe2, 0x1e, 0x1c, 0x0e, 0x13, 0xcc, 0x18, 0x66, 0xda,
+	0x4e, 0xa7, 0xdc, 0xad, 0x39, 0x9f, 0x57, 0xa9, 0x71, 0x74, 0xbf, 0xea, 0x7f, 0xda, 0xbf, 0xa9,
+	0xf1, 0x74, 0x0e, 0xc3, 0xc9, 0x5b, 0xf3, 0x7e, 0xb5, 0xe9, 0x6a, 0x19, 0xec, 0x67, 0xec, 0xfd,
+	0x0d, 0x52, 0xbf, 0x83, 0x27, 0x01, 0x9d, 0x6e, 0x33, 0x52, 0x16, 0x46, 0x3e, 0xac, 0x52, 0xa3,
+	0xb3, 0x5d, 0x51, 0x30, 0xd1, 0x96, 0x26, 0xb6, 0x2b, 0x4d, 0xb7, 0x11, 0xd0, 0xe9, 0x66, 0xf1,
+	0x6f, 0xe0, 0x31, 0xf1, 0x51, 0x1e, 0xc0, 0xb4, 0x4a, 0xa7, 0xdc, 0xad, 0x1f, 0x1f, 0x6e, 0x0c,
+	0x77, 0xe0, 0xf4, 0x6f, 0xa2, 0x9d, 0x37, 0xd9, 0x84, 0x9b, 0x85, 0xd0, 0x82, 0x99, 0x86, 0x34,
+	0x53, 0x10, 0x98, 0xee, 0x2e, 0xf1, 0x51, 0x3f, 0x3f, 0xc6, 0xa0, 0x7e, 0x2b, 0xab, 0xfa, 0x1c,
+	0xec, 0xdf, 0xf5, 0x2d, 0x5e, 0x75, 0xcd, 0xdd, 0x43, 0x45, 0xdf, 0x6a, 0x13, 0x3c, 0x8a, 0x69,
+	0xc2, 0x3d, 0x32, 0xd4, 0x76, 0x84, 0xa2, 0xba, 0x3e, 0x0e, 0x86, 0x6a, 0x1b, 0x00, 0x34, 0x82,
+	0x51, 0x84, 0x27, 0x6b, 0x56, 0x16, 0xac, 0x96, 0xdd, 0x0c, 0x86, 0xe6, 0xcf, 0x1d, 0x50, 0x95,
+	0x5f, 0x89, 0x3a, 0x06, 0x6a, 0x5e, 0x2d, 0x80, 0xcc, 0x9b, 0x90, 0x90, 0x70, 0x51, 0xaf, 0xe2,
+	0xbc, 0x5b, 0xa5, 0xc6, 0xe1, 0x26, 0x2d, 0x34, 0x78, 0x20, 0x1b, 0xdc, 0x54, 0x99, 0x6e, 0xde,
+	0xc6, 0x09, 0x64, 0x9f, 0xd6, 0x57, 0xea, 0x6f, 0x05, 0xb4, 0x43, 0x38, 0xf3, 0x0a, 0xe3, 0xf0,
+	0x62, 0x9c, 0x78, 0x99, 0x37, 0xd1, 0x46, 0xc5, 0x39, 0x5b, 0xa5, 0xc6, 0xb3, 0x07, 0x85, 0x05,
+	0x0f, 0x47, 0xd2, 0xc3, 0x83, 0x01, 0xa6, 0x7b, 0x10, 0xc2, 0xd9, 0xe0, 0xd6, 0xdc, 0x4f, 0x71,
+	0xd2, 0x97, 0xcc, 0xf9, 0x78, 0xb9, 0xd0, 0x95, 0xab, 0x85, 0xae, 0x5c, 0x2f, 0x74, 0xe5, 0xd7,
+	0x52, 0x2f, 0x5d, 0x2d, 0xf5, 0xd2, 0x9f, 0xa5, 0x5e, 0xfa, 0xf2, 0x2a, 0x20, 0x7c, 0x74, 0xee,
+	0x5b, 0x88, 0x86, 0x76, 0x5f, 0x6c, 0x6a, 0x1e, 0x6f, 0x8b, 0xfd, 0x9f, 0xd9, 0xe8, 0xe2, 0xa5,
+	0xfc, 0x05, 0xf0, 0x79, 0x8c, 0x99, 0x5f, 0x15, 0x7b, 0xfb, 0xfa, 0xdf, 0x00, 0x95, 0x44, 0xb5,
+	0xfe, 0x1f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -190,6 +282,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcContracts) > 0 {
+		for iNdEx := len(m.IbcContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GovContractAddresses) > 0 {
 		for iNdEx := len(m.GovContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.GovContractAddresses[iNdEx])
@@ -221,6 +327,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -241,6 +391,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxIbcContractsPerChannel != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxIbcContractsPerChannel))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ContractGasLimit != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractGasLimit))
 		i--
@@ -280,6 +435,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IbcContracts) > 0 {
+		for _, e := range m.IbcContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -292,6 +474,9 @@ func (m *Params) Size() (n int) {
 	if m.ContractGasLimit != 0 {
 		n += 1 + sovGenesis(uint64(m.ContractGasLimit))
 	}
+	if m.MaxIbcContractsPerChannel != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxIbcContractsPerChannel))
+	}
 	return n
 }
 
@@ -427,6 +612,186 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.GovContractAddresses = append(m.GovContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcContracts = append(m.IbcContracts, IBCContract{})
+			if err := m.IbcContracts[len(m.IbcContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -496,6 +861,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIbcContractsPerChannel", wireType)
+			}
+			m.MaxIbcContractsPerChannel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIbcContractsPerChannel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
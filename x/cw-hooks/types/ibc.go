@@ -0,0 +1,40 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewIBCContract creates a new IBCContract
+func NewIBCContract(contract sdk.AccAddress, portID, channelID string) IBCContract {
+	return IBCContract{
+		ContractAddress: contract.String(),
+		PortId:          portID,
+		ChannelId:       channelID,
+	}
+}
+
+// Validate performs a stateless validation of the registration.
+func (c IBCContract) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return errors.Wrap(err, "invalid contract address")
+	}
+
+	return ValidateIBCChannel(c.PortId, c.ChannelId)
+}
+
+// ValidateIBCChannel validates the identifiers of a channel end.
+func ValidateIBCChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errors.Wrap(err, "invalid port id")
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errors.Wrap(err, "invalid channel id")
+	}
+
+	return nil
+}
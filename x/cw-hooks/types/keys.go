@@ -15,4 +15,11 @@ const (
 var (
 	KeyPrefixStaking = []byte{0x01}
 	KeyPrefixGov     = []byte{0x02}
+	KeyPrefixIBC     = []byte{0x03}
 )
+
+// IBCChannelKeyPrefix returns the prefix of the contracts registered for the
+// packets of a channel. Port and channel identifiers cannot contain a '/'.
+func IBCChannelKeyPrefix(portID, channelID string) []byte {
+	return append(append([]byte{}, KeyPrefixIBC...), []byte(portID+"/"+channelID+"/")...)
+}
@@ -210,3 +210,91 @@ func (msg *MsgUnregisterStaking) GetSigners() []sdk.AccAddress {
 func (msg *MsgUnregisterStaking) ValidateBasic() error {
 	return Validate(msg)
 }
+
+// == MsgRegisterIBC ==
+const TypeMsgRegisterIBC = "register_ibc"
+
+var _ sdk.Msg = &MsgRegisterIBC{}
+
+func NewMsgRegisterIBC(
+	sender sdk.Address,
+	contract sdk.Address,
+	portID, channelID string,
+) *MsgRegisterIBC {
+	return &MsgRegisterIBC{
+		ContractAddress: contract.String(),
+		RegisterAddress: sender.String(),
+		PortId:          portID,
+		ChannelId:       channelID,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterIBC) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterIBC) Type() string { return TypeMsgRegisterIBC }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRegisterIBC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterIBC message.
+func (msg *MsgRegisterIBC) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.RegisterAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRegisterIBC) ValidateBasic() error {
+	if err := Validate(msg); err != nil {
+		return err
+	}
+
+	return ValidateIBCChannel(msg.PortId, msg.ChannelId)
+}
+
+// == MsgUnregisterIBC ==
+const TypeMsgUnregisterIBC = "unregister_ibc"
+
+var _ sdk.Msg = &MsgUnregisterIBC{}
+
+func NewMsgUnregisterIBC(
+	sender sdk.Address,
+	contract sdk.Address,
+	portID, channelID string,
+) *MsgUnregisterIBC {
+	return &MsgUnregisterIBC{
+		ContractAddress: contract.String(),
+		RegisterAddress: sender.String(),
+		PortId:          portID,
+		ChannelId:       channelID,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUnregisterIBC) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUnregisterIBC) Type() string { return TypeMsgUnregisterIBC }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnregisterIBC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUnregisterIBC message.
+func (msg *MsgUnregisterIBC) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.RegisterAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUnregisterIBC) ValidateBasic() error {
+	if err := Validate(msg); err != nil {
+		return err
+	}
+
+	return ValidateIBCChannel(msg.PortId, msg.ChannelId)
+}
@@ -2,13 +2,14 @@ package types
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return NewParams(250_000)
+	return NewParams(250_000, 10)
 }
 
 // NewParams creates a new Params object
-func NewParams(contractGasLimit uint64) Params {
+func NewParams(contractGasLimit, maxIBCContractsPerChannel uint64) Params {
 	return Params{
-		ContractGasLimit: contractGasLimit,
+		ContractGasLimit:          contractGasLimit,
+		MaxIbcContractsPerChannel: maxIBCContractsPerChannel,
 	}
 }
 
@@ -276,6 +276,104 @@ func (m *QueryGovernanceContractsResponse) GetContracts() []string {
 	return nil
 }
 
+// QueryIBCContractsRequest
+type QueryIBCContractsRequest struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryIBCContractsRequest) Reset()         { *m = QueryIBCContractsRequest{} }
+func (m *QueryIBCContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCContractsRequest) ProtoMessage()    {}
+func (*QueryIBCContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c08b0c5bc2d2dc51, []int{6}
+}
+func (m *QueryIBCContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCContractsRequest.Merge(m, src)
+}
+func (m *QueryIBCContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCContractsRequest proto.InternalMessageInfo
+
+func (m *QueryIBCContractsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryIBCContractsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryIBCContractsResponse
+type QueryIBCContractsResponse struct {
+	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts" yaml:"contracts"`
+}
+
+func (m *QueryIBCContractsResponse) Reset()         { *m = QueryIBCContractsResponse{} }
+func (m *QueryIBCContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCContractsResponse) ProtoMessage()    {}
+func (*QueryIBCContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c08b0c5bc2d2dc51, []int{7}
+}
+func (m *QueryIBCContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCContractsResponse.Merge(m, src)
+}
+func (m *QueryIBCContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCContractsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCContractsResponse) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "juno.cwhooks.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "juno.cwhooks.v1.QueryParamsResponse")
@@ -283,42 +381,50 @@ func init() {
 	proto.RegisterType((*QueryStakingContractsResponse)(nil), "juno.cwhooks.v1.QueryStakingContractsResponse")
 	proto.RegisterType((*QueryGovernanceContractsRequest)(nil), "juno.cwhooks.v1.QueryGovernanceContractsRequest")
 	proto.RegisterType((*QueryGovernanceContractsResponse)(nil), "juno.cwhooks.v1.QueryGovernanceContractsResponse")
+	proto.RegisterType((*QueryIBCContractsRequest)(nil), "juno.cwhooks.v1.QueryIBCContractsRequest")
+	proto.RegisterType((*QueryIBCContractsResponse)(nil), "juno.cwhooks.v1.QueryIBCContractsResponse")
 }
 
 func init() { proto.RegisterFile("juno/cwhooks/v1/query.proto", fileDescriptor_c08b0c5bc2d2dc51) }
 
 var fileDescriptor_c08b0c5bc2d2dc51 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x26, 0x2a, 0xcd, 0x08, 0x31, 0x79, 0x93, 0x36, 0xb2, 0x2d, 0xe9, 0xcc, 0x10,
-	0x13, 0x52, 0xe3, 0x65, 0xdc, 0xb8, 0x20, 0x75, 0x07, 0x24, 0xc4, 0x01, 0xca, 0x8d, 0x0b, 0xb8,
-	0x96, 0xe5, 0x85, 0xad, 0x7e, 0x59, 0xec, 0x76, 0xf4, 0xca, 0x27, 0x40, 0xe2, 0xcc, 0x07, 0xe0,
-	0x9b, 0xc0, 0x6d, 0x12, 0x17, 0x4e, 0x15, 0x6a, 0x39, 0xed, 0xd8, 0x4f, 0x80, 0x6a, 0x9b, 0x54,
-	0x34, 0x2d, 0x02, 0x89, 0x5b, 0xf2, 0xfe, 0xef, 0xf9, 0xff, 0xcb, 0xfb, 0xc7, 0x68, 0xfb, 0x4d,
-	0x4f, 0x01, 0xe5, 0x17, 0x27, 0x00, 0xa7, 0x9a, 0xf6, 0x53, 0x7a, 0xde, 0x13, 0xc5, 0x20, 0xc9,
-	0x0b, 0x30, 0x80, 0x6f, 0x4d, 0xc5, 0xc4, 0x8b, 0x49, 0x3f, 0x0d, 0x37, 0x24, 0x48, 0xb0, 0x1a,
-	0x9d, 0x3e, 0xb9, 0xb6, 0x70, 0x47, 0x02, 0xc8, 0x33, 0x41, 0x59, 0x9e, 0x51, 0xa6, 0x14, 0x18,
-	0x66, 0x32, 0x50, 0xda, 0xab, 0x11, 0x07, 0xdd, 0x05, 0x4d, 0x3b, 0x4c, 0x0b, 0xda, 0x4f, 0x3b,
-	0xc2, 0xb0, 0x94, 0x72, 0xc8, 0x94, 0xd7, 0x77, 0xe7, 0x09, 0xa4, 0x50, 0x42, 0x67, 0x7e, 0x9c,
-	0x6c, 0x20, 0xfc, 0x7c, 0x8a, 0xf4, 0x8c, 0x15, 0xac, 0xab, 0xdb, 0xe2, 0xbc, 0x27, 0xb4, 0x21,
-	0x1c, 0xad, 0xff, 0x56, 0xd5, 0x39, 0x28, 0x2d, 0xf0, 0x53, 0x54, 0xcf, 0x6d, 0x65, 0x2b, 0x68,
-	0x04, 0x07, 0x37, 0x8e, 0x36, 0x93, 0xb9, 0x2f, 0x48, 0xdc, 0x40, 0x6b, 0xfb, 0x6a, 0x18, 0xfb,
-	0xd6, 0xc9, 0x30, 0xbe, 0x39, 0x60, 0xdd, 0xb3, 0x87, 0xc4, 0xbd, 0x93, 0xb6, 0x17, 0x48, 0x84,
-	0x76, 0xac, 0xc9, 0x0b, 0xc3, 0x4e, 0x33, 0x25, 0x8f, 0x41, 0x99, 0x82, 0x71, 0x53, 0x42, 0xbc,
-	0x46, 0xbb, 0x4b, 0x74, 0x8f, 0xf3, 0x08, 0xad, 0xf2, 0x5f, 0xc5, 0xad, 0xa0, 0xb1, 0x72, 0xb0,
-	0xda, 0xda, 0xbb, 0x1a, 0xc6, 0xb3, 0xe2, 0x64, 0x18, 0xaf, 0x39, 0xef, 0xb2, 0x44, 0xda, 0x33,
-	0x99, 0xec, 0xa1, 0xd8, 0x3a, 0x3c, 0x86, 0xbe, 0x28, 0x14, 0x53, 0x5c, 0x54, 0x20, 0x38, 0x6a,
-	0x2c, 0x6f, 0xf9, 0x4f, 0x1c, 0x47, 0x5f, 0x56, 0xd0, 0x75, 0xeb, 0x82, 0x0d, 0xaa, 0xbb, 0x15,
-	0xe2, 0x3b, 0x95, 0xdd, 0x56, 0x73, 0x0a, 0xf7, 0xff, 0xdc, 0xe4, 0xf8, 0x48, 0xfc, 0xee, 0xeb,
-	0x8f, 0x0f, 0xd7, 0x6e, 0xe3, 0x4d, 0x3a, 0xff, 0x2f, 0xb8, 0x24, 0xf0, 0xc7, 0x00, 0xad, 0xcd,
-	0x6f, 0x19, 0x37, 0x17, 0x9f, 0xbd, 0x24, 0xad, 0x30, 0xf9, 0xdb, 0x76, 0x0f, 0x75, 0xdf, 0x42,
-	0xed, 0x63, 0x52, 0x81, 0xd2, 0x6e, 0xe4, 0x55, 0xb9, 0x1f, 0xfc, 0x29, 0x40, 0xeb, 0x0b, 0x02,
-	0xc0, 0x87, 0x8b, 0x3d, 0x97, 0xc7, 0x19, 0xa6, 0xff, 0x30, 0xe1, 0x41, 0x9b, 0x16, 0xf4, 0x1e,
-	0xbe, 0x5b, 0x01, 0x95, 0xe5, 0xd4, 0x8c, 0xb5, 0xf5, 0xe4, 0xf3, 0x28, 0x0a, 0x2e, 0x47, 0x51,
-	0xf0, 0x7d, 0x14, 0x05, 0xef, 0xc7, 0x51, 0xed, 0x72, 0x1c, 0xd5, 0xbe, 0x8d, 0xa3, 0xda, 0xcb,
-	0x43, 0x99, 0x99, 0x93, 0x5e, 0x27, 0xe1, 0xd0, 0xa5, 0xc7, 0xf6, 0xd2, 0x96, 0x66, 0xee, 0xe8,
-	0xb7, 0x94, 0x5f, 0x34, 0xdd, 0xe9, 0x66, 0x90, 0x0b, 0xdd, 0xa9, 0xdb, 0x3b, 0xfa, 0xe0, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0x28, 0x8e, 0xa2, 0x46, 0x04, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x55, 0x23, 0x19, 0x15, 0xcb, 0xb4, 0x90, 0x74, 0xdb, 0xec, 0xa6, 0x6b, 0xc5,
+	0x5a, 0xc8, 0x4e, 0x53, 0x11, 0x41, 0x10, 0x21, 0x39, 0x48, 0xc4, 0x83, 0xc6, 0x9b, 0x08, 0x75,
+	0x32, 0x19, 0x36, 0x6b, 0x93, 0x99, 0xed, 0xce, 0x24, 0x35, 0x94, 0x5e, 0xfc, 0x04, 0x82, 0x67,
+	0x6f, 0x5e, 0xfc, 0x26, 0x1e, 0x0b, 0x5e, 0x3c, 0x05, 0x49, 0x3c, 0xf5, 0x24, 0xfd, 0x04, 0x92,
+	0xd9, 0x31, 0xa9, 0xbb, 0x1b, 0xa9, 0xd0, 0x5b, 0xe6, 0xfd, 0xdf, 0x7b, 0xff, 0x5f, 0x66, 0xff,
+	0x0c, 0x58, 0x7d, 0xdb, 0x63, 0x1c, 0x91, 0x83, 0x36, 0xe7, 0x7b, 0x02, 0xf5, 0x2b, 0x68, 0xbf,
+	0x47, 0xc3, 0x81, 0x1b, 0x84, 0x5c, 0x72, 0x78, 0x73, 0x22, 0xba, 0x5a, 0x74, 0xfb, 0x15, 0x73,
+	0xd9, 0xe3, 0x1e, 0x57, 0x1a, 0x9a, 0xfc, 0x8a, 0xda, 0xcc, 0x35, 0x8f, 0x73, 0xaf, 0x43, 0x11,
+	0x0e, 0x7c, 0x84, 0x19, 0xe3, 0x12, 0x4b, 0x9f, 0x33, 0xa1, 0x55, 0x8b, 0x70, 0xd1, 0xe5, 0x02,
+	0x35, 0xb1, 0xa0, 0xa8, 0x5f, 0x69, 0x52, 0x89, 0x2b, 0x88, 0x70, 0x9f, 0x69, 0xbd, 0x18, 0x27,
+	0xf0, 0x28, 0xa3, 0xc2, 0xd7, 0xe3, 0xce, 0x32, 0x80, 0x2f, 0x26, 0x48, 0xcf, 0x71, 0x88, 0xbb,
+	0xa2, 0x41, 0xf7, 0x7b, 0x54, 0x48, 0x87, 0x80, 0xa5, 0xbf, 0xaa, 0x22, 0xe0, 0x4c, 0x50, 0xf8,
+	0x0c, 0x64, 0x03, 0x55, 0x29, 0x18, 0x25, 0x63, 0xf3, 0xda, 0x4e, 0xde, 0x8d, 0xfd, 0x03, 0x37,
+	0x1a, 0xa8, 0xae, 0x9e, 0x0c, 0x6d, 0xdd, 0x7a, 0x3a, 0xb4, 0x6f, 0x0c, 0x70, 0xb7, 0xf3, 0xd0,
+	0x89, 0xce, 0x4e, 0x43, 0x0b, 0x8e, 0x05, 0xd6, 0x94, 0xc9, 0x4b, 0x89, 0xf7, 0x7c, 0xe6, 0xd5,
+	0x38, 0x93, 0x21, 0x26, 0x72, 0x0a, 0xf1, 0x06, 0x14, 0xe7, 0xe8, 0x1a, 0xe7, 0x31, 0xc8, 0x91,
+	0x3f, 0xc5, 0x82, 0x51, 0xba, 0xb4, 0x99, 0xab, 0xae, 0x9f, 0x0c, 0xed, 0x59, 0xf1, 0x74, 0x68,
+	0x2f, 0x46, 0xde, 0xd3, 0x92, 0xd3, 0x98, 0xc9, 0xce, 0x3a, 0xb0, 0x95, 0xc3, 0x13, 0xde, 0xa7,
+	0x21, 0xc3, 0x8c, 0xd0, 0x04, 0x04, 0x01, 0xa5, 0xf9, 0x2d, 0x17, 0xc5, 0xd1, 0x00, 0x05, 0x65,
+	0x52, 0xaf, 0xd6, 0xe2, 0x00, 0x30, 0x0f, 0xae, 0x06, 0x3c, 0x94, 0xbb, 0x7e, 0x4b, 0x5d, 0x7a,
+	0xae, 0x91, 0x9d, 0x1c, 0xeb, 0x2d, 0x58, 0x04, 0x80, 0xb4, 0x31, 0x63, 0xb4, 0x33, 0xd1, 0x16,
+	0x94, 0x96, 0xd3, 0x95, 0x7a, 0xcb, 0x79, 0x0d, 0x56, 0x52, 0x76, 0x5e, 0x10, 0xf1, 0xce, 0xaf,
+	0xcb, 0xe0, 0x8a, 0x5a, 0x0f, 0x25, 0xc8, 0x46, 0x1f, 0x1d, 0xde, 0x4a, 0xa4, 0x21, 0x99, 0x2c,
+	0x73, 0xe3, 0xdf, 0x4d, 0x11, 0x9f, 0x63, 0xbf, 0xff, 0xf6, 0xf3, 0xe3, 0xc2, 0x0a, 0xcc, 0xa3,
+	0x78, 0x7a, 0xa3, 0xec, 0xc0, 0x4f, 0x06, 0x58, 0x8c, 0xe7, 0x02, 0x96, 0xd3, 0x77, 0xcf, 0xc9,
+	0x97, 0xe9, 0x9e, 0xb7, 0x5d, 0x43, 0x6d, 0x29, 0xa8, 0x0d, 0xe8, 0x24, 0xa0, 0x44, 0x34, 0xb2,
+	0x3b, 0xbd, 0x1f, 0xf8, 0xc5, 0x00, 0x4b, 0x29, 0x91, 0x81, 0xdb, 0xe9, 0x9e, 0xf3, 0x03, 0x68,
+	0x56, 0xfe, 0x63, 0x42, 0x83, 0x96, 0x15, 0xe8, 0x1d, 0x78, 0x3b, 0x01, 0xea, 0x4d, 0xa7, 0xce,
+	0xb0, 0x7e, 0x36, 0xc0, 0xf5, 0xb3, 0x29, 0x81, 0x77, 0xd3, 0x2d, 0x53, 0xd2, 0x69, 0x6e, 0x9d,
+	0xa7, 0x55, 0x63, 0x3d, 0x52, 0x58, 0x0f, 0xe0, 0xfd, 0x04, 0x96, 0xdf, 0x24, 0x33, 0x1e, 0x74,
+	0xa8, 0xf3, 0x7e, 0x84, 0x0e, 0x67, 0x01, 0x3f, 0xaa, 0x3e, 0xfd, 0x3a, 0xb2, 0x8c, 0xe3, 0x91,
+	0x65, 0xfc, 0x18, 0x59, 0xc6, 0x87, 0xb1, 0x95, 0x39, 0x1e, 0x5b, 0x99, 0xef, 0x63, 0x2b, 0xf3,
+	0x6a, 0xdb, 0xf3, 0x65, 0xbb, 0xd7, 0x74, 0x09, 0xef, 0xa2, 0x9a, 0x7a, 0x0d, 0xa7, 0xe6, 0x91,
+	0xd5, 0x3b, 0x44, 0x0e, 0xca, 0x91, 0x9b, 0x1c, 0x04, 0x54, 0x34, 0xb3, 0xea, 0xf1, 0xbb, 0xf7,
+	0x7b, 0x00, 0xff, 0x0e, 0x9e, 0xda, 0x9f, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +445,9 @@ type QueryClient interface {
 	StakingContracts(ctx context.Context, in *QueryStakingContractsRequest, opts ...grpc.CallOption) (*QueryStakingContractsResponse, error)
 	// GovernanceContracts
 	GovernanceContracts(ctx context.Context, in *QueryGovernanceContractsRequest, opts ...grpc.CallOption) (*QueryGovernanceContractsResponse, error)
+	// IBCContracts returns the contracts registered for the packets of an IBC
+	// channel
+	IBCContracts(ctx context.Context, in *QueryIBCContractsRequest, opts ...grpc.CallOption) (*QueryIBCContractsResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +485,15 @@ func (c *queryClient) GovernanceContracts(ctx context.Context, in *QueryGovernan
 	return out, nil
 }
 
+func (c *queryClient) IBCContracts(ctx context.Context, in *QueryIBCContractsRequest, opts ...grpc.CallOption) (*QueryIBCContractsResponse, error) {
+	out := new(QueryIBCContractsResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Query/IBCContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params
@@ -384,6 +502,9 @@ type QueryServer interface {
 	StakingContracts(context.Context, *QueryStakingContractsRequest) (*QueryStakingContractsResponse, error)
 	// GovernanceContracts
 	GovernanceContracts(context.Context, *QueryGovernanceContractsRequest) (*QueryGovernanceContractsResponse, error)
+	// IBCContracts returns the contracts registered for the packets of an IBC
+	// channel
+	IBCContracts(context.Context, *QueryIBCContractsRequest) (*QueryIBCContractsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +520,9 @@ func (*UnimplementedQueryServer) StakingContracts(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GovernanceContracts(ctx context.Context, req *QueryGovernanceContractsRequest) (*QueryGovernanceContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovernanceContracts not implemented")
 }
+func (*UnimplementedQueryServer) IBCContracts(ctx context.Context, req *QueryIBCContractsRequest) (*QueryIBCContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCContracts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +582,24 @@ func _Query_GovernanceContracts_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IBCContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Query/IBCContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCContracts(ctx, req.(*QueryIBCContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.cwhooks.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +616,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GovernanceContracts",
 			Handler:    _Query_GovernanceContracts_Handler,
 		},
+		{
+			MethodName: "IBCContracts",
+			Handler:    _Query_IBCContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/cwhooks/v1/query.proto",
@@ -647,6 +793,75 @@ func (m *QueryGovernanceContractsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryIBCContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -728,6 +943,38 @@ func (m *QueryGovernanceContractsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIBCContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1134,6 +1381,202 @@ func (m *QueryGovernanceContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryIBCContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -490,6 +490,222 @@ func (m *MsgUnregisterStakingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterStakingResponse proto.InternalMessageInfo
 
+// MsgRegisterIBC
+type MsgRegisterIBC struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// port_id of the channel end on this chain
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id of the channel end on this chain
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgRegisterIBC) Reset()         { *m = MsgRegisterIBC{} }
+func (m *MsgRegisterIBC) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIBC) ProtoMessage()    {}
+func (*MsgRegisterIBC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{10}
+}
+func (m *MsgRegisterIBC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIBC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIBC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIBC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIBC.Merge(m, src)
+}
+func (m *MsgRegisterIBC) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIBC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIBC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIBC proto.InternalMessageInfo
+
+func (m *MsgRegisterIBC) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterIBC) GetRegisterAddress() string {
+	if m != nil {
+		return m.RegisterAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterIBC) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgRegisterIBC) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgRegisterIBCResponse
+type MsgRegisterIBCResponse struct {
+}
+
+func (m *MsgRegisterIBCResponse) Reset()         { *m = MsgRegisterIBCResponse{} }
+func (m *MsgRegisterIBCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIBCResponse) ProtoMessage()    {}
+func (*MsgRegisterIBCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{11}
+}
+func (m *MsgRegisterIBCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIBCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIBCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIBCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIBCResponse.Merge(m, src)
+}
+func (m *MsgRegisterIBCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIBCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIBCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIBCResponse proto.InternalMessageInfo
+
+// MsgUnregisterIBC
+type MsgUnregisterIBC struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// port_id of the channel end on this chain
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id of the channel end on this chain
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *MsgUnregisterIBC) Reset()         { *m = MsgUnregisterIBC{} }
+func (m *MsgUnregisterIBC) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterIBC) ProtoMessage()    {}
+func (*MsgUnregisterIBC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{12}
+}
+func (m *MsgUnregisterIBC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterIBC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterIBC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterIBC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterIBC.Merge(m, src)
+}
+func (m *MsgUnregisterIBC) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterIBC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterIBC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterIBC proto.InternalMessageInfo
+
+func (m *MsgUnregisterIBC) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUnregisterIBC) GetRegisterAddress() string {
+	if m != nil {
+		return m.RegisterAddress
+	}
+	return ""
+}
+
+func (m *MsgUnregisterIBC) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgUnregisterIBC) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// MsgUnregisterIBCResponse
+type MsgUnregisterIBCResponse struct {
+}
+
+func (m *MsgUnregisterIBCResponse) Reset()         { *m = MsgUnregisterIBCResponse{} }
+func (m *MsgUnregisterIBCResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterIBCResponse) ProtoMessage()    {}
+func (*MsgUnregisterIBCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{13}
+}
+func (m *MsgUnregisterIBCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterIBCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterIBCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterIBCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterIBCResponse.Merge(m, src)
+}
+func (m *MsgUnregisterIBCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterIBCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterIBCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterIBCResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.cwhooks.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.cwhooks.v1.MsgUpdateParamsResponse")
@@ -501,46 +717,57 @@ func init() {
 	proto.RegisterType((*MsgUnregisterGovernanceResponse)(nil), "juno.cwhooks.v1.MsgUnregisterGovernanceResponse")
 	proto.RegisterType((*MsgUnregisterStaking)(nil), "juno.cwhooks.v1.MsgUnregisterStaking")
 	proto.RegisterType((*MsgUnregisterStakingResponse)(nil), "juno.cwhooks.v1.MsgUnregisterStakingResponse")
+	proto.RegisterType((*MsgRegisterIBC)(nil), "juno.cwhooks.v1.MsgRegisterIBC")
+	proto.RegisterType((*MsgRegisterIBCResponse)(nil), "juno.cwhooks.v1.MsgRegisterIBCResponse")
+	proto.RegisterType((*MsgUnregisterIBC)(nil), "juno.cwhooks.v1.MsgUnregisterIBC")
+	proto.RegisterType((*MsgUnregisterIBCResponse)(nil), "juno.cwhooks.v1.MsgUnregisterIBCResponse")
 }
 
 func init() { proto.RegisterFile("juno/cwhooks/v1/tx.proto", fileDescriptor_2868e302cb80fd0b) }
 
 var fileDescriptor_2868e302cb80fd0b = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x31, 0x6f, 0xd3, 0x4e,
-	0x14, 0xc0, 0x7d, 0xff, 0x7f, 0x54, 0x29, 0x0f, 0x84, 0x5b, 0x2b, 0x28, 0xa9, 0xd5, 0x3a, 0x21,
-	0x08, 0x14, 0x40, 0xb1, 0xdb, 0x22, 0x18, 0xba, 0x91, 0x0c, 0x48, 0x48, 0x91, 0x90, 0x2b, 0x96,
-	0x2e, 0x95, 0xeb, 0x9c, 0x2e, 0xa6, 0xf8, 0xce, 0xba, 0xbb, 0xa4, 0xed, 0xca, 0x88, 0x40, 0x54,
-	0xe2, 0x0b, 0xf0, 0x11, 0x18, 0xf8, 0x10, 0x1d, 0x2b, 0x26, 0x26, 0x84, 0x92, 0x01, 0x3e, 0x06,
-	0x8a, 0x7d, 0x76, 0x5a, 0xc7, 0xb4, 0xd9, 0xca, 0x12, 0x39, 0xef, 0xfd, 0xfc, 0xde, 0xef, 0x4e,
-	0xf7, 0x7c, 0x50, 0x7b, 0x3d, 0xa4, 0xcc, 0xf1, 0x0f, 0x07, 0x8c, 0x1d, 0x08, 0x67, 0xb4, 0xe9,
-	0xc8, 0x23, 0x3b, 0xe2, 0x4c, 0x32, 0x43, 0x9f, 0x66, 0x6c, 0x95, 0xb1, 0x47, 0x9b, 0x66, 0xd5,
-	0x67, 0x22, 0x64, 0xc2, 0x09, 0x05, 0x99, 0x82, 0xa1, 0x20, 0x09, 0x69, 0xae, 0xe7, 0x6b, 0x10,
-	0x4c, 0xb1, 0x08, 0x84, 0x4a, 0x57, 0x08, 0x23, 0x2c, 0x7e, 0x74, 0xa6, 0x4f, 0x2a, 0xba, 0x9a,
-	0x54, 0xdb, 0x4b, 0x12, 0xc9, 0x1f, 0x95, 0x5a, 0xf1, 0xc2, 0x80, 0x32, 0x27, 0xfe, 0x4d, 0x42,
-	0xcd, 0x13, 0x04, 0x7a, 0x4f, 0x90, 0x57, 0x51, 0xdf, 0x93, 0xf8, 0xa5, 0xc7, 0xbd, 0x50, 0x18,
-	0x4f, 0xa1, 0xec, 0x0d, 0xe5, 0x80, 0xf1, 0x40, 0x1e, 0xd7, 0x50, 0x03, 0xb5, 0xca, 0x9d, 0xda,
-	0xb7, 0xaf, 0xed, 0x8a, 0xaa, 0xf5, 0xac, 0xdf, 0xe7, 0x58, 0x88, 0x1d, 0xc9, 0x03, 0x4a, 0xdc,
-	0x19, 0x6a, 0x3c, 0x81, 0xa5, 0x28, 0xae, 0x50, 0xfb, 0xaf, 0x81, 0x5a, 0x37, 0xb6, 0xaa, 0x76,
-	0x6e, 0xa5, 0x76, 0xd2, 0xa0, 0x53, 0x3a, 0xfd, 0x51, 0xd7, 0x5c, 0x05, 0x6f, 0xdf, 0x7a, 0xfb,
-	0xeb, 0xcb, 0xc3, 0x59, 0x99, 0xe6, 0x2a, 0x54, 0x73, 0x46, 0x2e, 0x16, 0x11, 0xa3, 0x02, 0x37,
-	0xdf, 0x21, 0x30, 0x7a, 0x82, 0xb8, 0x98, 0x04, 0x42, 0x62, 0xbe, 0x23, 0xbd, 0x83, 0x80, 0x12,
-	0xa3, 0x0b, 0xcb, 0x3e, 0xa3, 0x92, 0x7b, 0xbe, 0xdc, 0xf3, 0x12, 0xbb, 0x2b, 0xbd, 0xf5, 0xf4,
-	0x0d, 0x15, 0x36, 0x1e, 0xc0, 0x32, 0x57, 0x75, 0xb3, 0x22, 0xd3, 0x75, 0x94, 0x5d, 0x3d, 0x8d,
-	0x2b, 0x74, 0xbb, 0xf4, 0xfb, 0x73, 0x5d, 0x6b, 0xae, 0x81, 0x39, 0xef, 0x92, 0xa9, 0x7e, 0x40,
-	0x70, 0xfb, 0x5c, 0xfa, 0x39, 0x1b, 0x61, 0x4e, 0x3d, 0xea, 0xe3, 0x6b, 0xb2, 0xad, 0xc3, 0x7a,
-	0xa1, 0x4e, 0x26, 0xfc, 0x11, 0x25, 0xfb, 0x4e, 0xf9, 0xbf, 0xa2, 0x7c, 0x07, 0xea, 0x7f, 0x11,
-	0xca, 0xa4, 0xdf, 0x23, 0xa8, 0x5c, 0x60, 0xae, 0xf7, 0x48, 0x58, 0xb0, 0x56, 0x64, 0x93, 0xea,
-	0x6e, 0x7d, 0x2a, 0xc1, 0xff, 0x3d, 0x41, 0x8c, 0x5d, 0xb8, 0x79, 0x61, 0xe2, 0x1a, 0x73, 0x93,
-	0x92, 0x9b, 0x00, 0xb3, 0x75, 0x15, 0x91, 0xf6, 0x30, 0x7c, 0xd0, 0xf3, 0xf3, 0x71, 0xb7, 0xe8,
-	0xe5, 0x1c, 0x64, 0x3e, 0x5a, 0x00, 0xca, 0x9a, 0x04, 0xb0, 0x32, 0xbf, 0xe7, 0xf7, 0x0a, 0x1d,
-	0xf3, 0x98, 0xd9, 0x5e, 0x08, 0xcb, 0x5a, 0xbd, 0x01, 0xa3, 0x60, 0x88, 0xee, 0x5f, 0x66, 0x3b,
-	0xe3, 0x4c, 0x7b, 0x31, 0x2e, 0xeb, 0xc6, 0xa1, 0x52, 0x38, 0x01, 0xad, 0xcb, 0xa5, 0xcf, 0x75,
-	0xdc, 0x58, 0x94, 0x4c, 0x7b, 0x76, 0x5e, 0x9c, 0x8e, 0x2d, 0x74, 0x36, 0xb6, 0xd0, 0xcf, 0xb1,
-	0x85, 0x4e, 0x26, 0x96, 0x76, 0x36, 0xb1, 0xb4, 0xef, 0x13, 0x4b, 0xdb, 0xdd, 0x20, 0x81, 0x1c,
-	0x0c, 0xf7, 0x6d, 0x9f, 0x85, 0x4e, 0x37, 0x3e, 0xb2, 0x5d, 0x75, 0x44, 0x85, 0x13, 0xdf, 0x0d,
-	0x47, 0x8e, 0x7f, 0xd8, 0x4e, 0xae, 0x07, 0x79, 0x1c, 0x61, 0xb1, 0xbf, 0x14, 0x7f, 0xd6, 0x1f,
-	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x33, 0x2b, 0xca, 0xcf, 0x7f, 0x06, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x82, 0x98, 0x3e, 0x94, 0xc2, 0xa6, 0xda, 0x65, 0x03, 0x5b, 0xa8, 0x51, 0x8b,
+	0x86, 0x5d, 0xc0, 0xe8, 0x81, 0x9b, 0xed, 0xc1, 0xd4, 0x84, 0xc4, 0x94, 0x18, 0x13, 0x12, 0x43,
+	0x96, 0xed, 0x64, 0xba, 0x42, 0x67, 0x36, 0x33, 0xc3, 0xaf, 0xab, 0x47, 0xa3, 0x91, 0x3f, 0xc1,
+	0x3f, 0xc1, 0x83, 0x67, 0xe3, 0x91, 0xc4, 0x0b, 0xf1, 0xe4, 0xc9, 0x18, 0x38, 0xe8, 0x9f, 0x61,
+	0x76, 0x77, 0xba, 0xb4, 0xdb, 0xb5, 0xed, 0x49, 0xf4, 0xd2, 0xec, 0xbe, 0xf7, 0x9d, 0xef, 0xfb,
+	0xbc, 0xb6, 0xef, 0x65, 0x40, 0x7f, 0xb9, 0x4b, 0x99, 0xed, 0xee, 0x37, 0x19, 0xdb, 0x16, 0xf6,
+	0xde, 0xb2, 0x2d, 0x0f, 0x2c, 0x9f, 0x33, 0xc9, 0xb4, 0x5c, 0x90, 0xb1, 0x54, 0xc6, 0xda, 0x5b,
+	0x36, 0x0a, 0x2e, 0x13, 0x2d, 0x26, 0xec, 0x96, 0x20, 0x81, 0xb0, 0x25, 0x48, 0xa4, 0x34, 0x66,
+	0x93, 0x1e, 0x04, 0x53, 0x2c, 0x3c, 0xa1, 0xd2, 0x79, 0xc2, 0x08, 0x0b, 0x1f, 0xed, 0xe0, 0x49,
+	0x45, 0xa7, 0x23, 0xb7, 0xcd, 0x28, 0x11, 0xbd, 0xa8, 0xd4, 0x94, 0xd3, 0xf2, 0x28, 0xb3, 0xc3,
+	0xcf, 0x28, 0x54, 0x3a, 0x42, 0x90, 0x5b, 0x13, 0xe4, 0x99, 0xdf, 0x70, 0x24, 0x7e, 0xea, 0x70,
+	0xa7, 0x25, 0xb4, 0x87, 0x90, 0x75, 0x76, 0x65, 0x93, 0x71, 0x4f, 0x1e, 0xea, 0x68, 0x0e, 0x95,
+	0xb3, 0x15, 0xfd, 0xeb, 0xc7, 0xc5, 0xbc, 0xf2, 0x7a, 0xd4, 0x68, 0x70, 0x2c, 0xc4, 0xba, 0xe4,
+	0x1e, 0x25, 0xf5, 0x73, 0xa9, 0xf6, 0x00, 0xc6, 0xfc, 0xd0, 0x41, 0xbf, 0x34, 0x87, 0xca, 0xe3,
+	0x2b, 0x05, 0x2b, 0xd1, 0xa9, 0x15, 0x15, 0xa8, 0x8c, 0x1e, 0x7f, 0x2f, 0x66, 0xea, 0x4a, 0xbc,
+	0x3a, 0xf1, 0xea, 0xe7, 0x87, 0xbb, 0xe7, 0x36, 0xa5, 0x69, 0x28, 0x24, 0x88, 0xea, 0x58, 0xf8,
+	0x8c, 0x0a, 0x5c, 0x7a, 0x8d, 0x40, 0x5b, 0x13, 0xa4, 0x8e, 0x89, 0x27, 0x24, 0xe6, 0xeb, 0xd2,
+	0xd9, 0xf6, 0x28, 0xd1, 0xaa, 0x30, 0xe9, 0x32, 0x2a, 0xb9, 0xe3, 0xca, 0x4d, 0x27, 0xa2, 0x1b,
+	0xc8, 0x9d, 0x6b, 0x9f, 0x50, 0x61, 0x6d, 0x01, 0x26, 0xb9, 0xf2, 0x8d, 0x4d, 0x82, 0x3e, 0xb2,
+	0xf5, 0x5c, 0x3b, 0xae, 0xa4, 0xab, 0xa3, 0xbf, 0xde, 0x17, 0x33, 0xa5, 0x19, 0x30, 0x7a, 0x59,
+	0x62, 0xd4, 0xb7, 0x08, 0xae, 0x77, 0xa4, 0x1f, 0xb3, 0x3d, 0xcc, 0xa9, 0x43, 0x5d, 0x7c, 0x41,
+	0xb4, 0x45, 0x98, 0x4d, 0xc5, 0x89, 0x81, 0xdf, 0xa1, 0xe8, 0x7b, 0xa7, 0xfc, 0x5f, 0x41, 0x9e,
+	0x87, 0xe2, 0x1f, 0x80, 0x62, 0xe8, 0x37, 0x08, 0xf2, 0x5d, 0x9a, 0x8b, 0xfd, 0x4b, 0x98, 0x30,
+	0x93, 0x46, 0x13, 0xe3, 0x7e, 0x42, 0x30, 0xd1, 0xf1, 0x2b, 0xd4, 0x2a, 0xd5, 0xbf, 0x0d, 0xaa,
+	0x15, 0xe0, 0x8a, 0xcf, 0xb8, 0xdc, 0xf4, 0x1a, 0xfa, 0x48, 0xa8, 0x18, 0x0b, 0x5e, 0x6b, 0x0d,
+	0x6d, 0x16, 0xc0, 0x6d, 0x3a, 0x94, 0xe2, 0x9d, 0x20, 0x37, 0x1a, 0xe6, 0xb2, 0x2a, 0x52, 0x6b,
+	0xa8, 0x06, 0x75, 0xb8, 0xd1, 0xcd, 0x1f, 0xb7, 0xf6, 0x19, 0xc1, 0x64, 0x57, 0xef, 0xff, 0x5f,
+	0x73, 0x06, 0xe8, 0xc9, 0x0e, 0xda, 0xed, 0xad, 0x7c, 0xb9, 0x0c, 0x23, 0x6b, 0x82, 0x68, 0x1b,
+	0x70, 0xb5, 0x6b, 0x57, 0xce, 0xf5, 0xec, 0xb8, 0xc4, 0xee, 0x32, 0xca, 0x83, 0x14, 0xed, 0x1a,
+	0x9a, 0x0b, 0xb9, 0xe4, 0x66, 0xbb, 0x99, 0x76, 0x38, 0x21, 0x32, 0xee, 0x0d, 0x21, 0x8a, 0x8b,
+	0x78, 0x30, 0xd5, 0x3b, 0x2d, 0xb7, 0x52, 0x19, 0x93, 0x32, 0x63, 0x71, 0x28, 0x59, 0x5c, 0x6a,
+	0x07, 0xb4, 0x94, 0xf5, 0x77, 0xbb, 0x1f, 0xed, 0xb9, 0xce, 0xb0, 0x86, 0xd3, 0xc5, 0xd5, 0x38,
+	0xe4, 0x53, 0x77, 0x57, 0xb9, 0x3f, 0x74, 0x47, 0xc5, 0xa5, 0x61, 0x95, 0x71, 0xcd, 0xe7, 0x30,
+	0xde, 0x39, 0xcb, 0xc5, 0x7e, 0xc8, 0xb5, 0x4a, 0xd5, 0xb8, 0x33, 0x40, 0x10, 0x1b, 0xbf, 0x80,
+	0x6b, 0xdd, 0x93, 0x34, 0xdf, 0x9f, 0x2d, 0x30, 0x5f, 0x18, 0x28, 0x69, 0xdb, 0x57, 0x9e, 0x1c,
+	0x9f, 0x9a, 0xe8, 0xe4, 0xd4, 0x44, 0x3f, 0x4e, 0x4d, 0x74, 0x74, 0x66, 0x66, 0x4e, 0xce, 0xcc,
+	0xcc, 0xb7, 0x33, 0x33, 0xb3, 0xb1, 0x44, 0x3c, 0xd9, 0xdc, 0xdd, 0xb2, 0x5c, 0xd6, 0xb2, 0xab,
+	0xe1, 0x78, 0x56, 0xd5, 0x38, 0x0a, 0x3b, 0xbc, 0x8d, 0x1c, 0xd8, 0xee, 0xfe, 0x62, 0x74, 0x21,
+	0x91, 0x87, 0x3e, 0x16, 0x5b, 0x63, 0xe1, 0x45, 0xe2, 0xfe, 0xef, 0x01, 0x00, 0x0a, 0x9d, 0x78,
+	0x96, 0xf1, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -568,6 +795,10 @@ type MsgClient interface {
 	RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error)
 	// UnregisterGovernance.
 	UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error)
+	// RegisterIBC registers a contract for the packets of an IBC channel.
+	RegisterIBC(ctx context.Context, in *MsgRegisterIBC, opts ...grpc.CallOption) (*MsgRegisterIBCResponse, error)
+	// UnregisterIBC unregisters a contract from the packets of an IBC channel.
+	UnregisterIBC(ctx context.Context, in *MsgUnregisterIBC, opts ...grpc.CallOption) (*MsgUnregisterIBCResponse, error)
 }
 
 type msgClient struct {
@@ -623,6 +854,24 @@ func (c *msgClient) UnregisterGovernance(ctx context.Context, in *MsgUnregisterG
 	return out, nil
 }
 
+func (c *msgClient) RegisterIBC(ctx context.Context, in *MsgRegisterIBC, opts ...grpc.CallOption) (*MsgRegisterIBCResponse, error) {
+	out := new(MsgRegisterIBCResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterIBC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterIBC(ctx context.Context, in *MsgUnregisterIBC, opts ...grpc.CallOption) (*MsgUnregisterIBCResponse, error) {
+	out := new(MsgUnregisterIBCResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterIBC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/clock module
@@ -638,6 +887,10 @@ type MsgServer interface {
 	RegisterGovernance(context.Context, *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error)
 	// UnregisterGovernance.
 	UnregisterGovernance(context.Context, *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error)
+	// RegisterIBC registers a contract for the packets of an IBC channel.
+	RegisterIBC(context.Context, *MsgRegisterIBC) (*MsgRegisterIBCResponse, error)
+	// UnregisterIBC unregisters a contract from the packets of an IBC channel.
+	UnregisterIBC(context.Context, *MsgUnregisterIBC) (*MsgUnregisterIBCResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -659,6 +912,12 @@ func (*UnimplementedMsgServer) RegisterGovernance(ctx context.Context, req *MsgR
 func (*UnimplementedMsgServer) UnregisterGovernance(ctx context.Context, req *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterGovernance not implemented")
 }
+func (*UnimplementedMsgServer) RegisterIBC(ctx context.Context, req *MsgRegisterIBC) (*MsgRegisterIBCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIBC not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterIBC(ctx context.Context, req *MsgUnregisterIBC) (*MsgUnregisterIBCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterIBC not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -754,6 +1013,42 @@ func _Msg_UnregisterGovernance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterIBC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterIBC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterIBC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterIBC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterIBC(ctx, req.(*MsgRegisterIBC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterIBC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterIBC)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterIBC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterIBC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterIBC(ctx, req.(*MsgUnregisterIBC))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.cwhooks.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -778,6 +1073,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterGovernance",
 			Handler:    _Msg_UnregisterGovernance_Handler,
 		},
+		{
+			MethodName: "RegisterIBC",
+			Handler:    _Msg_RegisterIBC_Handler,
+		},
+		{
+			MethodName: "UnregisterIBC",
+			Handler:    _Msg_UnregisterIBC_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/cwhooks/v1/tx.proto",
@@ -1086,65 +1389,213 @@ func (m *MsgUnregisterStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterIBC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRegisterIBC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterStaking) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterIBC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.RegisterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterStakingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterIBCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIBCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIBCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterIBC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterIBC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterIBC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterIBCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterIBCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterIBCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgRegisterGovernance) Size() (n int) {
@@ -1213,25 +1664,422 @@ func (m *MsgUnregisterStaking) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUnregisterStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterIBC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterIBCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterIBC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterIBCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUnregisterStakingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,15 +2102,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterGovernance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +2138,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1306,24 +2154,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1346,7 +2193,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,10 +2216,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1396,7 +2243,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1419,10 +2266,10 @@ func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterGovernance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1510,7 +2357,7 @@ func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1533,10 +2380,10 @@ func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStakingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1560,7 +2407,7 @@ func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1583,10 +2430,10 @@ func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGovernance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterStaking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1674,7 +2521,7 @@ func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1697,10 +2544,10 @@ func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterStakingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1724,7 +2571,7 @@ func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterIBC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,10 +2594,10 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterGovernance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterIBC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterIBC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1817,6 +2664,70 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 			}
 			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1838,7 +2749,7 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterIBCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1861,10 +2772,10 @@ func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterIBCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterIBCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1888,7 +2799,7 @@ func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterIBC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1911,10 +2822,10 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterIBC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterIBC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1981,6 +2892,70 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 			}
 			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2002,7 +2977,7 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterIBCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2025,10 +3000,10 @@ func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterStakingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterIBCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterIBCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,19 @@
+package drip
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/drip/keeper"
+	"github.com/CosmosContracts/juno/v23/x/drip/types"
+)
+
+// BeginBlocker releases the tokens of the active drip schedules to the fee
+// collector.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ReleaseSchedules(ctx)
+}
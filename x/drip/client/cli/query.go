@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/CosmosContracts/juno/v23/x/drip/types"
 )
 
+// FlagSender defines the flag to filter drip schedules by sender
+const FlagSender = "sender"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	feesQueryCmd := &cobra.Command{
@@ -24,6 +28,10 @@ func GetQueryCmd() *cobra.Command {
 
 	feesQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQuerySchedule(),
+		GetCmdQuerySchedules(),
+		GetCmdQuerySenderDistribution(),
+		GetCmdQuerySenderDistributions(),
 	)
 
 	return feesQueryCmd
@@ -57,3 +65,149 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySchedule implements a command to return a drip schedule by id.
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [id]",
+		Short: "Query an active drip schedule by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Schedule(context.Background(), &types.QueryScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySchedules implements a command to return all the active drip
+// schedules.
+func GetCmdQuerySchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Query all the active drip schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySchedulesRequest{
+				SenderAddress: sender,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.Schedules(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "Only return the schedules created by this address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+
+	return cmd
+}
+
+// GetCmdQuerySenderDistribution returns the cumulative amount distributed by a
+// sender
+func GetCmdQuerySenderDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sender-distribution [sender]",
+		Short: "Query the cumulative amount distributed by a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySenderDistributionRequest{
+				SenderAddress: args[0],
+			}
+
+			res, err := queryClient.SenderDistribution(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySenderDistributions returns the cumulative amounts distributed by
+// all senders
+func GetCmdQuerySenderDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sender-distributions",
+		Short: "Query the cumulative amounts distributed by all senders",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySenderDistributionsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SenderDistributions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sender-distributions")
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +13,15 @@ import (
 	"github.com/CosmosContracts/juno/v23/x/drip/types"
 )
 
+const (
+	// FlagStartHeight defines the flag for the start height of a drip schedule
+	FlagStartHeight = "start-height"
+	// FlagValidators defines the flag for the target validators of a distribution
+	FlagValidators = "validators"
+	// FlagStakeWeighted defines the flag to weight a targeted distribution by stake
+	FlagStakeWeighted = "stake-weighted"
+)
+
 // NewTxCmd returns a root CLI command handler for certain modules transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,6 +34,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewDistributeToken(),
+		NewScheduleDrip(),
+		NewCancelDrip(),
 	)
 	return txCmd
 }
@@ -32,7 +45,7 @@ func NewDistributeToken() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "distribute-tokens [amount]",
 		Short: "Distribute tokens to all stakers in the next block.",
-		Long:  "Distribute tokens to all stakers in the next block **NOTE** ALL the tokens sent will be distributed to stakers in one shot at the next block. If you want to do a gradual airdrop, execute this transaction multiple times splitting the amount. This message can be executed only by authorized addresses.",
+		Long:  "Distribute tokens to all stakers in the next block **NOTE** ALL the tokens sent will be distributed to stakers in one shot at the next block. If you want to do a gradual airdrop, execute this transaction multiple times splitting the amount. With --validators the tokens are instead allocated immediately to the delegators of the given validators, split equally or by bonded stake with --stake-weighted. This message can be executed only by authorized addresses.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -46,11 +59,105 @@ func NewDistributeToken() *cobra.Command {
 				return err
 			}
 
+			validators, err := cmd.Flags().GetStringSlice(FlagValidators)
+			if err != nil {
+				return err
+			}
+
+			stakeWeighted, err := cmd.Flags().GetBool(FlagStakeWeighted)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgDistributeTokens{
 				SenderAddress: sender.String(),
 				Amount:        amount,
 			}
 
+			if len(validators) > 0 {
+				msg.Target = &types.DripTarget{
+					ValidatorAddresses: validators,
+					StakeWeighted:      stakeWeighted,
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagValidators, []string{}, "Comma separated list of validator operator addresses whose delegators receive the tokens")
+	cmd.Flags().Bool(FlagStakeWeighted, false, "Split the tokens between the validators proportionally to their bonded tokens")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewScheduleDrip returns a CLI command handler for scheduling a streamed
+// distribution of tokens.
+func NewScheduleDrip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-drip [amount] [num-blocks]",
+		Short: "Distribute tokens to all stakers linearly over a number of blocks.",
+		Long:  "Escrow tokens in the drip module and distribute them to all stakers linearly over the given number of blocks, starting at the next block or at --start-height. The undistributed tokens can be refunded with cancel-drip. This message can be executed only by authorized addresses.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			numBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleDrip(amount, numBlocks, startHeight, sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "First block at which tokens are released (defaults to the next block)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelDrip returns a CLI command handler for cancelling a drip schedule.
+func NewCancelDrip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-drip [id]",
+		Short: "Cancel a drip schedule and refund the undistributed tokens.",
+		Long:  "Cancel a drip schedule and refund the undistributed tokens. Only the sender of the schedule can cancel it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDrip(id, cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -16,11 +16,26 @@ func InitGenesis(
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+
+	if data.NextScheduleId > 0 {
+		k.SetNextScheduleID(ctx, data.NextScheduleId)
+	}
+
+	for _, distribution := range data.SenderDistributions {
+		k.SetSenderDistribution(ctx, distribution)
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:              k.GetParams(ctx),
+		Schedules:           k.GetAllSchedules(ctx),
+		NextScheduleId:      k.GetNextScheduleID(ctx),
+		SenderDistributions: k.GetAllSenderDistributions(ctx),
 	}
 }
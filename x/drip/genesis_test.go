@@ -112,3 +112,65 @@ func (suite *GenesisTestSuite) TestDripInitGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestDripExportImportSchedules() {
+	sender := "juno1v6vlpuqlhhpwujvaqs4pe5dmljapdev4s827ql"
+	genesis := types.GenesisState{
+		Params: types.DefaultParams(),
+		Schedules: []types.DripSchedule{
+			{
+				Id:            1,
+				SenderAddress: sender,
+				Amount:        sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+				Distributed:   sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+				StartHeight:   5,
+				NumBlocks:     10,
+			},
+			{
+				Id:            3,
+				SenderAddress: sender,
+				Amount:        sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(50))),
+				Distributed:   sdk.Coins{},
+				StartHeight:   20,
+				NumBlocks:     5,
+			},
+		},
+		NextScheduleId: 4,
+	}
+	suite.Require().NoError(genesis.Validate())
+
+	drip.InitGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper, genesis)
+	exported := drip.ExportGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper)
+
+	suite.Require().Equal(genesis.NextScheduleId, exported.NextScheduleId)
+	suite.Require().Len(exported.Schedules, 2)
+	for i, schedule := range exported.Schedules {
+		suite.Require().Equal(genesis.Schedules[i].Id, schedule.Id)
+		suite.Require().Equal(genesis.Schedules[i].Amount, schedule.Amount)
+		suite.Require().True(genesis.Schedules[i].Distributed.IsEqual(schedule.Distributed))
+	}
+
+	// schedule ids must be lower than the next schedule id
+	genesis.NextScheduleId = 3
+	suite.Require().Error(genesis.Validate())
+}
+
+func (suite *GenesisTestSuite) TestDripExportImportSenderDistributions() {
+	sender := "juno1v6vlpuqlhhpwujvaqs4pe5dmljapdev4s827ql"
+	genesis := *types.DefaultGenesisState()
+	genesis.SenderDistributions = []types.SenderDistribution{
+		{
+			SenderAddress: sender,
+			Distributed:   sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)), sdk.NewCoin("ujuno", sdk.NewInt(5))),
+		},
+	}
+	suite.Require().NoError(genesis.Validate())
+
+	drip.InitGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper, genesis)
+	exported := drip.ExportGenesis(suite.ctx, suite.app.AppKeepers.DripKeeper)
+	suite.Require().Equal(genesis.SenderDistributions, exported.SenderDistributions)
+
+	// senders must be unique
+	genesis.SenderDistributions = append(genesis.SenderDistributions, genesis.SenderDistributions[0])
+	suite.Require().Error(genesis.Validate())
+}
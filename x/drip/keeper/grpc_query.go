@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v23/x/drip/types"
@@ -29,3 +32,89 @@ func (q Querier) Params(
 	params := q.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Schedule returns a single active drip schedule
+func (q Querier) Schedule(
+	c context.Context,
+	req *types.QueryScheduleRequest,
+) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := q.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "drip schedule %d not found", req.Id)
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules returns all the active drip schedules, optionally filtered by sender
+func (q Querier) Schedules(
+	c context.Context,
+	req *types.QuerySchedulesRequest,
+) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.SenderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(req.SenderAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.SenderAddress)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedules, pageRes, err := q.GetSchedules(ctx, req.SenderAddress, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SenderDistribution returns the cumulative amount distributed by a sender
+func (q Querier) SenderDistribution(
+	c context.Context,
+	req *types.QuerySenderDistributionRequest,
+) (*types.QuerySenderDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.SenderAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySenderDistributionResponse{
+		SenderDistribution: q.GetSenderDistribution(ctx, sender),
+	}, nil
+}
+
+// SenderDistributions returns the cumulative amounts distributed by all senders
+func (q Querier) SenderDistributions(
+	c context.Context,
+	req *types.QuerySenderDistributionsRequest,
+) (*types.QuerySenderDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	distributions, pageRes, err := q.GetSenderDistributions(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySenderDistributionsResponse{
+		SenderDistributions: distributions,
+		Pagination:          pageRes,
+	}, nil
+}
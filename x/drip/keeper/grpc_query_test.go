@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmosContracts/juno/v23/x/drip/types"
 )
@@ -57,3 +58,81 @@ func (s *IntegrationTestSuite) TestDripQueryParams() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestDripQuerySchedules() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, otherSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, otherSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String(), otherSender.String()},
+	})
+
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	for _, addr := range []sdk.AccAddress{sender, sender, otherSender} {
+		_, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(amount, 10, 0, addr))
+		s.Require().NoError(err)
+	}
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	resp, err := s.queryClient.Schedule(goCtx, &types.QueryScheduleRequest{Id: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(sender.String(), resp.Schedule.SenderAddress)
+	s.Require().Equal(amount, resp.Schedule.Amount)
+
+	_, err = s.queryClient.Schedule(goCtx, &types.QueryScheduleRequest{Id: 4})
+	s.Require().Error(err)
+
+	all, err := s.queryClient.Schedules(goCtx, &types.QuerySchedulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Schedules, 3)
+
+	bySender, err := s.queryClient.Schedules(goCtx, &types.QuerySchedulesRequest{SenderAddress: sender.String()})
+	s.Require().NoError(err)
+	s.Require().Len(bySender.Schedules, 2)
+
+	_, err = s.queryClient.Schedules(goCtx, &types.QuerySchedulesRequest{SenderAddress: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestDripQuerySenderDistributions() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, otherSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, otherSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String(), otherSender.String()},
+	})
+
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	for _, addr := range []sdk.AccAddress{sender, sender, otherSender} {
+		_, err := s.app.AppKeepers.DripKeeper.DistributeTokens(s.ctx, types.NewMsgDistributeTokens(amount, addr))
+		s.Require().NoError(err)
+	}
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	resp, err := s.queryClient.SenderDistribution(goCtx, &types.QuerySenderDistributionRequest{SenderAddress: sender.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200))), resp.SenderDistribution.Distributed)
+
+	_, _, unknown := testdata.KeyTestPubAddr()
+	resp, err = s.queryClient.SenderDistribution(goCtx, &types.QuerySenderDistributionRequest{SenderAddress: unknown.String()})
+	s.Require().NoError(err)
+	s.Require().True(resp.SenderDistribution.Distributed.IsZero())
+
+	_, err = s.queryClient.SenderDistribution(goCtx, &types.QuerySenderDistributionRequest{SenderAddress: "invalid"})
+	s.Require().Error(err)
+
+	all, err := s.queryClient.SenderDistributions(goCtx, &types.QuerySenderDistributionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(all.SenderDistributions, 1)
+	s.Require().Equal(uint64(2), all.Pagination.Total)
+}
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +17,9 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
-	bankKeeper driptypes.BankKeeper
+	bankKeeper    driptypes.BankKeeper
+	distrKeeper   driptypes.DistributionKeeper
+	stakingKeeper driptypes.StakingKeeper
 
 	feeCollectorName string
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -26,6 +32,8 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	bk driptypes.BankKeeper,
+	dk driptypes.DistributionKeeper,
+	sk driptypes.StakingKeeper,
 	feeCollector string,
 	authority string,
 ) Keeper {
@@ -33,6 +41,8 @@ func NewKeeper(
 		storeKey:         storeKey,
 		cdc:              cdc,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
+		stakingKeeper:    sk,
 		feeCollectorName: feeCollector,
 		authority:        authority,
 	}
@@ -43,6 +53,11 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", driptypes.ModuleName))
+}
+
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account, where it will be catch up by the distribution module at the next block
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
@@ -13,7 +13,8 @@ import (
 
 var _ types.MsgServer = &Keeper{}
 
-// DistributeTokens distribute tokens to all stakers at the next block
+// DistributeTokens distribute tokens to all stakers at the next block, or
+// directly to the delegators of the target validators
 func (k Keeper) DistributeTokens(
 	goCtx context.Context,
 	msg *types.MsgDistributeTokens,
@@ -24,35 +25,132 @@ func (k Keeper) DistributeTokens(
 		return nil, err
 	}
 
-	params := k.GetParams(ctx)
-	if !params.EnableDrip {
-		return nil, types.ErrDripDisabled
+	if err := k.assertSenderAllowed(ctx, msg.SenderAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Get sender
+	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if sender is allowed
-	authorized := false
-	for _, addr := range params.AllowedAddresses {
-		if msg.SenderAddress == addr {
-			authorized = true
-			break
+	if msg.Target.IsValidatorTarget() {
+		if err := k.DistributeTokensToValidators(ctx, sender, msg.Amount, *msg.Target); err != nil {
+			return nil, err
 		}
+	} else if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, msg.Amount); err != nil {
+		return nil, err
 	}
 
-	if !authorized {
-		return nil, types.ErrDripNotAllowed
+	k.AddSenderDistribution(ctx, sender, msg.Amount)
+
+	return &types.MsgDistributeTokensResponse{}, nil
+}
+
+// ScheduleDrip escrows the sent tokens and releases them linearly to all
+// stakers over a number of blocks. Since every active schedule is processed in
+// the BeginBlocker, only the allowed addresses can schedule drips, even when
+// the module is permissionless.
+func (k Keeper) ScheduleDrip(
+	goCtx context.Context,
+	msg *types.MsgScheduleDrip,
+) (*types.MsgScheduleDripResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.assertSenderAllowlisted(ctx, msg.SenderAddress); err != nil {
+		return nil, err
 	}
 
-	// Get sender
 	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := k.SendCoinsFromAccountToFeeCollector(ctx, sender, msg.Amount); err != nil {
+	schedule, err := k.CreateSchedule(ctx, sender, msg.Amount, msg.StartHeight, msg.NumBlocks)
+	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgDistributeTokensResponse{}, nil
+	return &types.MsgScheduleDripResponse{Id: schedule.Id}, nil
+}
+
+// CancelDrip cancels a drip schedule and refunds the undistributed tokens to
+// its sender. Cancelling is allowed even if the drip module has been disabled
+// or the sender has been removed from the allowed addresses.
+func (k Keeper) CancelDrip(
+	goCtx context.Context,
+	msg *types.MsgCancelDrip,
+) (*types.MsgCancelDripResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := k.CancelSchedule(ctx, sender, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDripResponse{Refunded: refunded}, nil
+}
+
+// assertSenderAllowed returns an error if the drip module is disabled or the
+// sender is not one of the allowed addresses. When the module is
+// permissionless, any sender is allowed to distribute an amount that only
+// contains permissionless denoms, each above its minimum.
+func (k Keeper) assertSenderAllowed(ctx sdk.Context, sender string, amount sdk.Coins) error {
+	params := k.GetParams(ctx)
+	if !params.EnableDrip {
+		return types.ErrDripDisabled
+	}
+
+	if params.IsAllowedAddress(sender) {
+		return nil
+	}
+
+	if !params.Permissionless {
+		return types.ErrDripNotAllowed
+	}
+
+	for _, coin := range amount {
+		found, minAmount := params.PermissionlessMinAmounts.Find(coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrDripNotAllowed, "denom %s cannot be distributed permissionlessly", coin.Denom)
+		}
+
+		if coin.IsLT(minAmount) {
+			return errorsmod.Wrapf(types.ErrBelowMinimum, "%s is lower than %s", coin, minAmount)
+		}
+	}
+
+	return nil
+}
+
+// assertSenderAllowlisted returns an error if the drip module is disabled or
+// the sender is not one of the allowed addresses, regardless of the
+// permissionless mode.
+func (k Keeper) assertSenderAllowlisted(ctx sdk.Context, sender string) error {
+	params := k.GetParams(ctx)
+	if !params.EnableDrip {
+		return types.ErrDripDisabled
+	}
+
+	if !params.IsAllowedAddress(sender) {
+		return errorsmod.Wrap(types.ErrDripNotAllowed, "only the allowed addresses can schedule drips")
+	}
+
+	return nil
 }
 
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -3,8 +3,12 @@ package keeper_test
 import (
 	_ "embed"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/CosmosContracts/juno/v23/x/drip/types"
 )
@@ -151,3 +155,427 @@ func (s *IntegrationTestSuite) TestUpdateDripParams() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestScheduleDrip() {
+	_, _, allowedSender := testdata.KeyTestPubAddr()
+	_, _, notAllowedSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, allowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	_ = s.FundAccount(s.ctx, notAllowedSender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip: true,
+		AllowedAddresses: []string{
+			allowedSender.String(),
+		},
+	})
+
+	for _, tc := range []struct {
+		desc        string
+		senderAddr  string
+		coins       sdk.Coins
+		numBlocks   uint64
+		startHeight int64
+		success     bool
+	}{
+		{
+			desc:       "Success - Allowed sender starting next block",
+			senderAddr: allowedSender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			numBlocks:  10,
+			success:    true,
+		},
+		{
+			desc:        "Success - Allowed sender starting in the future",
+			senderAddr:  allowedSender.String(),
+			coins:       sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			numBlocks:   10,
+			startHeight: 100,
+			success:     true,
+		},
+		{
+			desc:        "Fail - Start height in the past",
+			senderAddr:  allowedSender.String(),
+			coins:       sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			numBlocks:   10,
+			startHeight: 5,
+			success:     false,
+		},
+		{
+			desc:       "Fail - Too many blocks",
+			senderAddr: allowedSender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			numBlocks:  types.MaxScheduleNumBlocks + 1,
+			success:    false,
+		},
+		{
+			desc:       "Fail - Zero blocks",
+			senderAddr: allowedSender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			numBlocks:  0,
+			success:    false,
+		},
+		{
+			desc:       "Fail - Allowed sender no proper funds",
+			senderAddr: allowedSender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("notarealtoken", sdk.NewInt(1))),
+			numBlocks:  10,
+			success:    false,
+		},
+		{
+			desc:       "Fail - Non Allowed sender proper funds",
+			senderAddr: notAllowedSender.String(),
+			coins:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1))),
+			numBlocks:  10,
+			success:    false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			msg := types.MsgScheduleDrip{
+				SenderAddress: tc.senderAddr,
+				Amount:        tc.coins,
+				NumBlocks:     tc.numBlocks,
+				StartHeight:   tc.startHeight,
+			}
+			res, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, &msg)
+
+			if !tc.success {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				schedule, found := s.app.AppKeepers.DripKeeper.GetSchedule(s.ctx, res.Id)
+				s.Require().True(found)
+				s.Require().Equal(tc.coins, schedule.Amount)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestReleaseAndCancelDrip() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, otherSender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip: true,
+		AllowedAddresses: []string{
+			sender.String(),
+		},
+	})
+
+	feeCollector := s.app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := func(ctx sdk.Context) sdkmath.Int {
+		return s.app.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, "stake").Amount
+	}
+	initialFees := feeCollectorBalance(s.ctx)
+
+	res, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+		3,
+		0,
+		sender,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(999_000), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, sender, "stake").Amount)
+
+	// Nothing is released before the start height
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(s.ctx)
+	s.Require().Equal(initialFees, feeCollectorBalance(s.ctx))
+
+	// Tokens are released linearly
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(ctx)
+	s.Require().Equal(initialFees.AddRaw(333), feeCollectorBalance(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(ctx)
+	s.Require().Equal(initialFees.AddRaw(666), feeCollectorBalance(ctx))
+
+	schedule, found := s.app.AppKeepers.DripKeeper.GetSchedule(ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(666))), schedule.Distributed)
+
+	// Only the sender can cancel the schedule
+	_, err = s.app.AppKeepers.DripKeeper.CancelDrip(ctx, types.NewMsgCancelDrip(res.Id, otherSender))
+	s.Require().ErrorIs(err, types.ErrNotScheduleOwner)
+
+	_, err = s.app.AppKeepers.DripKeeper.CancelDrip(ctx, types.NewMsgCancelDrip(res.Id+1, sender))
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+
+	// Cancelling refunds the undistributed tokens, even if the drip is disabled
+	_ = s.app.AppKeepers.DripKeeper.SetParams(ctx, types.Params{EnableDrip: false})
+	cancelRes, err := s.app.AppKeepers.DripKeeper.CancelDrip(ctx, types.NewMsgCancelDrip(res.Id, sender))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(334))), cancelRes.Refunded)
+	s.Require().Equal(sdk.NewInt(999_334), s.app.AppKeepers.BankKeeper.GetBalance(ctx, sender, "stake").Amount)
+
+	_, found = s.app.AppKeepers.DripKeeper.GetSchedule(ctx, res.Id)
+	s.Require().False(found)
+}
+
+func (s *IntegrationTestSuite) TestCompletedDripIsRemoved() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip: true,
+		AllowedAddresses: []string{
+			sender.String(),
+		},
+	})
+
+	res, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+		2,
+		0,
+		sender,
+	))
+	s.Require().NoError(err)
+
+	for i := int64(1); i <= 2; i++ {
+		s.app.AppKeepers.DripKeeper.ReleaseSchedules(s.ctx.WithBlockHeight(s.ctx.BlockHeight() + i))
+	}
+
+	_, found := s.app.AppKeepers.DripKeeper.GetSchedule(s.ctx, res.Id)
+	s.Require().False(found)
+
+	dripModule := s.app.AppKeepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().True(s.app.AppKeepers.BankKeeper.GetAllBalances(s.ctx, dripModule).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestDripWithoutReleasableAmountIsNotWritten() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip: true,
+		AllowedAddresses: []string{
+			sender.String(),
+		},
+	})
+
+	// 2 tokens over 4 blocks are released at the second and last blocks
+	res, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2))),
+		4,
+		0,
+		sender,
+	))
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(ctx)
+	s.Require().Empty(ctx.EventManager().Events())
+
+	schedule, found := s.app.AppKeepers.DripKeeper.GetSchedule(ctx, res.Id)
+	s.Require().True(found)
+	s.Require().True(schedule.Distributed.IsZero())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(ctx)
+	schedule, found = s.app.AppKeepers.DripKeeper.GetSchedule(ctx, res.Id)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1))), schedule.Distributed)
+}
+
+func (s *IntegrationTestSuite) TestDistributeTokensToValidators() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip: true,
+		AllowedAddresses: []string{
+			sender.String(),
+		},
+	})
+
+	validators := s.app.AppKeepers.StakingKeeper.GetBondedValidatorsByPower(s.ctx)
+	s.Require().NotEmpty(validators)
+	valAddr := validators[0].GetOperator()
+
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000)))
+	distrModule := s.app.AppKeepers.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	distrBalance := s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, distrModule, "stake").Amount
+	outstanding := s.app.AppKeepers.DistrKeeper.GetValidatorOutstandingRewards(s.ctx, valAddr).Rewards
+	current := s.app.AppKeepers.DistrKeeper.GetValidatorCurrentRewards(s.ctx, valAddr).Rewards
+
+	// Unknown validators are rejected
+	_, err := s.app.AppKeepers.DripKeeper.DistributeTokens(s.ctx, &types.MsgDistributeTokens{
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		Target: &types.DripTarget{
+			ValidatorAddresses: []string{sdk.ValAddress([]byte("unknown")).String()},
+		},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidTarget)
+
+	_, err = s.app.AppKeepers.DripKeeper.DistributeTokens(s.ctx, &types.MsgDistributeTokens{
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		Target: &types.DripTarget{
+			ValidatorAddresses: []string{valAddr.String()},
+			StakeWeighted:      true,
+		},
+	})
+	s.Require().NoError(err)
+
+	// The whole amount is allocated to the delegators, without commission
+	expected := sdk.NewDecCoinsFromCoins(amount...)
+	s.Require().Equal(outstanding.Add(expected...), s.app.AppKeepers.DistrKeeper.GetValidatorOutstandingRewards(s.ctx, valAddr).Rewards)
+	s.Require().Equal(current.Add(expected...), s.app.AppKeepers.DistrKeeper.GetValidatorCurrentRewards(s.ctx, valAddr).Rewards)
+	s.Require().Equal(distrBalance.AddRaw(1_000), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, distrModule, "stake").Amount)
+	s.Require().Equal(sdk.NewInt(999_000), s.app.AppKeepers.BankKeeper.GetBalance(s.ctx, sender, "stake").Amount)
+}
+
+func (s *IntegrationTestSuite) TestPermissionlessDistributeTokens() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(
+		sdk.NewCoin("stake", sdk.NewInt(1_000_000)),
+		sdk.NewCoin("ujuno", sdk.NewInt(1_000_000)),
+	))
+
+	params := types.Params{
+		EnableDrip:               true,
+		Permissionless:           true,
+		PermissionlessMinAmounts: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+	}
+
+	for _, tc := range []struct {
+		desc           string
+		permissionless bool
+		coins          sdk.Coins
+		expErr         error
+	}{
+		{
+			desc:   "Fail - Permissionless disabled",
+			coins:  sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+			expErr: types.ErrDripNotAllowed,
+		},
+		{
+			desc:           "Fail - Denom not allowed",
+			permissionless: true,
+			coins:          sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000))),
+			expErr:         types.ErrDripNotAllowed,
+		},
+		{
+			desc:           "Fail - One of the denoms not allowed",
+			permissionless: true,
+			coins:          sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000)), sdk.NewCoin("ujuno", sdk.NewInt(1_000))),
+			expErr:         types.ErrDripNotAllowed,
+		},
+		{
+			desc:           "Fail - Below minimum",
+			permissionless: true,
+			coins:          sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(999))),
+			expErr:         types.ErrBelowMinimum,
+		},
+		{
+			desc:           "Success - Above minimum",
+			permissionless: true,
+			coins:          sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			params.Permissionless = tc.permissionless
+			s.Require().NoError(s.app.AppKeepers.DripKeeper.SetParams(s.ctx, params))
+
+			_, err := s.app.AppKeepers.DripKeeper.DistributeTokens(s.ctx, types.NewMsgDistributeTokens(tc.coins, sender))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	distribution := s.app.AppKeepers.DripKeeper.GetSenderDistribution(s.ctx, sender)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))), distribution.Distributed)
+}
+
+func (s *IntegrationTestSuite) TestPermissionlessScheduleDripNotAllowed() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	s.Require().NoError(s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:               true,
+		Permissionless:           true,
+		PermissionlessMinAmounts: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+	}))
+
+	// permissionless senders can distribute, but not schedule drips
+	_, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000))),
+		10,
+		0,
+		sender,
+	))
+	s.Require().ErrorIs(err, types.ErrDripNotAllowed)
+}
+
+func (s *IntegrationTestSuite) TestGetStartedSchedules() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String()},
+	})
+
+	height := s.ctx.BlockHeight()
+	var ids []uint64
+	for _, startHeight := range []int64{height + 10, height + 1, height + 5} {
+		res, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+			sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+			10,
+			startHeight,
+			sender,
+		))
+		s.Require().NoError(err)
+		ids = append(ids, res.Id)
+	}
+
+	startedIDs := func(height int64) []uint64 {
+		var started []uint64
+		for _, schedule := range s.app.AppKeepers.DripKeeper.GetStartedSchedules(s.ctx, height) {
+			started = append(started, schedule.Id)
+		}
+		return started
+	}
+
+	s.Require().Empty(startedIDs(height))
+	s.Require().Equal([]uint64{ids[1]}, startedIDs(height+1))
+	s.Require().Equal([]uint64{ids[1], ids[2]}, startedIDs(height+9))
+	s.Require().Equal([]uint64{ids[1], ids[2], ids[0]}, startedIDs(height+10))
+
+	// cancelled schedules are removed from the index
+	_, err := s.app.AppKeepers.DripKeeper.CancelDrip(s.ctx, types.NewMsgCancelDrip(ids[2], sender))
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{ids[1], ids[0]}, startedIDs(height+10))
+}
+
+func (s *IntegrationTestSuite) TestScheduleReleaseTracksSenderDistribution() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+
+	_ = s.app.AppKeepers.DripKeeper.SetParams(s.ctx, types.Params{
+		EnableDrip:       true,
+		AllowedAddresses: []string{sender.String()},
+	})
+
+	_, err := s.app.AppKeepers.DripKeeper.ScheduleDrip(s.ctx, types.NewMsgScheduleDrip(
+		sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))),
+		2,
+		0,
+		sender,
+	))
+	s.Require().NoError(err)
+
+	// nothing is accounted until tokens are released
+	s.Require().True(s.app.AppKeepers.DripKeeper.GetSenderDistribution(s.ctx, sender).Distributed.IsZero())
+
+	s.app.AppKeepers.DripKeeper.ReleaseSchedules(s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1))
+	distribution := s.app.AppKeepers.DripKeeper.GetSenderDistribution(s.ctx, sender)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(5))), distribution.Distributed)
+}
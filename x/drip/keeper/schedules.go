@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/drip/types"
+)
+
+// GetNextScheduleID returns the id to assign to the next drip schedule.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID sets the id to assign to the next drip schedule.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSchedule returns a drip schedule by id.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (types.DripSchedule, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	bz := store.Get(types.GetScheduleKey(id))
+	if bz == nil {
+		return types.DripSchedule{}, false
+	}
+
+	var schedule types.DripSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule stores a drip schedule and indexes it by its start height.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	k.storeSchedule(ctx, schedule)
+
+	index := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByStartHeightKeyPrefix)
+	index.Set(types.GetScheduleByStartHeightKey(schedule.StartHeight, schedule.Id), []byte{})
+}
+
+// storeSchedule stores a drip schedule already indexed by its start height.
+func (k Keeper) storeSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.GetScheduleKey(schedule.Id), bz)
+}
+
+// DeleteSchedule removes a drip schedule and its index.
+func (k Keeper) DeleteSchedule(ctx sdk.Context, schedule types.DripSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	store.Delete(types.GetScheduleKey(schedule.Id))
+
+	index := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByStartHeightKeyPrefix)
+	index.Delete(types.GetScheduleByStartHeightKey(schedule.StartHeight, schedule.Id))
+}
+
+// IterateSchedules iterates over all the drip schedules in ascending id order
+// until the handler returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, handler func(schedule types.DripSchedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.DripSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// GetAllSchedules returns all the drip schedules.
+func (k Keeper) GetAllSchedules(ctx sdk.Context) []types.DripSchedule {
+	schedules := []types.DripSchedule{}
+	k.IterateSchedules(ctx, func(schedule types.DripSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return schedules
+}
+
+// GetSchedules returns the drip schedules, optionally filtered by sender.
+func (k Keeper) GetSchedules(ctx sdk.Context, sender string, pag *query.PageRequest) ([]types.DripSchedule, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.DripSchedule) (*types.DripSchedule, error) {
+			if sender != "" && value.SenderAddress != sender {
+				return nil, nil
+			}
+			return value, nil
+		},
+		func() *types.DripSchedule {
+			return &types.DripSchedule{}
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schedules := make([]types.DripSchedule, 0, len(results))
+	for _, schedule := range results {
+		schedules = append(schedules, *schedule)
+	}
+
+	return schedules, pageRes, nil
+}
+
+// CreateSchedule escrows the amount from the sender in the drip module account
+// and stores a new drip schedule releasing it over numBlocks blocks.
+func (k Keeper) CreateSchedule(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins, startHeight int64, numBlocks uint64) (types.DripSchedule, error) {
+	if startHeight == 0 {
+		startHeight = ctx.BlockHeight() + 1
+	}
+
+	if startHeight <= ctx.BlockHeight() {
+		return types.DripSchedule{}, types.ErrInvalidSchedule.Wrapf("start height %d must be in the future", startHeight)
+	}
+
+	id := k.GetNextScheduleID(ctx)
+	schedule := types.NewDripSchedule(id, sender, amount, startHeight, numBlocks)
+	if err := schedule.Validate(); err != nil {
+		return types.DripSchedule{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return types.DripSchedule{}, err
+	}
+
+	k.SetSchedule(ctx, schedule)
+	k.SetNextScheduleID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleDrip,
+			sdk.NewAttribute(sdk.AttributeKeySender, schedule.SenderAddress),
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(startHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyNumBlocks, strconv.FormatUint(numBlocks, 10)),
+		),
+	)
+
+	return schedule, nil
+}
+
+// CancelSchedule removes a drip schedule and refunds the undistributed amount
+// to its sender.
+func (k Keeper) CancelSchedule(ctx sdk.Context, sender sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return nil, types.ErrScheduleNotFound.Wrapf("id: %d", id)
+	}
+
+	if schedule.SenderAddress != sender.String() {
+		return nil, types.ErrNotScheduleOwner
+	}
+
+	refund := schedule.Remaining()
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelDrip,
+			sdk.NewAttribute(sdk.AttributeKeySender, schedule.SenderAddress),
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refund.String()),
+		),
+	)
+
+	return refund, nil
+}
+
+// GetStartedSchedules returns the drip schedules started at or before the
+// given height, which are the active ones since completed schedules are
+// removed. Schedules starting later are not read.
+func (k Keeper) GetStartedSchedules(ctx sdk.Context, height int64) []types.DripSchedule {
+	index := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByStartHeightKeyPrefix)
+	iterator := index.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	var schedules []types.DripSchedule
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[8:])
+		if schedule, found := k.GetSchedule(ctx, id); found {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	return schedules
+}
+
+// ReleaseSchedules sends the releasable amount of every active drip schedule
+// to the fee collector and removes the completed schedules. Schedules with
+// nothing to release at this height are left untouched. A schedule failing to
+// release is logged and retried at the next block.
+func (k Keeper) ReleaseSchedules(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	schedules := k.GetStartedSchedules(ctx, height)
+
+	for _, schedule := range schedules {
+		releasable := schedule.Releasable(height)
+		if releasable.IsZero() {
+			// only a schedule imported fully released has nothing left at all
+			if schedule.IsCompleted() {
+				k.DeleteSchedule(ctx, schedule)
+			}
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.releaseSchedule(cacheCtx, schedule, releasable); err != nil {
+			k.Logger(ctx).Error("failed to release drip schedule", "id", schedule.Id, "error", err)
+			continue
+		}
+		write()
+	}
+}
+
+// releaseSchedule sends the releasable amount of a schedule to the fee
+// collector, and removes the schedule once it is fully released.
+func (k Keeper) releaseSchedule(ctx sdk.Context, schedule types.DripSchedule, releasable sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, releasable); err != nil {
+		return err
+	}
+
+	schedule.Distributed = schedule.Distributed.Add(releasable...)
+	k.AddSenderDistribution(ctx, sdk.MustAccAddressFromBech32(schedule.SenderAddress), releasable)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseDrip,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, releasable.String()),
+		),
+	)
+
+	if schedule.IsCompleted() {
+		k.DeleteSchedule(ctx, schedule)
+		return nil
+	}
+
+	k.storeSchedule(ctx, schedule)
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/drip/types"
+)
+
+// GetSenderDistribution returns the cumulative amount distributed by a sender.
+func (k Keeper) GetSenderDistribution(ctx sdk.Context, sender sdk.AccAddress) types.SenderDistribution {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderDistributionKeyPrefix)
+	bz := store.Get(sender)
+	if bz == nil {
+		return types.SenderDistribution{
+			SenderAddress: sender.String(),
+			Distributed:   sdk.NewCoins(),
+		}
+	}
+
+	var distribution types.SenderDistribution
+	k.cdc.MustUnmarshal(bz, &distribution)
+	return distribution
+}
+
+// SetSenderDistribution stores the cumulative amount distributed by a sender.
+func (k Keeper) SetSenderDistribution(ctx sdk.Context, distribution types.SenderDistribution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderDistributionKeyPrefix)
+	sender := sdk.MustAccAddressFromBech32(distribution.SenderAddress)
+	bz := k.cdc.MustMarshal(&distribution)
+	store.Set(sender, bz)
+}
+
+// AddSenderDistribution adds amount to the cumulative amount distributed by a
+// sender.
+func (k Keeper) AddSenderDistribution(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) {
+	distribution := k.GetSenderDistribution(ctx, sender)
+	distribution.Distributed = distribution.Distributed.Add(amount...)
+	k.SetSenderDistribution(ctx, distribution)
+}
+
+// GetAllSenderDistributions returns the cumulative amounts distributed by all
+// senders.
+func (k Keeper) GetAllSenderDistributions(ctx sdk.Context) []types.SenderDistribution {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SenderDistributionKeyPrefix)
+	defer iterator.Close()
+
+	distributions := []types.SenderDistribution{}
+	for ; iterator.Valid(); iterator.Next() {
+		var distribution types.SenderDistribution
+		k.cdc.MustUnmarshal(iterator.Value(), &distribution)
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions
+}
+
+// GetSenderDistributions returns a page of the cumulative amounts distributed
+// by all senders.
+func (k Keeper) GetSenderDistributions(ctx sdk.Context, pag *query.PageRequest) ([]types.SenderDistribution, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SenderDistributionKeyPrefix)
+
+	distributions := []types.SenderDistribution{}
+	pageRes, err := query.Paginate(store, pag, func(_, value []byte) error {
+		var distribution types.SenderDistribution
+		if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+			return err
+		}
+
+		distributions = append(distributions, distribution)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return distributions, pageRes, nil
+}
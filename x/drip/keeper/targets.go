@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v23/x/drip/types"
+)
+
+// DistributeTokensToValidators transfers amt from the sender to the
+// distribution module and allocates it to the delegators of the target
+// validators, either equally or proportionally to their bonded tokens. No
+// commission is taken by the validators.
+func (k Keeper) DistributeTokensToValidators(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins, target types.DripTarget) error {
+	validators := make([]stakingtypes.Validator, 0, len(target.ValidatorAddresses))
+	totalTokens := sdk.ZeroInt()
+	for _, addr := range target.ValidatorAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			return err
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrInvalidTarget.Wrapf("validator %s not found", addr)
+		}
+
+		if !validator.IsBonded() {
+			return types.ErrInvalidTarget.Wrapf("validator %s is not bonded", addr)
+		}
+
+		validators = append(validators, validator)
+		totalTokens = totalTokens.Add(validator.GetTokens())
+	}
+
+	if len(validators) == 0 {
+		return types.ErrInvalidTarget.Wrap("no validators")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, distrtypes.ModuleName, amt); err != nil {
+		return err
+	}
+
+	total := sdk.NewDecCoinsFromCoins(amt...)
+	remaining := total
+	for i, validator := range validators {
+		var share sdk.DecCoins
+		switch {
+		case i == len(validators)-1:
+			// the last validator receives the truncation remainder
+			share = remaining
+		case target.StakeWeighted:
+			weight := sdk.NewDecFromInt(validator.GetTokens()).QuoTruncate(sdk.NewDecFromInt(totalTokens))
+			share = total.MulDecTruncate(weight)
+		default:
+			share = total.QuoDecTruncate(sdk.NewDec(int64(len(validators))))
+		}
+
+		remaining = remaining.Sub(share)
+		k.allocateTokensToDelegators(ctx, validator.GetOperator(), share)
+	}
+
+	return nil
+}
+
+// allocateTokensToDelegators adds tokens to the current rewards of a validator,
+// which are entirely shared between its delegators.
+func (k Keeper) allocateTokensToDelegators(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdk.DecCoins) {
+	if tokens.IsZero() {
+		return
+	}
+
+	currentRewards := k.distrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+	currentRewards.Rewards = currentRewards.Rewards.Add(tokens...)
+	k.distrKeeper.SetValidatorCurrentRewards(ctx, valAddr, currentRewards)
+
+	outstanding := k.distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr)
+	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	k.distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, outstanding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeToValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		),
+	)
+}
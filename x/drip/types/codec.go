@@ -25,6 +25,8 @@ var (
 const (
 	// Amino names
 	distributeTokensName = "juno/MsgDistributeTokens" //nolint:gosec // these are not hard coded credentials
+	scheduleDripName     = "juno/MsgScheduleDrip"
+	cancelDripName       = "juno/MsgCancelDrip"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgDistributeTokens{},
+		&MsgScheduleDrip{},
+		&MsgCancelDrip{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -54,4 +58,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDistributeTokens{}, distributeTokensName, nil)
+	cdc.RegisterConcrete(&MsgScheduleDrip{}, scheduleDripName, nil)
+	cdc.RegisterConcrete(&MsgCancelDrip{}, cancelDripName, nil)
 }
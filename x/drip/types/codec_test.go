@@ -23,9 +23,11 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(2, len(impls))
+	suite.Require().Equal(4, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/juno.drip.v1.MsgDistributeTokens",
 		"/juno.drip.v1.MsgUpdateParams",
+		"/juno.drip.v1.MsgScheduleDrip",
+		"/juno.drip.v1.MsgCancelDrip",
 	}, impls)
 }
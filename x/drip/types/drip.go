@@ -0,0 +1,148 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTargetValidators is the maximum number of validators a distribution can
+// target
+const MaxTargetValidators = 200
+
+// MaxScheduleNumBlocks is the maximum number of blocks a drip schedule can be
+// released over, about a year of 6 second blocks
+const MaxScheduleNumBlocks = 5_256_000
+
+// NewDripSchedule creates a new DripSchedule object
+func NewDripSchedule(
+	id uint64,
+	sender sdk.AccAddress,
+	amount sdk.Coins,
+	startHeight int64,
+	numBlocks uint64,
+) DripSchedule {
+	return DripSchedule{
+		Id:            id,
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		Distributed:   sdk.NewCoins(),
+		StartHeight:   startHeight,
+		NumBlocks:     numBlocks,
+	}
+}
+
+// EndHeight returns the last block at which tokens are released
+func (s DripSchedule) EndHeight() int64 {
+	return s.StartHeight + int64(s.NumBlocks) - 1
+}
+
+// Remaining returns the escrowed amount not yet released
+func (s DripSchedule) Remaining() sdk.Coins {
+	return s.Amount.Sub(s.Distributed...)
+}
+
+// IsCompleted returns true once the whole amount has been released
+func (s DripSchedule) IsCompleted() bool {
+	return s.Remaining().IsZero()
+}
+
+// Releasable returns the amount to release at the given height so that the
+// total distributed amount grows linearly from StartHeight to EndHeight. The
+// last block releases any rounding remainder.
+func (s DripSchedule) Releasable(height int64) sdk.Coins {
+	if height < s.StartHeight || s.NumBlocks == 0 {
+		return sdk.NewCoins()
+	}
+
+	elapsed := uint64(height-s.StartHeight) + 1
+	if elapsed >= s.NumBlocks {
+		return s.Remaining()
+	}
+
+	releasable := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		target := coin.Amount.MulRaw(int64(elapsed)).QuoRaw(int64(s.NumBlocks))
+		amount := target.Sub(s.Distributed.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			releasable = releasable.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return releasable
+}
+
+// Validate performs a stateless validation of the schedule
+func (s DripSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", s.SenderAddress)
+	}
+
+	if s.Amount.Empty() || !s.Amount.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid amount: %s", s.Amount)
+	}
+
+	if !s.Distributed.IsValid() || !s.Distributed.IsAllLTE(s.Amount) {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid distributed amount: %s", s.Distributed)
+	}
+
+	if s.NumBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidSchedule, "number of blocks must be positive")
+	}
+
+	if s.StartHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "invalid start height: %d", s.StartHeight)
+	}
+
+	if s.NumBlocks > MaxScheduleNumBlocks {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "number of blocks too large: %d > %d", s.NumBlocks, MaxScheduleNumBlocks)
+	}
+
+	if s.NumBlocks > uint64(math.MaxInt64-s.StartHeight) {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "number of blocks too large: %d", s.NumBlocks)
+	}
+
+	return nil
+}
+
+// IsValidatorTarget returns true if the distribution targets the delegators of
+// specific validators instead of the fee collector
+func (t *DripTarget) IsValidatorTarget() bool {
+	return t != nil && len(t.ValidatorAddresses) > 0
+}
+
+// Validate performs a stateless validation of the target
+func (t DripTarget) Validate() error {
+	if len(t.ValidatorAddresses) == 0 && t.StakeWeighted {
+		return errorsmod.Wrap(ErrInvalidTarget, "stake weighted target without validators")
+	}
+
+	if len(t.ValidatorAddresses) > MaxTargetValidators {
+		return errorsmod.Wrapf(ErrInvalidTarget, "too many validators: %d > %d", len(t.ValidatorAddresses), MaxTargetValidators)
+	}
+
+	seen := make(map[string]bool, len(t.ValidatorAddresses))
+	for _, addr := range t.ValidatorAddresses {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTarget, "invalid validator address %s: %s", addr, err)
+		}
+
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidTarget, "duplicate validator address: %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the sender distribution
+func (d SenderDistribution) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(d.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address %s", d.SenderAddress)
+	}
+
+	return d.Distributed.Validate()
+}
@@ -0,0 +1,1033 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/drip/v1/drip.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DripSchedule defines a streamed distribution of tokens to all stakers. The
+// escrowed amount is held by the drip module account and released linearly to
+// the fee collector over num_blocks blocks, starting at start_height.
+type DripSchedule struct {
+	// id is the unique identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender_address is the bech32 address of the schedule creator
+	SenderAddress string `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// amount is the total amount escrowed for the schedule
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// distributed is the amount already released to the fee collector
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// start_height is the first block at which tokens are released
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_blocks is the number of blocks the amount is released over
+	NumBlocks uint64 `protobuf:"varint,6,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+}
+
+func (m *DripSchedule) Reset()         { *m = DripSchedule{} }
+func (m *DripSchedule) String() string { return proto.CompactTextString(m) }
+func (*DripSchedule) ProtoMessage()    {}
+func (*DripSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f24ca720e58a285b, []int{0}
+}
+func (m *DripSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DripSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DripSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DripSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DripSchedule.Merge(m, src)
+}
+func (m *DripSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DripSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DripSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DripSchedule proto.InternalMessageInfo
+
+func (m *DripSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DripSchedule) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *DripSchedule) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *DripSchedule) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func (m *DripSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DripSchedule) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+// DripTarget defines the recipients of a distribution of tokens.
+type DripTarget struct {
+	// validator_addresses restricts the distribution to the delegators of the
+	// given bonded validators (bech32 valoper addresses). If empty, the tokens
+	// are sent to the fee collector and distributed to all stakers.
+	ValidatorAddresses []string `protobuf:"bytes,1,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// stake_weighted splits the tokens between the validators proportionally to
+	// their bonded tokens at the height of the distribution. If false, the tokens
+	// are split equally between the validators.
+	StakeWeighted bool `protobuf:"varint,2,opt,name=stake_weighted,json=stakeWeighted,proto3" json:"stake_weighted,omitempty"`
+}
+
+func (m *DripTarget) Reset()         { *m = DripTarget{} }
+func (m *DripTarget) String() string { return proto.CompactTextString(m) }
+func (*DripTarget) ProtoMessage()    {}
+func (*DripTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f24ca720e58a285b, []int{1}
+}
+func (m *DripTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DripTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DripTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DripTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DripTarget.Merge(m, src)
+}
+func (m *DripTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DripTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DripTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DripTarget proto.InternalMessageInfo
+
+func (m *DripTarget) GetValidatorAddresses() []string {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
+func (m *DripTarget) GetStakeWeighted() bool {
+	if m != nil {
+		return m.StakeWeighted
+	}
+	return false
+}
+
+// SenderDistribution defines the cumulative amount distributed by a sender,
+// either directly or through drip schedules.
+type SenderDistribution struct {
+	// sender_address is the bech32 address of the sender
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// distributed is the cumulative amount distributed by the sender
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+}
+
+func (m *SenderDistribution) Reset()         { *m = SenderDistribution{} }
+func (m *SenderDistribution) String() string { return proto.CompactTextString(m) }
+func (*SenderDistribution) ProtoMessage()    {}
+func (*SenderDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f24ca720e58a285b, []int{2}
+}
+func (m *SenderDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderDistribution.Merge(m, src)
+}
+func (m *SenderDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderDistribution proto.InternalMessageInfo
+
+func (m *SenderDistribution) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *SenderDistribution) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DripSchedule)(nil), "juno.drip.v1.DripSchedule")
+	proto.RegisterType((*DripTarget)(nil), "juno.drip.v1.DripTarget")
+	proto.RegisterType((*SenderDistribution)(nil), "juno.drip.v1.SenderDistribution")
+}
+
+func init() { proto.RegisterFile("juno/drip/v1/drip.proto", fileDescriptor_f24ca720e58a285b) }
+
+var fileDescriptor_f24ca720e58a285b = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0x66, 0x54, 0xd4, 0xed, 0x26, 0x61, 0x90, 0x08, 0x93, 0xc8, 0x42, 0x25, 0xa4,
+	0x68, 0x82, 0x58, 0x85, 0x27, 0x58, 0x3b, 0x4d, 0x9c, 0x33, 0x24, 0x24, 0x2e, 0x91, 0x13, 0x5b,
+	0xa9, 0x69, 0x63, 0x57, 0xb6, 0x53, 0xe8, 0x99, 0x0b, 0x47, 0xce, 0x3c, 0x01, 0xe2, 0xb4, 0x57,
+	0xe0, 0xb6, 0xe3, 0x8e, 0x9c, 0x00, 0xb5, 0x87, 0xbd, 0x06, 0xca, 0x3f, 0xd9, 0x34, 0xa1, 0x9d,
+	0xe1, 0x12, 0x47, 0xbf, 0x2f, 0xf1, 0xf7, 0xd7, 0xf7, 0xe9, 0x8f, 0x1f, 0xbe, 0xab, 0x94, 0xa6,
+	0xdc, 0xc8, 0x25, 0x5d, 0x8d, 0xe1, 0x8c, 0x97, 0x46, 0x3b, 0x4d, 0x86, 0xb5, 0x10, 0x03, 0x58,
+	0x8d, 0xf7, 0x1f, 0x14, 0xba, 0xd0, 0x20, 0xd0, 0xfa, 0xad, 0xf9, 0x66, 0xff, 0x1e, 0x2b, 0xa5,
+	0xd2, 0x14, 0x9e, 0x2d, 0x0a, 0x72, 0x6d, 0x4b, 0x6d, 0x69, 0xc6, 0xac, 0xa0, 0xab, 0x71, 0x26,
+	0x1c, 0x1b, 0xd3, 0x5c, 0x4b, 0xd5, 0xe8, 0xa3, 0x4f, 0x1e, 0x1e, 0x1e, 0x1b, 0xb9, 0x3c, 0xcd,
+	0x67, 0x82, 0x57, 0x0b, 0x41, 0xf6, 0x70, 0x57, 0x72, 0x1f, 0x85, 0x28, 0xda, 0x49, 0xba, 0x92,
+	0x93, 0xa7, 0x78, 0xcf, 0x0a, 0xc5, 0x85, 0x49, 0x19, 0xe7, 0x46, 0x58, 0xeb, 0x77, 0x43, 0x14,
+	0xf5, 0x93, 0xdd, 0x86, 0x1e, 0x35, 0x90, 0xac, 0x71, 0x8f, 0x95, 0xba, 0x52, 0xce, 0xf7, 0x42,
+	0x2f, 0x1a, 0xbc, 0x78, 0x14, 0x37, 0xc6, 0x71, 0x6d, 0x1c, 0xb7, 0xc6, 0xf1, 0x54, 0x4b, 0x35,
+	0x39, 0x39, 0xff, 0x79, 0xd0, 0xf9, 0xf6, 0xeb, 0x20, 0x2a, 0xa4, 0x9b, 0x55, 0x59, 0x9c, 0xeb,
+	0x92, 0xb6, 0x53, 0x36, 0xc7, 0x73, 0xcb, 0xe7, 0xd4, 0xad, 0x97, 0xc2, 0xc2, 0x0f, 0xf6, 0xcb,
+	0xe5, 0xd9, 0xe1, 0x70, 0x21, 0x0a, 0x96, 0xaf, 0xd3, 0x7a, 0x74, 0xfb, 0xf5, 0xf2, 0xec, 0x10,
+	0x25, 0xad, 0x21, 0xf9, 0x88, 0xf0, 0x80, 0x4b, 0xeb, 0x8c, 0xcc, 0x2a, 0x27, 0xb8, 0xbf, 0xf3,
+	0xaf, 0x06, 0xb8, 0xe9, 0x4a, 0x9e, 0xe0, 0xa1, 0x75, 0xcc, 0xb8, 0x74, 0x26, 0x64, 0x31, 0x73,
+	0xfe, 0x9d, 0x10, 0x45, 0x5e, 0x32, 0x00, 0xf6, 0x0a, 0x10, 0x79, 0x8c, 0xb1, 0xaa, 0xca, 0x34,
+	0x5b, 0xe8, 0x7c, 0x6e, 0xfd, 0x1e, 0x44, 0xdc, 0x57, 0x55, 0x39, 0x01, 0x30, 0xe2, 0x18, 0xd7,
+	0x4d, 0xbc, 0x66, 0xa6, 0x10, 0x8e, 0x50, 0x7c, 0x7f, 0xc5, 0x16, 0x92, 0x33, 0xa7, 0xaf, 0xa3,
+	0x17, 0xd6, 0x47, 0xa1, 0x17, 0xf5, 0x13, 0x72, 0x2d, 0x1d, 0x5d, 0x29, 0x50, 0x94, 0x63, 0x73,
+	0x91, 0xbe, 0x07, 0x37, 0xc1, 0xa1, 0xa8, 0xbb, 0xc9, 0x2e, 0xd0, 0x37, 0x2d, 0x1c, 0x7d, 0x47,
+	0x98, 0x9c, 0x42, 0x75, 0xc7, 0x57, 0xd3, 0x4b, 0xad, 0x6e, 0xa9, 0x19, 0xdd, 0x56, 0xf3, 0xdf,
+	0x59, 0x77, 0xff, 0x47, 0xd6, 0x93, 0x93, 0xf3, 0x4d, 0x80, 0x2e, 0x36, 0x01, 0xfa, 0xbd, 0x09,
+	0xd0, 0xe7, 0x6d, 0xd0, 0xb9, 0xd8, 0x06, 0x9d, 0x1f, 0xdb, 0xa0, 0xf3, 0xf6, 0xd9, 0x0d, 0x9b,
+	0x29, 0xdc, 0x3f, 0xd5, 0xca, 0x19, 0x96, 0x3b, 0x4b, 0x61, 0xb3, 0x3e, 0x34, 0xbb, 0x05, 0x86,
+	0x59, 0x0f, 0x76, 0xe0, 0xe5, 0x9f, 0x01, 0x00, 0x26, 0xeb, 0x63, 0x0a, 0x75, 0x03, 0x00, 0x00,
+}
+
+func (m *DripSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DripSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DripSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintDrip(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDrip(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDrip(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDrip(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintDrip(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDrip(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DripTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DripTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DripTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakeWeighted {
+		i--
+		if m.StakeWeighted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintDrip(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDrip(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintDrip(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDrip(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDrip(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DripSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDrip(uint64(m.Id))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovDrip(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDrip(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDrip(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDrip(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovDrip(uint64(m.NumBlocks))
+	}
+	return n
+}
+
+func (m *DripTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovDrip(uint64(l))
+		}
+	}
+	if m.StakeWeighted {
+		n += 2
+	}
+	return n
+}
+
+func (m *SenderDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovDrip(uint64(l))
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovDrip(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDrip(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDrip(x uint64) (n int) {
+	return sovDrip(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DripSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDrip
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DripSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DripSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDrip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DripTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDrip
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DripTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DripTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeighted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeighted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDrip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDrip
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDrip
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDrip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDrip
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDrip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDrip
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDrip
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDrip
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDrip
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDrip
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDrip        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDrip          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDrip = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDripScheduleReleasable(t *testing.T) {
+	sender := sdk.AccAddress([]byte("cosmos1"))
+	amount := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000)), sdk.NewCoin("uatom", sdk.NewInt(10)))
+	schedule := NewDripSchedule(1, sender, amount, 10, 3)
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, int64(12), schedule.EndHeight())
+
+	// nothing is released before the start height
+	require.True(t, schedule.Releasable(9).IsZero())
+
+	expected := []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(333)), sdk.NewCoin("uatom", sdk.NewInt(3))),
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(333)), sdk.NewCoin("uatom", sdk.NewInt(3))),
+		sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(334)), sdk.NewCoin("uatom", sdk.NewInt(4))),
+	}
+	for i, exp := range expected {
+		releasable := schedule.Releasable(10 + int64(i))
+		require.Equal(t, exp, releasable)
+		schedule.Distributed = schedule.Distributed.Add(releasable...)
+	}
+
+	require.True(t, schedule.IsCompleted())
+	require.True(t, schedule.Releasable(13).IsZero())
+}
+
+func TestDripScheduleValidate(t *testing.T) {
+	sender := sdk.AccAddress([]byte("cosmos1"))
+	amount := sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000)))
+
+	testCases := []struct {
+		name     string
+		schedule DripSchedule
+		expErr   bool
+	}{
+		{"valid", NewDripSchedule(1, sender, amount, 1, 10), false},
+		{"empty amount", NewDripSchedule(1, sender, sdk.NewCoins(), 1, 10), true},
+		{"zero blocks", NewDripSchedule(1, sender, amount, 1, 0), true},
+		{"zero start height", NewDripSchedule(1, sender, amount, 0, 10), true},
+		{"too many blocks", NewDripSchedule(1, sender, amount, 1, MaxScheduleNumBlocks+1), true},
+		{"overflowing end height", NewDripSchedule(1, sender, amount, 10, ^uint64(0)), true},
+		{"invalid sender", DripSchedule{Id: 1, SenderAddress: "invalid", Amount: amount, StartHeight: 1, NumBlocks: 10}, true},
+		{"distributed more than amount", DripSchedule{
+			Id:            1,
+			SenderAddress: sender.String(),
+			Amount:        amount,
+			Distributed:   amount.Add(amount...),
+			StartHeight:   1,
+			NumBlocks:     10,
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.schedule.Validate()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestDripTargetValidate(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("validator1")).String()
+	valAddr2 := sdk.ValAddress([]byte("validator2")).String()
+
+	tooMany := make([]string, MaxTargetValidators+1)
+	for i := range tooMany {
+		tooMany[i] = sdk.ValAddress([]byte{byte(i / 256), byte(i % 256)}).String()
+	}
+
+	testCases := []struct {
+		name   string
+		target DripTarget
+		expErr bool
+	}{
+		{"single validator", DripTarget{ValidatorAddresses: []string{valAddr}}, false},
+		{"stake weighted", DripTarget{ValidatorAddresses: []string{valAddr, valAddr2}, StakeWeighted: true}, false},
+		{"no validators", DripTarget{}, false},
+		{"stake weighted without validators", DripTarget{StakeWeighted: true}, true},
+		{"invalid address", DripTarget{ValidatorAddresses: []string{"juno1invalid"}}, true},
+		{"account address", DripTarget{ValidatorAddresses: []string{sdk.AccAddress([]byte("validator1")).String()}}, true},
+		{"duplicate validator", DripTarget{ValidatorAddresses: []string{valAddr, valAddr}}, true},
+		{"too many validators", DripTarget{ValidatorAddresses: tooMany}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.target.Validate()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -5,9 +5,14 @@ import (
 )
 
 var (
-	ErrDripDisabled   = errorsmod.Register(ModuleName, 1, "drip module is disabled by governance")
-	ErrDripNotAllowed = errorsmod.Register(ModuleName, 2, "this address is not allowed to use the module, you can request access from governance")
-	ErrEmpty          = errorsmod.Register(ModuleName, 3, "empty")
-	ErrDuplicate      = errorsmod.Register(ModuleName, 4, "duplicate")
-	ErrBlank          = errorsmod.Register(ModuleName, 5, "address cannot be blank")
+	ErrDripDisabled     = errorsmod.Register(ModuleName, 1, "drip module is disabled by governance")
+	ErrDripNotAllowed   = errorsmod.Register(ModuleName, 2, "this address is not allowed to use the module, you can request access from governance")
+	ErrEmpty            = errorsmod.Register(ModuleName, 3, "empty")
+	ErrDuplicate        = errorsmod.Register(ModuleName, 4, "duplicate")
+	ErrBlank            = errorsmod.Register(ModuleName, 5, "address cannot be blank")
+	ErrScheduleNotFound = errorsmod.Register(ModuleName, 6, "drip schedule not found")
+	ErrNotScheduleOwner = errorsmod.Register(ModuleName, 7, "only the sender of the drip schedule can cancel it")
+	ErrInvalidSchedule  = errorsmod.Register(ModuleName, 8, "invalid drip schedule")
+	ErrInvalidTarget    = errorsmod.Register(ModuleName, 9, "invalid drip target")
+	ErrBelowMinimum     = errorsmod.Register(ModuleName, 10, "amount is below the permissionless minimum")
 )
@@ -0,0 +1,15 @@
+package types
+
+const (
+	EventTypeScheduleDrip = "schedule_drip"
+	EventTypeCancelDrip   = "cancel_drip"
+	EventTypeReleaseDrip  = "release_drip"
+
+	EventTypeDistributeToValidator = "distribute_to_validator"
+
+	AttributeKeyScheduleID  = "schedule_id"
+	AttributeKeyStartHeight = "start_height"
+	AttributeKeyNumBlocks   = "num_blocks"
+	AttributeKeyRefunded    = "refunded"
+	AttributeKeyValidator   = "validator"
+)
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -10,4 +12,19 @@ type BankKeeper interface {
 
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected interface needed to allocate rewards
+// to the delegators of a validator.
+type DistributionKeeper interface {
+	GetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress) distrtypes.ValidatorCurrentRewards
+	SetValidatorCurrentRewards(ctx sdk.Context, val sdk.ValAddress, rewards distrtypes.ValidatorCurrentRewards)
+	GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) distrtypes.ValidatorOutstandingRewards
+	SetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress, rewards distrtypes.ValidatorOutstandingRewards)
+}
+
+// StakingKeeper defines the expected interface needed to retrieve validators.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
 }
@@ -1,9 +1,21 @@
 package types
 
+import (
+	"fmt"
+)
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(
+	params Params,
+	schedules []DripSchedule,
+	nextScheduleID uint64,
+	senderDistributions []SenderDistribution,
+) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:              params,
+		Schedules:           schedules,
+		NextScheduleId:      nextScheduleID,
+		SenderDistributions: senderDistributions,
 	}
 }
 
@@ -11,12 +23,45 @@ func NewGenesisState(params Params) GenesisState {
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:              DefaultParams(),
+		Schedules:           []DripSchedule{},
+		NextScheduleId:      1,
+		SenderDistributions: []SenderDistribution{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, schedule := range gs.Schedules {
+		if seenIDs[schedule.Id] {
+			return fmt.Errorf("duplicate drip schedule id %d", schedule.Id)
+		}
+
+		if schedule.Id >= gs.NextScheduleId {
+			return fmt.Errorf("drip schedule id %d must be lower than the next schedule id %d", schedule.Id, gs.NextScheduleId)
+		}
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		seenIDs[schedule.Id] = true
+	}
+
+	seenSenders := make(map[string]bool)
+	for _, distribution := range gs.SenderDistributions {
+		if seenSenders[distribution.SenderAddress] {
+			return fmt.Errorf("duplicate sender distribution for %s", distribution.SenderAddress)
+		}
+
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+
+		seenSenders[distribution.SenderAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +30,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are the drip module parameters
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// schedules are the active drip schedules
+	Schedules []DripSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+	// next_schedule_id is the id assigned to the next drip schedule
+	NextScheduleId uint64 `protobuf:"varint,3,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
+	// sender_distributions are the cumulative amounts distributed per sender
+	SenderDistributions []SenderDistribution `protobuf:"bytes,4,rep,name=sender_distributions,json=senderDistributions,proto3" json:"sender_distributions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,12 +78,40 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSchedules() []DripSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSenderDistributions() []SenderDistribution {
+	if m != nil {
+		return m.SenderDistributions
+	}
+	return nil
+}
+
 // Params defines the drip module params
 type Params struct {
 	// enable_drip defines a parameter to enable the drip module
 	EnableDrip bool `protobuf:"varint,1,opt,name=enable_drip,json=enableDrip,proto3" json:"enable_drip,omitempty"`
 	// allowed_addresses defines the list of addresses authorized to use the module
 	AllowedAddresses []string `protobuf:"bytes,3,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty" yaml:"addresses"`
+	// permissionless allows any address to distribute tokens, as long as the
+	// amount only contains denoms listed in permissionless_min_amounts
+	Permissionless bool `protobuf:"varint,4,opt,name=permissionless,proto3" json:"permissionless,omitempty"`
+	// permissionless_min_amounts defines the denoms that addresses which are not
+	// in allowed_addresses can distribute, along with the minimum amount of each
+	// denom per distribution
+	PermissionlessMinAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=permissionless_min_amounts,json=permissionlessMinAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"permissionless_min_amounts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -124,6 +161,20 @@ func (m *Params) GetAllowedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetPermissionless() bool {
+	if m != nil {
+		return m.Permissionless
+	}
+	return false
+}
+
+func (m *Params) GetPermissionlessMinAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PermissionlessMinAmounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.drip.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.drip.v1.Params")
@@ -132,24 +183,39 @@ func init() {
 func init() { proto.RegisterFile("juno/drip/v1/genesis.proto", fileDescriptor_a281ae9bcc19c501) }
 
 var fileDescriptor_a281ae9bcc19c501 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x29, 0xca, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0xc9, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x12, 0xfa, 0x20, 0x16, 0x44, 0x8d, 0x92, 0x13, 0x17,
-	0x8f, 0x3b, 0x44, 0x53, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x11, 0x17, 0x5b, 0x41, 0x62, 0x51,
-	0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xb2, 0x21, 0x7a, 0x01,
-	0x60, 0x39, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x2a, 0x95, 0x72, 0xb8, 0xd8, 0x20,
-	0xe2, 0x42, 0xf2, 0x5c, 0xdc, 0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0xf1, 0x20, 0x0d, 0x60, 0x23,
-	0x38, 0x82, 0xb8, 0x20, 0x42, 0x2e, 0x45, 0x99, 0x05, 0x42, 0x8e, 0x5c, 0x82, 0x89, 0x39, 0x39,
-	0xf9, 0xe5, 0xa9, 0x29, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12, 0xcc,
-	0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x22, 0x9f, 0xee, 0xc9, 0x0b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0xc1, 0xa5, 0x94, 0x82, 0x04, 0xa0, 0xca, 0x1d, 0x61, 0x42, 0x4e, 0x6e, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xec, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c,
-	0x52, 0xac, 0x0f, 0x0e, 0xa6, 0x0a, 0x48, 0x40, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x03, 0xc0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x9c, 0xad, 0xd3, 0x42, 0x01, 0x00, 0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x9b, 0x10, 0xd1, 0x4b, 0x54, 0xa5, 0x26, 0x12, 0x26, 0x83, 0x13, 0x65, 0x40, 0x56,
+	0x05, 0x77, 0x4a, 0xd8, 0x18, 0x90, 0x92, 0x54, 0x45, 0x0c, 0x48, 0x28, 0x99, 0x60, 0xb1, 0xce,
+	0xbe, 0x53, 0x7a, 0x60, 0xdf, 0x59, 0xfe, 0x2e, 0xa1, 0xf9, 0x05, 0xac, 0xcc, 0x2c, 0xac, 0x88,
+	0xa9, 0x13, 0xbf, 0xa1, 0x63, 0x47, 0xa6, 0x82, 0x92, 0xa1, 0x3b, 0xbf, 0x00, 0xdd, 0xd9, 0x81,
+	0x04, 0x16, 0xdb, 0x7a, 0xef, 0x7d, 0xf7, 0xbe, 0xe7, 0x7b, 0xa8, 0xf3, 0x76, 0x21, 0x15, 0x61,
+	0xb9, 0xc8, 0xc8, 0x72, 0x40, 0xe6, 0x5c, 0x72, 0x10, 0x80, 0xb3, 0x5c, 0x69, 0xe5, 0x36, 0x0d,
+	0x87, 0x0d, 0x87, 0x97, 0x83, 0x4e, 0x7b, 0xae, 0xe6, 0xca, 0x12, 0xc4, 0x7c, 0x15, 0x9a, 0xce,
+	0x31, 0x4d, 0x85, 0x54, 0xc4, 0x3e, 0x4b, 0xc8, 0x8f, 0x15, 0xa4, 0x0a, 0x48, 0x44, 0x81, 0x93,
+	0xe5, 0x20, 0xe2, 0x9a, 0x0e, 0x48, 0xac, 0x84, 0x2c, 0xf9, 0xfb, 0x7b, 0x96, 0xf6, 0x78, 0x4b,
+	0xf4, 0x3f, 0x1c, 0xa0, 0xe6, 0xf3, 0x62, 0x83, 0x99, 0xa6, 0x9a, 0xbb, 0x43, 0x54, 0xcf, 0x68,
+	0x4e, 0x53, 0xf0, 0x9c, 0x9e, 0x13, 0x34, 0x86, 0x6d, 0xbc, 0xbb, 0x11, 0x7e, 0x65, 0xb9, 0x71,
+	0xed, 0xea, 0xa6, 0x5b, 0x99, 0x96, 0x4a, 0xf7, 0x19, 0x3a, 0x84, 0xf8, 0x9c, 0xb3, 0x45, 0xc2,
+	0xc1, 0x3b, 0xe8, 0x55, 0x83, 0xc6, 0xb0, 0xb3, 0x3f, 0x76, 0x9a, 0x8b, 0x6c, 0x56, 0x4a, 0xca,
+	0xe1, 0xbf, 0x23, 0x6e, 0x80, 0x5a, 0x92, 0x5f, 0xe8, 0x70, 0x8b, 0x84, 0x82, 0x79, 0xd5, 0x9e,
+	0x13, 0xd4, 0xa6, 0x47, 0x06, 0xdf, 0x0e, 0xbe, 0x60, 0xee, 0x6b, 0xd4, 0x06, 0x2e, 0x19, 0xcf,
+	0x43, 0x26, 0x40, 0xe7, 0x22, 0x5a, 0x68, 0xa1, 0x24, 0x78, 0x35, 0x6b, 0xda, 0xdb, 0x37, 0x9d,
+	0x59, 0xe5, 0xe9, 0x8e, 0xb0, 0xb4, 0xbe, 0x07, 0xff, 0x31, 0xd0, 0xff, 0x76, 0x80, 0xea, 0x45,
+	0x3a, 0xb7, 0x8b, 0x1a, 0x5c, 0xd2, 0x28, 0xe1, 0xa1, 0x39, 0xca, 0xfe, 0x88, 0xbb, 0x53, 0x54,
+	0x40, 0x26, 0x89, 0x3b, 0x42, 0xc7, 0x34, 0x49, 0xd4, 0x7b, 0xce, 0x42, 0xca, 0x58, 0xce, 0x01,
+	0x38, 0x78, 0xd5, 0x5e, 0x35, 0x38, 0x1c, 0xb7, 0x7f, 0xdd, 0x74, 0x5b, 0x2b, 0x9a, 0x26, 0x4f,
+	0xfb, 0x7f, 0xa8, 0xfe, 0xb4, 0x55, 0xca, 0x47, 0x5b, 0xc8, 0x7d, 0x88, 0x8e, 0x32, 0x9e, 0xa7,
+	0x02, 0x40, 0x28, 0x99, 0x70, 0x30, 0x19, 0x8c, 0xcd, 0x3f, 0xa8, 0xfb, 0xd9, 0x41, 0x9d, 0x7d,
+	0x28, 0x4c, 0x85, 0x0c, 0x69, 0xaa, 0x16, 0x52, 0x83, 0x77, 0xc7, 0x06, 0x7f, 0x80, 0x8b, 0xfb,
+	0xc7, 0xe6, 0xfe, 0x71, 0x79, 0xff, 0x78, 0xa2, 0x84, 0x1c, 0x9f, 0x99, 0xc4, 0x5f, 0x7f, 0x74,
+	0x83, 0xb9, 0xd0, 0xe7, 0x8b, 0x08, 0xc7, 0x2a, 0x25, 0x65, 0x59, 0x8a, 0xd7, 0x63, 0x60, 0xef,
+	0x88, 0x5e, 0x65, 0x1c, 0xec, 0x00, 0x7c, 0xba, 0xbd, 0x3c, 0x69, 0x26, 0x7c, 0x4e, 0xe3, 0x55,
+	0x68, 0x1a, 0x04, 0x5f, 0x6e, 0x2f, 0x4f, 0x9c, 0xa9, 0xb7, 0xbf, 0xc4, 0x4b, 0x21, 0x47, 0xc5,
+	0x0a, 0xe3, 0xb3, 0xab, 0xb5, 0xef, 0x5c, 0xaf, 0x7d, 0xe7, 0xe7, 0xda, 0x77, 0x3e, 0x6e, 0xfc,
+	0xca, 0xf5, 0xc6, 0xaf, 0x7c, 0xdf, 0xf8, 0x95, 0x37, 0x8f, 0x76, 0x3c, 0x27, 0xd6, 0x6c, 0xa2,
+	0xa4, 0xce, 0x69, 0xac, 0x81, 0xd8, 0x42, 0x5e, 0x14, 0x95, 0xb4, 0xee, 0x51, 0xdd, 0x36, 0xf2,
+	0xc9, 0xef, 0x01, 0x00, 0xe4, 0xb0, 0x82, 0xc3, 0x1f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -172,6 +238,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SenderDistributions) > 0 {
+		for iNdEx := len(m.SenderDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SenderDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -205,6 +304,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PermissionlessMinAmounts) > 0 {
+		for iNdEx := len(m.PermissionlessMinAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermissionlessMinAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Permissionless {
+		i--
+		if m.Permissionless {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowedAddresses) > 0 {
 		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAddresses[iNdEx])
@@ -246,6 +369,21 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
+	if len(m.SenderDistributions) > 0 {
+		for _, e := range m.SenderDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -264,6 +402,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Permissionless {
+		n += 2
+	}
+	if len(m.PermissionlessMinAmounts) > 0 {
+		for _, e := range m.PermissionlessMinAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -335,6 +482,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, DripSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderDistributions = append(m.SenderDistributions, SenderDistribution{})
+			if err := m.SenderDistributions[len(m.SenderDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -437,6 +671,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissionless", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permissionless = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessMinAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionlessMinAmounts = append(m.PermissionlessMinAmounts, types.Coin{})
+			if err := m.PermissionlessMinAmounts[len(m.PermissionlessMinAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// module name
 	ModuleName = "drip"
@@ -13,5 +17,22 @@ const (
 
 // KVStore key prefixes
 var (
-	ParamsKey = []byte{0x00} // Prefix for params key
+	ParamsKey         = []byte{0x00} // Prefix for params key
+	ScheduleKeyPrefix = []byte{0x01} // Prefix for drip schedules
+	NextScheduleIDKey = []byte{0x02} // Key for the next drip schedule id
+
+	SenderDistributionKeyPrefix = []byte{0x03} // Prefix for the cumulative distributions per sender
+
+	ScheduleByStartHeightKeyPrefix = []byte{0x04} // Prefix for the drip schedule ids indexed by start height
 )
+
+// GetScheduleKey returns the store key of a drip schedule
+func GetScheduleKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// GetScheduleByStartHeightKey returns the store key indexing a drip schedule
+// by its start height
+func GetScheduleByStartHeightKey(startHeight int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(startHeight)), sdk.Uint64ToBigEndian(id)...)
+}
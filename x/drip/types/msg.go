@@ -11,10 +11,14 @@ import (
 var (
 	_ sdk.Msg = &MsgDistributeTokens{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgScheduleDrip{}
+	_ sdk.Msg = &MsgCancelDrip{}
 )
 
 const (
 	TypeMsgDistributeTokens = "distribute_tokens"
+	TypeMsgScheduleDrip     = "schedule_drip"
+	TypeMsgCancelDrip       = "cancel_drip"
 )
 
 // NewMsgDistributeTokens creates new instance of MsgDistributeTokens
@@ -52,6 +56,10 @@ func (msg MsgDistributeTokens) ValidateBasic() error {
 		return fmt.Errorf("invalid coins: %s", msg.Amount.String())
 	}
 
+	if msg.Target != nil {
+		return msg.Target.Validate()
+	}
+
 	return nil
 }
 
@@ -66,6 +74,112 @@ func (msg MsgDistributeTokens) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgScheduleDrip creates new instance of MsgScheduleDrip
+func NewMsgScheduleDrip(
+	amount sdk.Coins,
+	numBlocks uint64,
+	startHeight int64,
+	sender sdk.Address,
+) *MsgScheduleDrip {
+	return &MsgScheduleDrip{
+		SenderAddress: sender.String(),
+		Amount:        amount,
+		NumBlocks:     numBlocks,
+		StartHeight:   startHeight,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgScheduleDrip) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgScheduleDrip) Type() string { return TypeMsgScheduleDrip }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgScheduleDrip) ValidateBasic() error {
+	if msg.SenderAddress == "" {
+		return fmt.Errorf("sender address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", err.Error())
+	}
+
+	if msg.Amount == nil || msg.Amount.Empty() {
+		return fmt.Errorf("invalid coins: %s", msg.Amount.String())
+	}
+
+	if !msg.Amount.IsValid() {
+		return fmt.Errorf("invalid coins: %s", msg.Amount.String())
+	}
+
+	if msg.NumBlocks == 0 {
+		return fmt.Errorf("number of blocks must be positive")
+	}
+
+	if msg.NumBlocks > MaxScheduleNumBlocks {
+		return fmt.Errorf("number of blocks too large: %d > %d", msg.NumBlocks, MaxScheduleNumBlocks)
+	}
+
+	if msg.StartHeight < 0 {
+		return fmt.Errorf("start height cannot be negative: %d", msg.StartHeight)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgScheduleDrip) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgScheduleDrip) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCancelDrip creates new instance of MsgCancelDrip
+func NewMsgCancelDrip(
+	id uint64,
+	sender sdk.Address,
+) *MsgCancelDrip {
+	return &MsgCancelDrip{
+		SenderAddress: sender.String(),
+		Id:            id,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCancelDrip) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCancelDrip) Type() string { return TypeMsgCancelDrip }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelDrip) ValidateBasic() error {
+	if msg.SenderAddress == "" {
+		return fmt.Errorf("sender address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelDrip) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelDrip) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
 // GetSignBytes implements the LegacyMsg interface.
 func (msg MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
@@ -79,3 +79,81 @@ func (suite *MsgsTestSuite) TestMsgDistributeTokensNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgScheduleDripNew() {
+	testCases := []struct {
+		msg         string
+		amount      sdk.Coins
+		numBlocks   uint64
+		startHeight int64
+		sender      string
+		expectPass  bool
+	}{
+		{
+			"pass",
+			suite.amount,
+			10,
+			0,
+			suite.sender.String(),
+			true,
+		},
+		{
+			"sender address cannot be empty",
+			suite.amount,
+			10,
+			0,
+			"",
+			false,
+		},
+		{
+			"invalid coins",
+			nil,
+			10,
+			0,
+			suite.sender.String(),
+			false,
+		},
+		{
+			"number of blocks must be positive",
+			suite.amount,
+			0,
+			0,
+			suite.sender.String(),
+			false,
+		},
+		{
+			"number of blocks too large",
+			suite.amount,
+			MaxScheduleNumBlocks + 1,
+			0,
+			suite.sender.String(),
+			false,
+		},
+		{
+			"start height cannot be negative",
+			suite.amount,
+			10,
+			-1,
+			suite.sender.String(),
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgScheduleDrip{
+			Amount:        tc.amount,
+			NumBlocks:     tc.numBlocks,
+			StartHeight:   tc.startHeight,
+			SenderAddress: tc.sender,
+		}
+
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -11,16 +11,21 @@ import (
 var (
 	DefaultEnableDrip       = true
 	DefaultAllowedAddresses = []string(nil) // no one allowed
+	DefaultPermissionless   = false
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableDrip bool,
 	allowedAddresses []string,
+	permissionless bool,
+	permissionlessMinAmounts sdk.Coins,
 ) Params {
 	return Params{
-		EnableDrip:       enableDrip,
-		AllowedAddresses: allowedAddresses,
+		EnableDrip:               enableDrip,
+		AllowedAddresses:         allowedAddresses,
+		Permissionless:           permissionless,
+		PermissionlessMinAmounts: permissionlessMinAmounts,
 	}
 }
 
@@ -29,6 +34,7 @@ func DefaultParams() Params {
 	return Params{
 		EnableDrip:       DefaultEnableDrip,
 		AllowedAddresses: DefaultAllowedAddresses,
+		Permissionless:   DefaultPermissionless,
 	}
 }
 
@@ -59,9 +65,27 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBool(p.Permissionless); err != nil {
+		return err
+	}
+
+	if err := p.PermissionlessMinAmounts.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid permissionless min amounts")
+	}
+
 	return assertValidAddresses(p.AllowedAddresses)
 }
 
+// IsAllowedAddress returns true if the address is one of the allowed addresses
+func (p Params) IsAllowedAddress(addr string) bool {
+	for _, a := range p.AllowedAddresses {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func assertValidAddresses(addrs []string) error {
 	idx := make(map[string]struct{}, len(addrs))
 	for _, a := range addrs {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -15,12 +17,27 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: disabled, no one allowed",
-			NewParams(false, []string(nil)),
+			NewParams(false, []string(nil), false, nil),
 			false,
 		},
 		{
 			"invalid: enabled, address malformed",
-			NewParams(false, []string{"invalid address"}),
+			NewParams(false, []string{"invalid address"}, false, nil),
+			true,
+		},
+		{
+			"valid: permissionless with min amounts",
+			NewParams(true, []string(nil), true, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1_000)))),
+			false,
+		},
+		{
+			"invalid: permissionless min amount is zero",
+			NewParams(true, []string(nil), true, sdk.Coins{sdk.NewCoin("ujuno", sdk.ZeroInt())}),
+			true,
+		},
+		{
+			"invalid: permissionless min amounts duplicated",
+			NewParams(true, []string(nil), true, sdk.Coins{sdk.NewCoin("ujuno", sdk.OneInt()), sdk.NewCoin("ujuno", sdk.OneInt())}),
 			true,
 		},
 	}
@@ -40,6 +40,9 @@ type MsgDistributeTokens struct {
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
 	// amount is the amount being airdropped to stakers
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// target optionally restricts the distribution to the delegators of a set
+	// of validators. If not set, the tokens are distributed to all stakers.
+	Target *DripTarget `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 }
 
 func (m *MsgDistributeTokens) Reset()         { *m = MsgDistributeTokens{} }
@@ -89,6 +92,13 @@ func (m *MsgDistributeTokens) GetAmount() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *MsgDistributeTokens) GetTarget() *DripTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 // MsgDistributeTokensResponse defines the MsgDistributeTokens response type
 type MsgDistributeTokensResponse struct {
 }
@@ -221,52 +231,292 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleDrip defines a message that schedules a streamed distribution of
+// tokens.
+type MsgScheduleDrip struct {
+	// sender_address is the bech32 address of message sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// amount is the total amount being streamed to stakers
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// num_blocks is the number of blocks the amount is released over
+	NumBlocks uint64 `protobuf:"varint,3,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// start_height is the first block at which tokens are released. If zero, the
+	// schedule starts at the next block.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *MsgScheduleDrip) Reset()         { *m = MsgScheduleDrip{} }
+func (m *MsgScheduleDrip) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDrip) ProtoMessage()    {}
+func (*MsgScheduleDrip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{4}
+}
+func (m *MsgScheduleDrip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDrip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDrip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleDrip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDrip.Merge(m, src)
+}
+func (m *MsgScheduleDrip) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDrip) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDrip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleDrip proto.InternalMessageInfo
+
+func (m *MsgScheduleDrip) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgScheduleDrip) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgScheduleDrip) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *MsgScheduleDrip) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// MsgScheduleDripResponse defines the MsgScheduleDrip response type
+type MsgScheduleDripResponse struct {
+	// id is the identifier of the created schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleDripResponse) Reset()         { *m = MsgScheduleDripResponse{} }
+func (m *MsgScheduleDripResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleDripResponse) ProtoMessage()    {}
+func (*MsgScheduleDripResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{5}
+}
+func (m *MsgScheduleDripResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleDripResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleDripResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleDripResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleDripResponse.Merge(m, src)
+}
+func (m *MsgScheduleDripResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleDripResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleDripResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleDripResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleDripResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDrip defines a message that cancels a drip schedule.
+type MsgCancelDrip struct {
+	// sender_address is the bech32 address of the schedule sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// id is the identifier of the schedule to cancel
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelDrip) Reset()         { *m = MsgCancelDrip{} }
+func (m *MsgCancelDrip) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDrip) ProtoMessage()    {}
+func (*MsgCancelDrip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{6}
+}
+func (m *MsgCancelDrip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDrip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDrip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDrip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDrip.Merge(m, src)
+}
+func (m *MsgCancelDrip) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDrip) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDrip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDrip proto.InternalMessageInfo
+
+func (m *MsgCancelDrip) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelDrip) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelDripResponse defines the MsgCancelDrip response type
+type MsgCancelDripResponse struct {
+	// refunded is the undistributed amount returned to the sender
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+}
+
+func (m *MsgCancelDripResponse) Reset()         { *m = MsgCancelDripResponse{} }
+func (m *MsgCancelDripResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDripResponse) ProtoMessage()    {}
+func (*MsgCancelDripResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c0f1d75f17f4bc, []int{7}
+}
+func (m *MsgCancelDripResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDripResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDripResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDripResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDripResponse.Merge(m, src)
+}
+func (m *MsgCancelDripResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDripResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDripResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDripResponse proto.InternalMessageInfo
+
+func (m *MsgCancelDripResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgDistributeTokens)(nil), "juno.drip.v1.MsgDistributeTokens")
 	proto.RegisterType((*MsgDistributeTokensResponse)(nil), "juno.drip.v1.MsgDistributeTokensResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.drip.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.drip.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleDrip)(nil), "juno.drip.v1.MsgScheduleDrip")
+	proto.RegisterType((*MsgScheduleDripResponse)(nil), "juno.drip.v1.MsgScheduleDripResponse")
+	proto.RegisterType((*MsgCancelDrip)(nil), "juno.drip.v1.MsgCancelDrip")
+	proto.RegisterType((*MsgCancelDripResponse)(nil), "juno.drip.v1.MsgCancelDripResponse")
 }
 
 func init() { proto.RegisterFile("juno/drip/v1/tx.proto", fileDescriptor_73c0f1d75f17f4bc) }
 
 var fileDescriptor_73c0f1d75f17f4bc = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xbd, 0x6f, 0x13, 0x4d,
-	0x10, 0xc6, 0x7d, 0xc9, 0xab, 0x48, 0xde, 0xf8, 0xe5, 0xe3, 0x30, 0x8a, 0x6d, 0xc8, 0xd9, 0x39,
-	0x11, 0xc9, 0x58, 0xe4, 0x56, 0x36, 0x08, 0xa4, 0x74, 0xd8, 0x28, 0x9d, 0x25, 0x64, 0x42, 0x43,
-	0x63, 0xad, 0x7d, 0xab, 0xcd, 0x92, 0xdc, 0xee, 0xe9, 0x66, 0x6d, 0xd9, 0x6d, 0x4a, 0x44, 0x81,
-	0x44, 0x47, 0x45, 0x89, 0xa8, 0x5c, 0x50, 0xd0, 0xd0, 0xa7, 0x8c, 0xa0, 0xa1, 0x02, 0x64, 0x23,
-	0x19, 0xf1, 0x57, 0xa0, 0xbb, 0xdd, 0x24, 0xb6, 0x13, 0x41, 0x73, 0x1f, 0xcf, 0x33, 0x3b, 0xf3,
-	0x9b, 0xb9, 0x39, 0x74, 0xfd, 0x79, 0x4f, 0x48, 0xec, 0x47, 0x3c, 0xc4, 0xfd, 0x2a, 0x56, 0x03,
-	0x2f, 0x8c, 0xa4, 0x92, 0x76, 0x26, 0x96, 0xbd, 0x58, 0xf6, 0xfa, 0xd5, 0x42, 0x96, 0x49, 0x26,
-	0x13, 0x03, 0xc7, 0x4f, 0x3a, 0xa6, 0x70, 0x93, 0x49, 0xc9, 0x0e, 0x28, 0x26, 0x21, 0xc7, 0x44,
-	0x08, 0xa9, 0x88, 0xe2, 0x52, 0x80, 0x71, 0xaf, 0x92, 0x80, 0x0b, 0x89, 0x93, 0xab, 0x91, 0x9c,
-	0xae, 0x84, 0x40, 0x02, 0xee, 0x10, 0xa0, 0xb8, 0x5f, 0xed, 0x50, 0x45, 0xaa, 0xb8, 0x2b, 0xb9,
-	0x30, 0xfe, 0x9a, 0xf1, 0x03, 0x60, 0x31, 0x4c, 0x00, 0xcc, 0x18, 0x79, 0x6d, 0xb4, 0x35, 0x82,
-	0x7e, 0x31, 0x56, 0x61, 0x8e, 0x9f, 0x51, 0x41, 0x81, 0x1b, 0xcf, 0xfd, 0x64, 0xa1, 0x6b, 0x4d,
-	0x60, 0x8f, 0x38, 0xa8, 0x88, 0x77, 0x7a, 0x8a, 0xee, 0xca, 0x7d, 0x2a, 0xc0, 0xde, 0x44, 0x97,
-	0x80, 0x0a, 0x9f, 0x46, 0x6d, 0xe2, 0xfb, 0x11, 0x05, 0xc8, 0x59, 0x25, 0xab, 0x9c, 0x6e, 0xfd,
-	0xaf, 0xd5, 0x87, 0x5a, 0xb4, 0x87, 0x68, 0x85, 0x04, 0xb2, 0x27, 0x54, 0x6e, 0xa9, 0xb4, 0x5c,
-	0x5e, 0xad, 0xe5, 0x3d, 0x53, 0x39, 0xe6, 0xf7, 0x0c, 0xbf, 0xd7, 0x90, 0x5c, 0xd4, 0x77, 0x8e,
-	0xbe, 0x15, 0x53, 0xef, 0xbf, 0x17, 0xcb, 0x8c, 0xab, 0xbd, 0x5e, 0xc7, 0xeb, 0xca, 0xc0, 0x60,
-	0x9a, 0xdb, 0x16, 0xf8, 0xfb, 0x58, 0x0d, 0x43, 0x0a, 0xc9, 0x01, 0x78, 0x33, 0x1d, 0x55, 0x32,
-	0x07, 0x94, 0x91, 0xee, 0xb0, 0x1d, 0x4f, 0x00, 0xde, 0x4d, 0x47, 0x15, 0xab, 0x65, 0x0a, 0x6e,
-	0xff, 0xf7, 0xeb, 0x6d, 0x31, 0xe5, 0xae, 0xa3, 0x1b, 0x17, 0xe0, 0xb7, 0x28, 0x84, 0x52, 0x00,
-	0x75, 0x3f, 0x5a, 0xe8, 0x72, 0x13, 0xd8, 0xd3, 0xd0, 0x27, 0x8a, 0x3e, 0x26, 0x11, 0x09, 0xc0,
-	0xbe, 0x8f, 0xd2, 0xa4, 0xa7, 0xf6, 0x64, 0xc4, 0xd5, 0x50, 0x77, 0x55, 0xcf, 0x7d, 0xfe, 0xb0,
-	0x95, 0x35, 0xe4, 0xa6, 0xb5, 0x27, 0x2a, 0xe2, 0x82, 0xb5, 0xce, 0x42, 0xed, 0x07, 0x68, 0x25,
-	0x4c, 0x32, 0xe4, 0x96, 0x4a, 0x56, 0x79, 0xb5, 0x96, 0xf5, 0x66, 0x17, 0xc0, 0xd3, 0xd9, 0xeb,
-	0xe9, 0xb8, 0x4d, 0x43, 0xaa, 0xc3, 0xb7, 0xef, 0x1d, 0x4e, 0x47, 0x95, 0xb3, 0x44, 0x2f, 0xa6,
-	0xa3, 0xca, 0xc6, 0x4c, 0xcb, 0x03, 0x1c, 0x5b, 0x78, 0x01, 0xd3, 0xcd, 0xa3, 0xb5, 0x05, 0xe9,
-	0xa4, 0xab, 0xda, 0x6f, 0x0b, 0x2d, 0x37, 0x81, 0xd9, 0x2f, 0x2d, 0x74, 0xe5, 0xdc, 0x97, 0xdb,
-	0x98, 0xc7, 0xba, 0x60, 0x3a, 0x85, 0xdb, 0xff, 0x0c, 0x39, 0x1d, 0x60, 0xe5, 0xf0, 0xcb, 0xcf,
-	0xd7, 0x4b, 0xb7, 0x5c, 0x17, 0x2f, 0xfc, 0x04, 0xd8, 0x3f, 0x3d, 0xd2, 0x56, 0xba, 0xf2, 0x2e,
-	0xca, 0xcc, 0x0d, 0x7a, 0xfd, 0x5c, 0x99, 0x59, 0xbb, 0xb0, 0xf9, 0x57, 0xfb, 0x84, 0xa0, 0xbe,
-	0x73, 0x34, 0x76, 0xac, 0xe3, 0xb1, 0x63, 0xfd, 0x18, 0x3b, 0xd6, 0xab, 0x89, 0x93, 0x3a, 0x9e,
-	0x38, 0xa9, 0xaf, 0x13, 0x27, 0xf5, 0xec, 0xce, 0xcc, 0x26, 0x35, 0x92, 0x79, 0x36, 0xa4, 0x50,
-	0x11, 0xe9, 0x2a, 0xd0, 0xb4, 0x03, 0xcd, 0x9b, 0xec, 0x54, 0x67, 0x25, 0x59, 0xf8, 0xbb, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x00, 0x1f, 0x02, 0x23, 0xce, 0x03, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0x23, 0x45,
+	0x18, 0xf5, 0xda, 0x96, 0x85, 0x27, 0x4e, 0x80, 0x25, 0x91, 0x1d, 0x27, 0xd9, 0x38, 0x4b, 0x82,
+	0x1c, 0x8b, 0xec, 0xe2, 0x80, 0x40, 0x4a, 0x87, 0x1d, 0x45, 0x34, 0x96, 0xd0, 0x26, 0x34, 0x34,
+	0xd6, 0x78, 0x77, 0x18, 0x0f, 0xf1, 0xce, 0xac, 0x76, 0x66, 0xa3, 0x58, 0x82, 0x26, 0x25, 0xa2,
+	0x40, 0x42, 0xa2, 0xa0, 0xa2, 0x44, 0x54, 0x2e, 0x28, 0xf8, 0x13, 0x52, 0x46, 0xd0, 0x50, 0x05,
+	0x94, 0x9c, 0xe4, 0xeb, 0xee, 0x0f, 0xb8, 0xe6, 0xb4, 0xb3, 0x93, 0x8d, 0x7f, 0x44, 0x39, 0x5d,
+	0x73, 0xd2, 0x35, 0xfe, 0xf1, 0xbd, 0x6f, 0xdf, 0xfb, 0xde, 0x9b, 0x6f, 0x6c, 0xb0, 0xf2, 0x6d,
+	0x44, 0x99, 0xed, 0x85, 0x24, 0xb0, 0xcf, 0x9a, 0xb6, 0x38, 0xb7, 0x82, 0x90, 0x09, 0xa6, 0x97,
+	0xe2, 0xb2, 0x15, 0x97, 0xad, 0xb3, 0x66, 0x75, 0x19, 0x33, 0xcc, 0x24, 0x60, 0xc7, 0x9f, 0x92,
+	0x9e, 0xea, 0x3a, 0x66, 0x0c, 0x0f, 0x90, 0x0d, 0x03, 0x62, 0x43, 0x4a, 0x99, 0x80, 0x82, 0x30,
+	0xca, 0x15, 0xfa, 0x2e, 0xf4, 0x09, 0x65, 0xb6, 0x7c, 0x55, 0x25, 0xc3, 0x65, 0xdc, 0x67, 0xdc,
+	0xee, 0x41, 0x8e, 0xec, 0xb3, 0x66, 0x0f, 0x09, 0xd8, 0xb4, 0x5d, 0x46, 0xa8, 0xc2, 0xcb, 0x0a,
+	0xf7, 0x39, 0x8e, 0x87, 0xf1, 0x39, 0x56, 0xc0, 0x6a, 0x02, 0x74, 0x93, 0x11, 0x92, 0x2f, 0x0a,
+	0xaa, 0x4e, 0xcd, 0x8f, 0x11, 0x45, 0x9c, 0xdc, 0x61, 0xe5, 0x29, 0x4c, 0x9a, 0x91, 0x80, 0xf9,
+	0x4c, 0x03, 0xef, 0x75, 0x38, 0x3e, 0x24, 0x5c, 0x84, 0xa4, 0x17, 0x09, 0x74, 0xc2, 0x4e, 0x11,
+	0xe5, 0xfa, 0x0e, 0x58, 0xe2, 0x88, 0x7a, 0x28, 0xec, 0x42, 0xcf, 0x0b, 0x11, 0xe7, 0x15, 0xad,
+	0xa6, 0xd5, 0x8b, 0xce, 0x62, 0x52, 0xfd, 0x3c, 0x29, 0xea, 0x43, 0x50, 0x80, 0x3e, 0x8b, 0xa8,
+	0xa8, 0x64, 0x6b, 0xb9, 0xfa, 0xc2, 0xfe, 0xaa, 0xa5, 0x46, 0x8a, 0x8d, 0x59, 0xca, 0x98, 0xd5,
+	0x66, 0x84, 0xb6, 0x8e, 0x2e, 0xaf, 0x37, 0x33, 0x7f, 0xfc, 0xb7, 0x59, 0xc7, 0x44, 0xf4, 0xa3,
+	0x9e, 0xe5, 0x32, 0x5f, 0xcd, 0xaf, 0xde, 0xf6, 0xb8, 0x77, 0x6a, 0x8b, 0x61, 0x80, 0xb8, 0x7c,
+	0x80, 0xff, 0x3a, 0x1e, 0x35, 0x4a, 0x03, 0x84, 0xa1, 0x3b, 0xec, 0xc6, 0xd1, 0xf0, 0xdf, 0xc7,
+	0xa3, 0x86, 0xe6, 0x28, 0x41, 0xfd, 0x23, 0x50, 0x10, 0x30, 0xc4, 0x48, 0x54, 0x72, 0x35, 0xad,
+	0xbe, 0xb0, 0x5f, 0xb1, 0x26, 0x0f, 0xca, 0x3a, 0x0c, 0x49, 0x70, 0x22, 0x71, 0x47, 0xf5, 0x1d,
+	0xe4, 0x9f, 0xfe, 0xb6, 0x99, 0x31, 0x37, 0xc0, 0xda, 0x03, 0x86, 0x1d, 0xc4, 0x03, 0x46, 0x39,
+	0x32, 0xff, 0xd2, 0xc0, 0xdb, 0x1d, 0x8e, 0xbf, 0x0a, 0x3c, 0x28, 0xd0, 0x97, 0x30, 0x84, 0x3e,
+	0xd7, 0x3f, 0x05, 0x45, 0x18, 0x89, 0x3e, 0x0b, 0x89, 0x18, 0x26, 0x39, 0xb4, 0x2a, 0x7f, 0xff,
+	0xb9, 0xb7, 0xac, 0xbc, 0xaa, 0x30, 0x8e, 0x45, 0x48, 0x28, 0x76, 0xee, 0x5b, 0xf5, 0xcf, 0x40,
+	0x21, 0x90, 0x0c, 0x95, 0xac, 0x1c, 0x71, 0x79, 0x7a, 0xc4, 0x84, 0xbd, 0x55, 0x8c, 0x83, 0x51,
+	0xde, 0x92, 0xf6, 0x83, 0x4f, 0x2e, 0xc6, 0xa3, 0xc6, 0x3d, 0xd1, 0x0f, 0xe3, 0x51, 0x63, 0x6b,
+	0x22, 0xa4, 0x73, 0x3b, 0x86, 0xec, 0x99, 0x31, 0xcd, 0x55, 0x50, 0x9e, 0x29, 0xa5, 0xae, 0x9e,
+	0x27, 0xae, 0x8e, 0xdd, 0x3e, 0xf2, 0xa2, 0x01, 0x8a, 0xc3, 0x79, 0x03, 0x8e, 0x78, 0x03, 0x00,
+	0x1a, 0xf9, 0xdd, 0xde, 0x80, 0xb9, 0xa7, 0x5c, 0x1e, 0x73, 0xde, 0x29, 0xd2, 0xc8, 0x6f, 0xc9,
+	0x82, 0xbe, 0x05, 0x4a, 0x5c, 0xc0, 0x50, 0x74, 0xfb, 0x88, 0xe0, 0xbe, 0xa8, 0xe4, 0x6b, 0x5a,
+	0x3d, 0xe7, 0x2c, 0xc8, 0xda, 0x17, 0xb2, 0xa4, 0x8e, 0x7c, 0x17, 0x94, 0x67, 0xcc, 0xdf, 0x05,
+	0xa3, 0x2f, 0x81, 0x2c, 0xf1, 0xa4, 0xf1, 0xbc, 0x93, 0x25, 0x9e, 0x79, 0x04, 0x16, 0x3b, 0x1c,
+	0xb7, 0x21, 0x75, 0xd1, 0xe0, 0x55, 0x52, 0x4a, 0x78, 0xb2, 0x29, 0xcf, 0x2f, 0x1a, 0x58, 0x99,
+	0x22, 0x4a, 0x15, 0xbf, 0x07, 0x6f, 0x85, 0xe8, 0x9b, 0x88, 0x7a, 0x28, 0xd6, 0x7d, 0x4d, 0x89,
+	0xa6, 0x92, 0xfb, 0xd7, 0x39, 0x90, 0xeb, 0x70, 0xac, 0xff, 0xa8, 0x81, 0x77, 0xe6, 0x6e, 0xfd,
+	0xd6, 0xf4, 0x82, 0x3e, 0x70, 0x4f, 0xaa, 0xbb, 0x2f, 0x6d, 0x49, 0x97, 0xae, 0x71, 0xf1, 0xcf,
+	0x93, 0x9f, 0xb3, 0xdb, 0xa6, 0x69, 0xcf, 0xfc, 0xb2, 0xda, 0x5e, 0xfa, 0x48, 0x57, 0x24, 0xca,
+	0x27, 0xa0, 0x34, 0x75, 0xe5, 0x36, 0xe6, 0x64, 0x26, 0xe1, 0xea, 0xce, 0xa3, 0x70, 0x9a, 0xf5,
+	0x77, 0xa0, 0x34, 0xb5, 0xf2, 0xf3, 0xac, 0x93, 0x70, 0x75, 0xe7, 0x51, 0x38, 0xf5, 0xf5, 0x81,
+	0xf4, 0x55, 0x33, 0x8d, 0x39, 0x5f, 0x5c, 0xb5, 0x77, 0xe3, 0x9a, 0x2e, 0x00, 0x98, 0x58, 0xa4,
+	0xb5, 0x39, 0xf2, 0x7b, 0xb0, 0xfa, 0xfe, 0x23, 0x60, 0xaa, 0xbb, 0x2d, 0x75, 0x0d, 0x73, 0x7d,
+	0x4e, 0xd7, 0x95, 0xcd, 0x52, 0xb5, 0x75, 0x74, 0x79, 0x63, 0x68, 0x57, 0x37, 0x86, 0xf6, 0xff,
+	0x8d, 0xa1, 0xfd, 0x74, 0x6b, 0x64, 0xae, 0x6e, 0x8d, 0xcc, 0xbf, 0xb7, 0x46, 0xe6, 0xeb, 0x0f,
+	0x27, 0x96, 0xa8, 0x2d, 0xb7, 0xa7, 0xcd, 0xa8, 0x08, 0xa1, 0x2b, 0x78, 0xc2, 0x78, 0x9e, 0x70,
+	0xca, 0x75, 0xea, 0x15, 0xe4, 0x1f, 0xc4, 0xc7, 0x2f, 0x06, 0x00, 0x1f, 0xa8, 0x9d, 0xdb, 0x17,
+	0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -281,9 +531,16 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
-	// DistributeTokens distribute the sent tokens to all stakers in the next block
+	// DistributeTokens distribute the sent tokens to all stakers in the next
+	// block, or to the delegators of the target validators
 	DistributeTokens(ctx context.Context, in *MsgDistributeTokens, opts ...grpc.CallOption) (*MsgDistributeTokensResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleDrip escrows the sent tokens and releases them linearly to all
+	// stakers over a number of blocks
+	ScheduleDrip(ctx context.Context, in *MsgScheduleDrip, opts ...grpc.CallOption) (*MsgScheduleDripResponse, error)
+	// CancelDrip cancels a drip schedule and refunds the undistributed tokens
+	// to its sender
+	CancelDrip(ctx context.Context, in *MsgCancelDrip, opts ...grpc.CallOption) (*MsgCancelDripResponse, error)
 }
 
 type msgClient struct {
@@ -312,11 +569,36 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleDrip(ctx context.Context, in *MsgScheduleDrip, opts ...grpc.CallOption) (*MsgScheduleDripResponse, error) {
+	out := new(MsgScheduleDripResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Msg/ScheduleDrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDrip(ctx context.Context, in *MsgCancelDrip, opts ...grpc.CallOption) (*MsgCancelDripResponse, error) {
+	out := new(MsgCancelDripResponse)
+	err := c.cc.Invoke(ctx, "/juno.drip.v1.Msg/CancelDrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
-	// DistributeTokens distribute the sent tokens to all stakers in the next block
+	// DistributeTokens distribute the sent tokens to all stakers in the next
+	// block, or to the delegators of the target validators
 	DistributeTokens(context.Context, *MsgDistributeTokens) (*MsgDistributeTokensResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleDrip escrows the sent tokens and releases them linearly to all
+	// stakers over a number of blocks
+	ScheduleDrip(context.Context, *MsgScheduleDrip) (*MsgScheduleDripResponse, error)
+	// CancelDrip cancels a drip schedule and refunds the undistributed tokens
+	// to its sender
+	CancelDrip(context.Context, *MsgCancelDrip) (*MsgCancelDripResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -329,6 +611,12 @@ func (*UnimplementedMsgServer) DistributeTokens(ctx context.Context, req *MsgDis
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleDrip(ctx context.Context, req *MsgScheduleDrip) (*MsgScheduleDripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDrip not implemented")
+}
+func (*UnimplementedMsgServer) CancelDrip(ctx context.Context, req *MsgCancelDrip) (*MsgCancelDripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDrip not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -370,6 +658,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleDrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleDrip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleDrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Msg/ScheduleDrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleDrip(ctx, req.(*MsgScheduleDrip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDrip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.drip.v1.Msg/CancelDrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDrip(ctx, req.(*MsgCancelDrip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.drip.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -382,6 +706,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleDrip",
+			Handler:    _Msg_ScheduleDrip_Handler,
+		},
+		{
+			MethodName: "CancelDrip",
+			Handler:    _Msg_CancelDrip_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/drip/v1/tx.proto",
@@ -407,6 +739,18 @@ func (m *MsgDistributeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -517,52 +861,210 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleDrip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgDistributeTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleDrip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDrip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDistributeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleDripResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+func (m *MsgScheduleDripResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleDripResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDrip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDrip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDrip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDripResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDripResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDripResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDistributeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDistributeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -580,6 +1082,74 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleDrip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *MsgScheduleDripResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDrip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelDripResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -681,6 +1251,42 @@ func (m *MsgDistributeTokens) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &DripTarget{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -917,6 +1523,414 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleDrip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleDrip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleDrip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleDripResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleDripResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleDripResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDrip) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDrip: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDrip: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDripResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDripResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDripResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,7 +2,6 @@ package ante
 
 import (
 	"fmt"
-	"math"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
@@ -17,6 +16,7 @@ import (
 
 	feepaykeeper "github.com/CosmosContracts/juno/v23/x/feepay/keeper"
 	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	globalfeeante "github.com/CosmosContracts/juno/v23/x/globalfee/ante"
 	globalfeekeeper "github.com/CosmosContracts/juno/v23/x/globalfee/keeper"
 )
 
@@ -60,35 +60,41 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
 	}
 
-	var (
-		priority int64
-		err      error
-	)
+	var err error
 
 	fee := feeTx.GetFee()
 	if !simulate {
-		fee, priority, err = dfd.checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		fee, err = dfd.checkTxFeeWithValidatorMinGasPrices(ctx, tx)
 		if err != nil {
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+
+	paid, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
+	var priority int64
+	if !simulate {
+		priority = dfd.getTxPriority(ctx, paid, feeTx.GetGas())
+	}
+
 	newCtx := ctx.WithPriority(priority)
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+// checkDeductFee deducts the fee of the tx and returns the coins paid for it,
+// which are the sponsor's payment for a FeePay transaction.
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (sdk.Coins, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+		return nil, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
 	}
 
 	feePayer := feeTx.FeePayer()
@@ -99,11 +105,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	// this works with only when feegrant enabled.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !feeGranter.Equals(feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -112,19 +118,24 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// Define errors per route
 	var feePayErr error
 	var sdkErr error
 
+	paid := fee
+
 	// First try to handle FeePay transactions, if error, try the std sdk route.
 	// If not a FeePay transaction, default to the std sdk route.
 	if *dfd.isFeePayTx {
 		// If the fee pay route fails, try the std sdk route
-		feePayErr = dfd.handleZeroFees(ctx, deductFeesFromAcc, sdkTx, fee)
-		if feePayErr != nil {
+		var payment sdk.Coins
+		payment, feePayErr = dfd.handleZeroFees(ctx, deductFeesFromAcc, sdkTx, fee)
+		if feePayErr == nil {
+			paid = payment
+		} else {
 			// Flag the tx to be processed by GlobalFee
 			*dfd.isFeePayTx = false
 
@@ -141,9 +152,9 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	// a sdk error is present, return all errors.
 	if sdkErr != nil {
 		if feePayErr != nil {
-			return errorsmod.Wrapf(feepaytypes.ErrDeductFees, "error deducting fees; fee pay error: %s, sdk error: %s", feePayErr, sdkErr)
+			return nil, errorsmod.Wrapf(feepaytypes.ErrDeductFees, "error deducting fees; fee pay error: %s, sdk error: %s", feePayErr, sdkErr)
 		}
-		return sdkErr
+		return nil, sdkErr
 	}
 
 	events := sdk.Events{
@@ -155,18 +166,19 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return paid, nil
 }
 
-// Handle zero fee transactions for fee prepay module
-func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc types.AccountI, tx sdk.Tx, _ sdk.Coins) error {
+// Handle zero fee transactions for fee prepay module, returning the payment
+// made by the FeePay contract.
+func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc types.AccountI, tx sdk.Tx, _ sdk.Coins) (sdk.Coins, error) {
 	msg := tx.GetMsgs()[0]
 	cw := msg.(*wasmtypes.MsgExecuteContract)
 
 	// Get the fee pay contract
 	feepayContract, err := dfd.feepayKeeper.GetContract(ctx, cw.GetContract())
 	if err != nil {
-		return errorsmod.Wrapf(err, "error getting contract %s", cw.GetContract())
+		return nil, errorsmod.Wrapf(err, "error getting contract %s", cw.GetContract())
 	}
 
 	// Get the fee price in the chain denom
@@ -178,7 +190,7 @@ func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc
 	}
 
 	if feePrice == (sdk.DecCoin{}) {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee price not found for denom %s in globalfee keeper", dfd.bondDenom)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee price not found for denom %s in globalfee keeper", dfd.bondDenom)
 	}
 
 	// Get the tx gas
@@ -190,12 +202,12 @@ func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc
 	// Check if wallet exceeded usage limit on contract
 	accBech32 := deductFeesFromAcc.GetAddress().String()
 	if dfd.feepayKeeper.HasWalletExceededUsageLimit(ctx, feepayContract, accBech32) {
-		return errorsmod.Wrapf(feepaytypes.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d)", feepayContract.WalletLimit)
+		return nil, errorsmod.Wrapf(feepaytypes.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d)", feepayContract.WalletLimit)
 	}
 
 	// Check if the contract has enough funds to cover the fee
 	if !dfd.feepayKeeper.CanContractCoverFee(feepayContract, requiredFee.Uint64()) {
-		return errorsmod.Wrapf(feepaytypes.ErrContractNotEnoughFunds, "contract has insufficient funds; expected: %d, got: %d", requiredFee.Uint64(), feepayContract.Balance)
+		return nil, errorsmod.Wrapf(feepaytypes.ErrContractNotEnoughFunds, "contract has insufficient funds; expected: %d, got: %d", requiredFee.Uint64(), feepayContract.Balance)
 	}
 
 	// Create an array of coins, storing the required fee
@@ -203,7 +215,7 @@ func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc
 
 	// Cover the fees of the transaction, send from FeePay Module to FeeCollector Module
 	if err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feepaytypes.ModuleName, types.FeeCollectorName, payment); err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "error transferring funds from FeePay to FeeCollector; %s", err)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "error transferring funds from FeePay to FeeCollector; %s", err)
 	}
 
 	// Deduct the fee from the contract balance
@@ -211,10 +223,19 @@ func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc
 
 	// Increment wallet usage
 	if err := dfd.feepayKeeper.IncrementContractUses(ctx, feepayContract, accBech32, 1); err != nil {
-		return errorsmod.Wrapf(err, "error incrementing contract uses")
+		return nil, errorsmod.Wrapf(err, "error incrementing contract uses")
 	}
 
-	return nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feepaytypes.EventTypeSponsorFee,
+			sdk.NewAttribute(feepaytypes.AttributeKeyContract, feepayContract.ContractAddress),
+			sdk.NewAttribute(feepaytypes.AttributeKeyWallet, accBech32),
+			sdk.NewAttribute(feepaytypes.AttributeKeyFee, payment.String()),
+		),
+	)
+
+	return payment, nil
 }
 
 // DeductFees deducts fees from the given account.
@@ -232,10 +253,10 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 }
 
 // from the SDK pulled out
-func (dfd DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+func (dfd DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
-		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	feeCoins := feeTx.GetFee()
@@ -258,31 +279,21 @@ func (dfd DeductFeeDecorator) checkTxFeeWithValidatorMinGasPrices(ctx sdk.Contex
 			}
 
 			if !feeCoins.IsAnyGTE(requiredFees) {
-				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
 	}
 
-	priority := getTxPriority(feeCoins, int64(gas))
-	return feeCoins, priority, nil
+	return feeCoins, nil
 }
 
-// getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
-// provided in a transaction.
-// NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
-// where txs with multiple coins could not be prioritize as expected.
-func getTxPriority(fee sdk.Coins, gas int64) int64 {
-	var priority int64
-	for _, c := range fee {
-		p := int64(math.MaxInt64)
-		gasPrice := c.Amount.QuoRaw(gas)
-		if gasPrice.IsInt64() {
-			p = gasPrice.Int64()
-		}
-		if priority == 0 || p < priority {
-			priority = p
-		}
-	}
+// getTxPriority returns the priority of a tx which paid the given coins for
+// gas, normalized to the bond denom with the global fee minimum gas prices.
+// The params are read without consuming gas so the priority does not change
+// the gas used by the tx.
+func (dfd DeductFeeDecorator) getTxPriority(ctx sdk.Context, paid sdk.Coins, gas uint64) int64 {
+	feeCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	gasPrices := dfd.globalfeeKeeper.GetParams(feeCtx).MinimumGasPrices
 
-	return priority
+	return globalfeeante.GetTxPriority(paid, gas, gasPrices, dfd.bondDenom)
 }
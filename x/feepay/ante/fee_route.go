@@ -53,20 +53,23 @@ func (mfd MsgIsFeePayTx) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, n
 	//
 	// This logic is necessary in the case the FeePay decorator fails,
 	// the global fee decorator will still be called to handle fees.
+	//
+	// The context returned by each decorator is kept so the tx priority set
+	// by the FeePay decorator reaches the mempool.
 	if *mfd.isFeePayTx {
-		if ctx, err := mfd.feePayDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
+		if ctx, err = mfd.feePayDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
 			return ctx, err
 		}
 
-		if ctx, err := mfd.globalFeeDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
+		if ctx, err = mfd.globalFeeDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
 			return ctx, err
 		}
 	} else {
-		if ctx, err := mfd.globalFeeDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
+		if ctx, err = mfd.globalFeeDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
 			return ctx, err
 		}
 
-		if ctx, err := mfd.feePayDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
+		if ctx, err = mfd.feePayDecorator.AnteHandle(ctx, tx, simulate, EmptyAnte); err != nil {
 			return ctx, err
 		}
 	}
@@ -0,0 +1,125 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/feepay/ante"
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+	globalfeeante "github.com/CosmosContracts/juno/v23/x/globalfee/ante"
+	globalfeetypes "github.com/CosmosContracts/juno/v23/x/globalfee/types"
+)
+
+func TestFeeRouteSetsTxPriority(t *testing.T) {
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: "testing",
+		Height:  10,
+		Time:    time.Now().UTC(),
+	})
+
+	bondDenom := junoApp.GetChainBondDenom()
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(bondDenom, sdk.NewDecWithPrec(75, 3)))
+	require.NoError(t, junoApp.AppKeepers.GlobalFeeKeeper.SetParams(ctx, globalfeetypes.Params{MinimumGasPrices: gasPrices}))
+
+	payer := sdk.AccAddress([]byte("fee-route-payer_____"))
+	junoApp.AppKeepers.AccountKeeper.SetAccount(ctx, junoApp.AppKeepers.AccountKeeper.NewAccountWithAddress(ctx, payer))
+	require.NoError(t, banktestutil.FundAccount(junoApp.AppKeepers.BankKeeper, ctx, payer, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+
+	// a registered contract sponsoring the fees of its callers
+	contract := sdk.AccAddress([]byte("fee-route-contract__"))
+	junoApp.AppKeepers.FeePayKeeper.SetFeePayContract(ctx, feepaytypes.FeePayContract{
+		ContractAddress: contract.String(),
+		Balance:         1_000_000,
+		WalletLimit:     10,
+	})
+	require.NoError(t, banktestutil.FundModuleAccount(junoApp.AppKeepers.BankKeeper, ctx, feepaytypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+
+	testCases := []struct {
+		name string
+		tx   mockFeeTx
+		paid sdk.Coins
+	}{
+		{
+			name: "fee paying tx",
+			tx: mockFeeTx{
+				fee:   sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20_000)),
+				payer: payer,
+				msgs:  []sdk.Msg{banktypes.NewMsgSend(payer, payer, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)))},
+			},
+			paid: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20_000)),
+		},
+		{
+			name: "sponsored tx is prioritized by the sponsor's payment",
+			tx: mockFeeTx{
+				payer: payer,
+				msgs:  []sdk.Msg{&wasmtypes.MsgExecuteContract{Sender: payer.String(), Contract: contract.String(), Msg: []byte("{}")}},
+			},
+			paid: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 15_000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			isFeePayTx := false
+			fpd := ante.NewDeductFeeDecorator(junoApp.AppKeepers.FeePayKeeper, junoApp.AppKeepers.GlobalFeeKeeper, junoApp.AppKeepers.AccountKeeper, junoApp.AppKeepers.BankKeeper, junoApp.AppKeepers.FeeGrantKeeper, bondDenom, &isFeePayTx)
+			gfd := globalfeeante.NewFeeDecorator(app.GetDefaultBypassFeeMessages(), junoApp.AppKeepers.GlobalFeeKeeper, *junoApp.AppKeepers.StakingKeeper, 1_000_000, &isFeePayTx)
+			route := ante.NewFeeRouteDecorator(junoApp.AppKeepers.FeePayKeeper, &fpd, &gfd, &isFeePayTx)
+
+			newCtx, err := route.AnteHandle(cacheCtx, tc.tx, false, ante.EmptyAnte)
+			require.NoError(t, err)
+
+			expected := globalfeeante.GetTxPriority(tc.paid, tc.tx.GetGas(), gasPrices, bondDenom)
+			require.Positive(t, expected)
+			require.Equal(t, expected, newCtx.Priority())
+		})
+	}
+}
+
+type mockFeeTx struct {
+	fee   sdk.Coins
+	payer sdk.AccAddress
+	msgs  []sdk.Msg
+}
+
+func (tx mockFeeTx) GetGas() uint64 {
+	return 200000
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx mockFeeTx) FeePayer() sdk.AccAddress {
+	return tx.payer
+}
+
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress {
+	return nil
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+func (tx mockFeeTx) ValidateBasic() error {
+	return nil
+}
@@ -0,0 +1,132 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/feepay/bindings/types"
+	feepaykeeper "github.com/CosmosContracts/juno/v23/x/feepay/keeper"
+	feepaytypes "github.com/CosmosContracts/juno/v23/x/feepay/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(feePay *feepaykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			feePay:  feePay,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	feePay  *feepaykeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		// only handle the fee pay messages, leave everything else for the wrapped version
+		var contractMsg bindingstypes.FeePayMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "fee pay msg")
+		}
+
+		if contractMsg.RegisterFeePayContract != nil {
+			return m.registerFeePayContract(ctx, contractAddr, contractMsg.RegisterFeePayContract)
+		}
+		if contractMsg.UnregisterFeePayContract != nil {
+			return m.unregisterFeePayContract(ctx, contractAddr, contractMsg.UnregisterFeePayContract)
+		}
+		if contractMsg.FundFeePayContract != nil {
+			return m.fundFeePayContract(ctx, contractAddr, contractMsg.FundFeePayContract)
+		}
+		if contractMsg.UpdateFeePayContractWalletLimit != nil {
+			return m.updateFeePayContractWalletLimit(ctx, contractAddr, contractMsg.UpdateFeePayContractWalletLimit)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// registerFeePayContract registers a contract administered by the calling contract.
+func (m *CustomMessenger) registerFeePayContract(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterFeePayContract) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feepaytypes.MsgRegisterFeePayContract{
+		SenderAddress: contractAddr.String(),
+		FeePayContract: &feepaytypes.FeePayContract{
+			ContractAddress: register.ContractAddress,
+			WalletLimit:     register.WalletLimit,
+		},
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterFeePayContract")
+	}
+
+	if _, err := m.feePay.RegisterFeePayContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering fee pay contract")
+	}
+	return nil, nil, nil
+}
+
+// unregisterFeePayContract unregisters a contract administered by the calling contract.
+func (m *CustomMessenger) unregisterFeePayContract(ctx sdk.Context, contractAddr sdk.AccAddress, unregister *bindingstypes.UnregisterFeePayContract) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feepaytypes.MsgUnregisterFeePayContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: unregister.ContractAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUnregisterFeePayContract")
+	}
+
+	if _, err := m.feePay.UnregisterFeePayContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "unregistering fee pay contract")
+	}
+	return nil, nil, nil
+}
+
+// fundFeePayContract funds a fee pay contract from the calling contract's balance.
+func (m *CustomMessenger) fundFeePayContract(ctx sdk.Context, contractAddr sdk.AccAddress, fund *bindingstypes.FundFeePayContract) ([]sdk.Event, [][]byte, error) {
+	amount, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(fund.Amount)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "fund amount")
+	}
+
+	sdkMsg := &feepaytypes.MsgFundFeePayContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: fund.ContractAddress,
+		Amount:          amount,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgFundFeePayContract")
+	}
+
+	if _, err := m.feePay.FundFeePayContract(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "funding fee pay contract")
+	}
+	return nil, nil, nil
+}
+
+// updateFeePayContractWalletLimit changes the wallet limit of a contract administered by the calling contract.
+func (m *CustomMessenger) updateFeePayContractWalletLimit(ctx sdk.Context, contractAddr sdk.AccAddress, update *bindingstypes.UpdateFeePayContractWalletLimit) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feepaytypes.MsgUpdateFeePayContractWalletLimit{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: update.ContractAddress,
+		WalletLimit:     update.WalletLimit,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUpdateFeePayContractWalletLimit")
+	}
+
+	if _, err := m.feePay.UpdateFeePayContractWalletLimit(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "updating fee pay contract wallet limit")
+	}
+	return nil, nil, nil
+}
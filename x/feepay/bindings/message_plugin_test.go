@@ -0,0 +1,128 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	appparams "github.com/CosmosContracts/juno/v23/app/params"
+	"github.com/CosmosContracts/juno/v23/x/feepay/bindings"
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/feepay/bindings/types"
+)
+
+func setupCustomApp(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "testing", Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress([]byte("feepay-creator______"))
+	wasmCode, err := os.ReadFile("../keeper/testdata/clock_example.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	return junoApp, ctx, creator
+}
+
+// instantiateContract creates a contract administered by admin, or by the
+// contract itself when admin is nil.
+func instantiateContract(t *testing.T, ctx sdk.Context, junoApp *app.App, creator, admin sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	addr, _, err := contractKeeper.Instantiate(ctx, 1, creator, creator, []byte("{}"), "feepay contract", nil)
+	require.NoError(t, err)
+
+	if admin == nil {
+		admin = addr
+	}
+	require.NoError(t, contractKeeper.UpdateContractAdmin(ctx, addr, creator, admin))
+
+	return addr
+}
+
+func dispatch(t *testing.T, ctx sdk.Context, messenger wasmkeeper.Messenger, contract sdk.AccAddress, msg bindingstypes.FeePayMsg) error {
+	t.Helper()
+
+	customBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
+
+func TestFeePayMsgs(t *testing.T) {
+	junoApp, ctx, creator := setupCustomApp(t)
+	feePayKeeper := junoApp.AppKeepers.FeePayKeeper
+
+	var wrappedCalled bool
+	messenger := bindings.CustomMessageDecorator(&junoApp.AppKeepers.FeePayKeeper)(
+		wasmkeeper.MessageHandlerFunc(func(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+			wrappedCalled = true
+			return nil, nil, nil
+		}),
+	)
+
+	dao := instantiateContract(t, ctx, junoApp, creator, nil)
+	other := instantiateContract(t, ctx, junoApp, creator, creator)
+
+	// the calling contract only manages contracts it administers
+	err := dispatch(t, ctx, messenger, dao, bindingstypes.FeePayMsg{
+		RegisterFeePayContract: &bindingstypes.RegisterFeePayContract{ContractAddress: other.String(), WalletLimit: 1},
+	})
+	require.Error(t, err)
+	require.False(t, feePayKeeper.IsContractRegistered(ctx, other.String()))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeePayMsg{
+		RegisterFeePayContract: &bindingstypes.RegisterFeePayContract{ContractAddress: dao.String(), WalletLimit: 1},
+	})
+	require.NoError(t, err)
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeePayMsg{
+		UpdateFeePayContractWalletLimit: &bindingstypes.UpdateFeePayContractWalletLimit{ContractAddress: dao.String(), WalletLimit: 7},
+	})
+	require.NoError(t, err)
+
+	// the calling contract funds its own sponsorship
+	require.NoError(t, banktestutil.FundAccount(junoApp.AppKeepers.BankKeeper, ctx, dao, sdk.NewCoins(sdk.NewInt64Coin(appparams.BondDenom, 1_000))))
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeePayMsg{
+		FundFeePayContract: &bindingstypes.FundFeePayContract{
+			ContractAddress: dao.String(),
+			Amount:          wasmvmtypes.Coins{wasmvmtypes.NewCoin(400, appparams.BondDenom)},
+		},
+	})
+	require.NoError(t, err)
+
+	fpc, err := feePayKeeper.GetContract(ctx, dao.String())
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), fpc.WalletLimit)
+	require.Equal(t, uint64(400), fpc.Balance)
+	require.Equal(t, int64(600), junoApp.AppKeepers.BankKeeper.GetBalance(ctx, dao, appparams.BondDenom).Amount.Int64())
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeePayMsg{
+		UnregisterFeePayContract: &bindingstypes.UnregisterFeePayContract{ContractAddress: dao.String()},
+	})
+	require.NoError(t, err)
+	require.False(t, feePayKeeper.IsContractRegistered(ctx, dao.String()))
+	require.Equal(t, int64(1_000), junoApp.AppKeepers.BankKeeper.GetBalance(ctx, dao, appparams.BondDenom).Amount.Int64())
+
+	// other custom messages are left to the wrapped messenger
+	require.False(t, wrappedCalled)
+	_, _, err = messenger.DispatchMsg(ctx, dao, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"sun"}}`)})
+	require.NoError(t, err)
+	require.True(t, wrappedCalled)
+}
@@ -0,0 +1,39 @@
+package types
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+type FeePayMsg struct {
+	/// Contracts can register a contract they administer for fee sponsorship.
+	RegisterFeePayContract *RegisterFeePayContract `json:"register_fee_pay_contract,omitempty"`
+	/// Contracts can unregister a contract they administer, refunding its
+	/// remaining balance to the contract's admin.
+	UnregisterFeePayContract *UnregisterFeePayContract `json:"unregister_fee_pay_contract,omitempty"`
+	/// Contracts can fund the sponsorship balance of any registered contract
+	/// from their own balance.
+	FundFeePayContract *FundFeePayContract `json:"fund_fee_pay_contract,omitempty"`
+	/// Contracts can change the wallet limit of a contract they administer.
+	UpdateFeePayContractWalletLimit *UpdateFeePayContractWalletLimit `json:"update_fee_pay_contract_wallet_limit,omitempty"`
+}
+
+// RegisterFeePayContract registers ContractAddress for fee sponsorship, where
+// each wallet may be sponsored at most WalletLimit times.
+type RegisterFeePayContract struct {
+	ContractAddress string `json:"contract_address"`
+	WalletLimit     uint64 `json:"wallet_limit"`
+}
+
+type UnregisterFeePayContract struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+// FundFeePayContract moves Amount from the calling contract into the
+// sponsorship balance of ContractAddress.
+type FundFeePayContract struct {
+	ContractAddress string            `json:"contract_address"`
+	Amount          wasmvmtypes.Coins `json:"amount"`
+}
+
+type UpdateFeePayContractWalletLimit struct {
+	ContractAddress string `json:"contract_address"`
+	WalletLimit     uint64 `json:"wallet_limit"`
+}
@@ -0,0 +1,17 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	feepaykeeper "github.com/CosmosContracts/juno/v23/x/feepay/keeper"
+)
+
+func RegisterCustomPlugins(feePay *feepaykeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(feePay),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	EventTypeSponsorFee = "feepay_sponsor_fee"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyWallet   = "wallet"
+	AttributeKeyFee      = "fee"
+)
@@ -0,0 +1,105 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/feeshare/bindings/types"
+	feesharekeeper "github.com/CosmosContracts/juno/v23/x/feeshare/keeper"
+	feesharetypes "github.com/CosmosContracts/juno/v23/x/feeshare/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(feeShare *feesharekeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:  old,
+			feeShare: feeShare,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped  wasmkeeper.Messenger
+	feeShare *feesharekeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		// only handle the fee share messages, leave everything else for the wrapped version
+		var contractMsg bindingstypes.FeeShareMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "fee share msg")
+		}
+
+		if contractMsg.RegisterFeeShare != nil {
+			return m.registerFeeShare(ctx, contractAddr, contractMsg.RegisterFeeShare)
+		}
+		if contractMsg.UpdateFeeShare != nil {
+			return m.updateFeeShare(ctx, contractAddr, contractMsg.UpdateFeeShare)
+		}
+		if contractMsg.CancelFeeShare != nil {
+			return m.cancelFeeShare(ctx, contractAddr, contractMsg.CancelFeeShare)
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// registerFeeShare registers a contract administered by the calling contract for fee sharing.
+func (m *CustomMessenger) registerFeeShare(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterFeeShare) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feesharetypes.MsgRegisterFeeShare{
+		ContractAddress:   register.ContractAddress,
+		DeployerAddress:   contractAddr.String(),
+		WithdrawerAddress: register.WithdrawerAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgRegisterFeeShare")
+	}
+
+	if _, err := m.feeShare.RegisterFeeShare(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "registering fee share")
+	}
+	return nil, nil, nil
+}
+
+// updateFeeShare changes the withdrawer of a contract administered by the calling contract.
+func (m *CustomMessenger) updateFeeShare(ctx sdk.Context, contractAddr sdk.AccAddress, update *bindingstypes.UpdateFeeShare) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feesharetypes.MsgUpdateFeeShare{
+		ContractAddress:   update.ContractAddress,
+		DeployerAddress:   contractAddr.String(),
+		WithdrawerAddress: update.WithdrawerAddress,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgUpdateFeeShare")
+	}
+
+	if _, err := m.feeShare.UpdateFeeShare(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "updating fee share")
+	}
+	return nil, nil, nil
+}
+
+// cancelFeeShare stops fee sharing for a contract administered by the calling contract.
+func (m *CustomMessenger) cancelFeeShare(ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.CancelFeeShare) ([]sdk.Event, [][]byte, error) {
+	sdkMsg := &feesharetypes.MsgCancelFeeShare{
+		ContractAddress: cancel.ContractAddress,
+		DeployerAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed validating MsgCancelFeeShare")
+	}
+
+	if _, err := m.feeShare.CancelFeeShare(sdk.WrapSDKContext(ctx), sdkMsg); err != nil {
+		return nil, nil, errorsmod.Wrap(err, "canceling fee share")
+	}
+	return nil, nil, nil
+}
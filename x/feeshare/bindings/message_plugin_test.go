@@ -0,0 +1,121 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/feeshare/bindings"
+	bindingstypes "github.com/CosmosContracts/juno/v23/x/feeshare/bindings/types"
+)
+
+func setupCustomApp(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+
+	junoApp := app.Setup(t)
+	ctx := junoApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "testing", Time: time.Now().UTC()})
+
+	creator := sdk.AccAddress([]byte("feeshare-creator____"))
+	wasmCode, err := os.ReadFile("../keeper/testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	_, _, err = contractKeeper.Create(ctx, creator, wasmCode, nil)
+	require.NoError(t, err)
+
+	return junoApp, ctx, creator
+}
+
+// instantiateContract creates a contract administered by admin, or by the
+// contract itself when admin is nil.
+func instantiateContract(t *testing.T, ctx sdk.Context, junoApp *app.App, creator, admin sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(junoApp.AppKeepers.WasmKeeper)
+	addr, _, err := contractKeeper.Instantiate(ctx, 1, creator, creator, []byte("{}"), "feeshare contract", nil)
+	require.NoError(t, err)
+
+	if admin == nil {
+		admin = addr
+	}
+	require.NoError(t, contractKeeper.UpdateContractAdmin(ctx, addr, creator, admin))
+
+	return addr
+}
+
+func dispatch(t *testing.T, ctx sdk.Context, messenger wasmkeeper.Messenger, contract sdk.AccAddress, msg bindingstypes.FeeShareMsg) error {
+	t.Helper()
+
+	customBz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
+
+func TestFeeShareMsgs(t *testing.T) {
+	junoApp, ctx, creator := setupCustomApp(t)
+	feeShareKeeper := junoApp.AppKeepers.FeeShareKeeper
+
+	var wrappedCalled bool
+	messenger := bindings.CustomMessageDecorator(&junoApp.AppKeepers.FeeShareKeeper)(
+		wasmkeeper.MessageHandlerFunc(func(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+			wrappedCalled = true
+			return nil, nil, nil
+		}),
+	)
+
+	dao := instantiateContract(t, ctx, junoApp, creator, nil)
+	other := instantiateContract(t, ctx, junoApp, creator, creator)
+	withdrawer := sdk.AccAddress([]byte("feeshare-withdrawer_"))
+	treasury := sdk.AccAddress([]byte("feeshare-treasury___"))
+
+	// the calling contract only manages contracts it administers
+	err := dispatch(t, ctx, messenger, dao, bindingstypes.FeeShareMsg{
+		RegisterFeeShare: &bindingstypes.RegisterFeeShare{ContractAddress: other.String(), WithdrawerAddress: withdrawer.String()},
+	})
+	require.Error(t, err)
+	require.False(t, feeShareKeeper.IsFeeShareRegistered(ctx, other))
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeeShareMsg{
+		RegisterFeeShare: &bindingstypes.RegisterFeeShare{ContractAddress: dao.String(), WithdrawerAddress: withdrawer.String()},
+	})
+	require.NoError(t, err)
+
+	feeShare, found := feeShareKeeper.GetFeeShare(ctx, dao)
+	require.True(t, found)
+	require.Equal(t, dao.String(), feeShare.DeployerAddress)
+	require.Equal(t, withdrawer.String(), feeShare.WithdrawerAddress)
+
+	// the calling contract rotates its withdrawer
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeeShareMsg{
+		UpdateFeeShare: &bindingstypes.UpdateFeeShare{ContractAddress: dao.String(), WithdrawerAddress: treasury.String()},
+	})
+	require.NoError(t, err)
+
+	feeShare, found = feeShareKeeper.GetFeeShare(ctx, dao)
+	require.True(t, found)
+	require.Equal(t, treasury.String(), feeShare.WithdrawerAddress)
+
+	err = dispatch(t, ctx, messenger, dao, bindingstypes.FeeShareMsg{
+		CancelFeeShare: &bindingstypes.CancelFeeShare{ContractAddress: dao.String()},
+	})
+	require.NoError(t, err)
+	require.False(t, feeShareKeeper.IsFeeShareRegistered(ctx, dao))
+
+	// other custom messages are left to the wrapped messenger
+	require.False(t, wrappedCalled)
+	_, _, err = messenger.DispatchMsg(ctx, dao, "", wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"sun"}}`)})
+	require.NoError(t, err)
+	require.True(t, wrappedCalled)
+}
@@ -0,0 +1,25 @@
+package types
+
+type FeeShareMsg struct {
+	/// Contracts can register a contract they administer to share its
+	/// transaction fees with a withdrawer.
+	RegisterFeeShare *RegisterFeeShare `json:"register_fee_share,omitempty"`
+	/// Contracts can change the withdrawer of a contract they administer.
+	UpdateFeeShare *UpdateFeeShare `json:"update_fee_share,omitempty"`
+	/// Contracts can stop sharing the fees of a contract they administer.
+	CancelFeeShare *CancelFeeShare `json:"cancel_fee_share,omitempty"`
+}
+
+type RegisterFeeShare struct {
+	ContractAddress   string `json:"contract_address"`
+	WithdrawerAddress string `json:"withdrawer_address"`
+}
+
+type UpdateFeeShare struct {
+	ContractAddress   string `json:"contract_address"`
+	WithdrawerAddress string `json:"withdrawer_address"`
+}
+
+type CancelFeeShare struct {
+	ContractAddress string `json:"contract_address"`
+}
@@ -0,0 +1,17 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	feesharekeeper "github.com/CosmosContracts/juno/v23/x/feeshare/keeper"
+)
+
+func RegisterCustomPlugins(feeShare *feesharekeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(feeShare),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
@@ -14,6 +14,7 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -78,7 +79,7 @@ func GenApp(t *testing.T, withGenesis bool, opts ...wasmkeeper.Option) (*junoapp
 		db,
 		nil,
 		true,
-		simtestutil.EmptyAppOptions{},
+		simtestutil.AppOptionsMap{flags.FlagHome: nodeHome},
 		opts,
 		bam.SetChainID("testing"),
 		bam.SetSnapshot(snapshotStore, snapshottypes.SnapshotOptions{KeepRecent: 2}),
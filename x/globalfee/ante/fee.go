@@ -18,8 +18,9 @@ import (
 // as the local validator's minimum gasFee (defined in validator config) and global fee, and the fee denom should be in the global fees' denoms.
 //
 // If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true. If fee is high enough or not
-// CheckTx, then call next AnteHandler.
+// In CheckTx both the local and the global minimum fees are checked. In DeliverTx
+// only the global minimum fee is checked, and only when the EnforceInDeliverTx
+// param is enabled. If fee is high enough, then call next AnteHandler.
 //
 // CONTRACT: Tx must implement FeeTx to use FeeDecorator
 // If the tx msg type is one of the bypass msg types, the tx is valid even if the min fee is lower than normally required.
@@ -52,24 +53,40 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
 	}
 
-	// Call next handler if the execution mode is CheckTx, simulation, or if the tx is a fee pay tx
-	if !ctx.IsCheckTx() || simulate || *mfd.IsFeePayTx {
+	// Call next handler if the execution mode is simulation, or if the tx is a fee pay tx
+	if simulate || *mfd.IsFeePayTx {
 		return next(ctx, tx, simulate)
 	}
 
+	// In DeliverTx, state reads do not consume gas so that the gas used stays
+	// consistent with the gas estimated during simulation. Call next handler if
+	// the global fee is not enforced on chain. Gentxs (block height 0) are never
+	// checked.
+	feeCtx := ctx
+	if !ctx.IsCheckTx() {
+		feeCtx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		if ctx.BlockHeight() == 0 || !mfd.GlobalFeeKeeper.GetParams(feeCtx).EnforceInDeliverTx {
+			return next(ctx, tx, simulate)
+		}
+	}
+
 	// Sort fee tx's coins, zero coins in feeCoins are already removed
 	feeCoins := feeTx.GetFee().Sort()
 	gas := feeTx.GetGas()
 	msgs := feeTx.GetMsgs()
 
 	// Get required Global Fee
-	requiredGlobalFees, err := mfd.GetGlobalFee(ctx, feeTx)
+	requiredGlobalFees, err := mfd.GetGlobalFee(feeCtx, feeTx)
 	if err != nil {
 		return ctx, err
 	}
 
-	// Get local minimum-gas-prices
-	localFees := GetMinGasPrice(ctx, int64(feeTx.GetGas()))
+	// Get local minimum-gas-prices. These are validator specific and must
+	// never be applied outside of CheckTx.
+	localFees := sdk.Coins{}
+	if ctx.IsCheckTx() {
+		localFees = GetMinGasPrice(ctx, int64(feeTx.GetGas()))
+	}
 
 	// CombinedFeeRequirement should never be empty since
 	// global fee is set to its default value, i.e. 0uatom, if empty
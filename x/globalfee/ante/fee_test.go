@@ -0,0 +1,200 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	protov2 "google.golang.org/protobuf/proto"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/globalfee/ante"
+	"github.com/CosmosContracts/juno/v23/x/globalfee/types"
+)
+
+// Define an empty ante handle
+var (
+	EmptyAnte = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+)
+
+type FeeTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.App
+}
+
+func (s *FeeTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID: "testing",
+		Height:  10,
+		Time:    time.Now().UTC(),
+	})
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
+
+func (s *FeeTestSuite) TestEnforceInDeliverTx() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	sendMsg := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(1))))
+	bypassMsg := &ibcchanneltypes.MsgRecvPacket{Signer: sender.String()}
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(75, 3)))
+
+	testCases := []struct {
+		name       string
+		enforce    bool
+		checkTx    bool
+		height     int64
+		isFeePayTx bool
+		tx         MockTx
+		expErr     bool
+	}{
+		{
+			name:   "deliver tx, not enforced, zero fee",
+			tx:     NewMockTx(nil, sendMsg),
+			height: 10,
+		},
+		{
+			name:    "deliver tx, enforced, zero fee",
+			enforce: true,
+			tx:      NewMockTx(nil, sendMsg),
+			height:  10,
+			expErr:  true,
+		},
+		{
+			name:    "deliver tx, enforced, insufficient fee",
+			enforce: true,
+			tx:      NewMockTx(sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(14_999))), sendMsg),
+			height:  10,
+			expErr:  true,
+		},
+		{
+			name:    "deliver tx, enforced, wrong denom",
+			enforce: true,
+			tx:      NewMockTx(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(15_000))), sendMsg),
+			height:  10,
+			expErr:  true,
+		},
+		{
+			name:    "deliver tx, enforced, sufficient fee",
+			enforce: true,
+			tx:      NewMockTx(sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewInt(15_000))), sendMsg),
+			height:  10,
+		},
+		{
+			name:    "deliver tx, enforced, bypass msg with zero fee",
+			enforce: true,
+			tx:      NewMockTx(nil, bypassMsg),
+			height:  10,
+		},
+		{
+			name:       "deliver tx, enforced, fee pay tx with zero fee",
+			enforce:    true,
+			isFeePayTx: true,
+			tx:         NewMockTx(nil, sendMsg),
+			height:     10,
+		},
+		{
+			name:    "deliver tx, enforced, genesis tx with zero fee",
+			enforce: true,
+			tx:      NewMockTx(nil, sendMsg),
+			height:  0,
+		},
+		{
+			name:    "check tx, not enforced, zero fee",
+			checkTx: true,
+			tx:      NewMockTx(nil, sendMsg),
+			height:  10,
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			err := s.app.AppKeepers.GlobalFeeKeeper.SetParams(s.ctx, types.Params{
+				MinimumGasPrices:   minGasPrices,
+				EnforceInDeliverTx: tc.enforce,
+			})
+			s.Require().NoError(err)
+
+			ctx := s.ctx.WithIsCheckTx(tc.checkTx).WithBlockHeight(tc.height)
+
+			isFeePayTx := tc.isFeePayTx
+			decorator := ante.NewFeeDecorator(
+				app.GetDefaultBypassFeeMessages(),
+				s.app.AppKeepers.GlobalFeeKeeper,
+				*s.app.AppKeepers.StakingKeeper,
+				2_000_000,
+				&isFeePayTx,
+			)
+
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err = decorator.AnteHandle(ctx, tc.tx, false, EmptyAnte)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			if !tc.checkTx {
+				s.Require().Equal(gasBefore, ctx.GasMeter().GasConsumed())
+			}
+		})
+	}
+}
+
+type MockTx struct {
+	fee  sdk.Coins
+	msgs []sdk.Msg
+}
+
+func NewMockTx(fee sdk.Coins, msgs ...sdk.Msg) MockTx {
+	return MockTx{
+		fee:  fee,
+		msgs: msgs,
+	}
+}
+
+func (tx MockTx) GetGas() uint64 {
+	return 200000
+}
+
+func (tx MockTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx MockTx) FeePayer() sdk.AccAddress {
+	return nil
+}
+
+func (tx MockTx) FeeGranter() sdk.AccAddress {
+	return nil
+}
+
+func (tx MockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx MockTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+func (tx MockTx) ValidateBasic() error {
+	return nil
+}
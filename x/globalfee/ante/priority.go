@@ -0,0 +1,63 @@
+package ante
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriorityScale is the number of priority units per bond denom unit of gas
+// price, so that fractional gas prices such as 0.0025ujuno still order.
+const PriorityScale = 1_000_000
+
+// GetTxPriority returns the priority of a tx paying fee for gas, which is its
+// gas price in the bond denom scaled by PriorityScale.
+//
+// Every fee denom is converted into the bond denom at the ratio of the global
+// fee minimum gas prices, so the same value paid in any accepted denom earns
+// the same priority. Denoms without a global fee price are not counted, so a
+// worthless denom can not jump the queue. The priority only depends on chain
+// state and not on the validator's local minimum gas prices, which keeps the
+// ordering identical between CheckTx and block building on every node.
+func GetTxPriority(fee sdk.Coins, gas uint64, gasPrices sdk.DecCoins, bondDenom string) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	value := BondDenomValue(fee, gasPrices, bondDenom)
+	if !value.IsPositive() {
+		return 0
+	}
+
+	priority := value.MulInt64(PriorityScale).Quo(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gas))).TruncateInt()
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
+
+// BondDenomValue returns the value of coins in the bond denom, converting the
+// other denoms at the ratio of their gas price to the bond denom gas price.
+func BondDenomValue(coins sdk.Coins, gasPrices sdk.DecCoins, bondDenom string) sdkmath.LegacyDec {
+	value := sdkmath.LegacyZeroDec()
+	bondPrice := gasPrices.AmountOf(bondDenom)
+
+	for _, c := range coins {
+		if c.Denom == bondDenom {
+			value = value.Add(sdkmath.LegacyNewDecFromInt(c.Amount))
+			continue
+		}
+
+		price := gasPrices.AmountOf(c.Denom)
+		if !price.IsPositive() || !bondPrice.IsPositive() {
+			continue
+		}
+
+		value = value.Add(sdkmath.LegacyNewDecFromInt(c.Amount).Mul(bondPrice).Quo(price))
+	}
+
+	return value
+}
@@ -0,0 +1,89 @@
+package ante
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetTxPriority(t *testing.T) {
+	gasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ujuno", sdk.NewDecWithPrec(75, 3)),
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 3)),
+	)
+
+	tests := []struct {
+		name      string
+		fee       sdk.Coins
+		gas       uint64
+		gasPrices sdk.DecCoins
+		priority  int64
+	}{
+		{
+			name:      "bond denom fee",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15_000)),
+			gas:       200_000,
+			gasPrices: gasPrices,
+			priority:  75_000,
+		},
+		{
+			name:      "other denom fee is normalized to the bond denom",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("uatom", 5_000)),
+			gas:       200_000,
+			gasPrices: gasPrices,
+			priority:  75_000,
+		},
+		{
+			name:      "multi denom fee adds up",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15_000), sdk.NewInt64Coin("uatom", 5_000)),
+			gas:       200_000,
+			gasPrices: gasPrices,
+			priority:  150_000,
+		},
+		{
+			name:      "unpriced denom is not counted",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15_000), sdk.NewInt64Coin("uspam", 1_000_000_000)),
+			gas:       200_000,
+			gasPrices: gasPrices,
+			priority:  75_000,
+		},
+		{
+			name:      "only the bond denom counts without a bond denom price",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15_000), sdk.NewInt64Coin("uatom", 5_000)),
+			gas:       200_000,
+			gasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 3))),
+			priority:  75_000,
+		},
+		{
+			name:      "zero fee",
+			fee:       sdk.Coins{},
+			gas:       200_000,
+			gasPrices: gasPrices,
+			priority:  0,
+		},
+		{
+			name:      "zero gas",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("ujuno", 15_000)),
+			gas:       0,
+			gasPrices: gasPrices,
+			priority:  0,
+		},
+		{
+			name:      "overflow is capped",
+			fee:       sdk.NewCoins(sdk.NewCoin("ujuno", sdk.NewIntFromUint64(math.MaxUint64))),
+			gas:       1,
+			gasPrices: gasPrices,
+			priority:  math.MaxInt64,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.priority, GetTxPriority(tc.fee, tc.gas, tc.gasPrices, "ujuno"))
+		})
+	}
+}
@@ -23,7 +23,7 @@ import (
 func TestDefaultGenesis(t *testing.T) {
 	encCfg := appparams.MakeEncodingConfig()
 	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
-	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[]}}`, string(gotJSON), string(gotJSON))
+	assert.JSONEq(t, `{"params":{"minimum_gas_prices":[],"enforce_in_deliver_tx":false}}`, string(gotJSON), string(gotJSON))
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -33,3 +33,12 @@ func (g GrpcQuerier) MinimumGasPrices(stdCtx context.Context, _ *types.QueryMini
 		MinimumGasPrices: minGasPrices,
 	}, nil
 }
+
+// Params returns the total set of globalfee parameters
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: g.keeper.GetParams(ctx),
+	}, nil
+}
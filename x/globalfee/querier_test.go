@@ -58,3 +58,18 @@ func TestQueryMinimumGasPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryParams(t *testing.T) {
+	ctx, _, keeper := setupTestStore(t)
+	params := types.Params{
+		MinimumGasPrices:   sdk.NewDecCoins(sdk.NewDecCoin("ALX", sdk.OneInt())),
+		EnforceInDeliverTx: true,
+	}
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	q := NewGrpcQuerier(keeper)
+	gotResp, gotErr := q.Params(sdk.WrapSDKContext(ctx), nil)
+	require.NoError(t, gotErr)
+	require.NotNil(t, gotResp)
+	assert.Equal(t, params, gotResp.Params)
+}
@@ -79,6 +79,12 @@ type Params struct {
 	// values allowed. For more information see
 	// https://docs.cosmos.network/main/modules/auth#concepts
 	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices,omitempty" yaml:"minimum_gas_prices"`
+	// EnforceInDeliverTx enables the global minimum fee checks during
+	// DeliverTx. When disabled, the minimum fees are only checked in CheckTx,
+	// which allows a block proposer to include zero-fee transactions from its
+	// own mempool. The validator's local minimum gas prices are never applied
+	// in DeliverTx.
+	EnforceInDeliverTx bool `protobuf:"varint,2,opt,name=enforce_in_deliver_tx,json=enforceInDeliverTx,proto3" json:"enforce_in_deliver_tx,omitempty" yaml:"enforce_in_deliver_tx"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -121,6 +127,13 @@ func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoi
 	return nil
 }
 
+func (m *Params) GetEnforceInDeliverTx() bool {
+	if m != nil {
+		return m.EnforceInDeliverTx
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gaia.globalfee.v1beta1.GenesisState")
 	proto.RegisterType((*Params)(nil), "gaia.globalfee.v1beta1.Params")
@@ -131,28 +144,31 @@ func init() {
 }
 
 var fileDescriptor_015b3e8b7a7c65c5 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0x08, 0x1d, 0xae, 0x0e, 0xe5, 0x10, 0xa9, 0xa5, 0xe4, 0xe4, 0x70, 0x28, 0xa8,
-	0x09, 0xad, 0x9b, 0xe3, 0x29, 0x74, 0x2d, 0x75, 0x73, 0xa9, 0xb9, 0x33, 0xc6, 0x60, 0x73, 0x09,
-	0x4d, 0x2a, 0xf6, 0x5b, 0xf8, 0x39, 0xfc, 0x0c, 0xee, 0x76, 0xec, 0xe8, 0x54, 0xe5, 0x6e, 0x73,
-	0xf4, 0x13, 0xc8, 0x25, 0x67, 0x2b, 0x9c, 0x53, 0x02, 0xef, 0xf7, 0x7e, 0xff, 0xc7, 0x7b, 0xfe,
-	0x11, 0x23, 0x9c, 0x60, 0x36, 0x95, 0x09, 0x99, 0xde, 0x51, 0x8a, 0x1f, 0xfb, 0x09, 0x35, 0xa4,
-	0x8f, 0x19, 0xcd, 0xa8, 0xe6, 0x1a, 0xa9, 0x99, 0x34, 0x32, 0xd8, 0x2f, 0x29, 0xb4, 0xa1, 0x50,
-	0x45, 0x75, 0xf6, 0x98, 0x64, 0xd2, 0x22, 0xb8, 0xfc, 0x39, 0xba, 0x03, 0x53, 0xa9, 0x85, 0xd4,
-	0x38, 0x21, 0x7a, 0x2b, 0x4c, 0x25, 0xcf, 0x5c, 0x3d, 0xba, 0xf1, 0x77, 0x87, 0x4e, 0x7f, 0x65,
-	0x88, 0xa1, 0xc1, 0xc8, 0x6f, 0x28, 0x32, 0x23, 0x42, 0xb7, 0xc1, 0x21, 0xe8, 0x35, 0x07, 0x10,
-	0xfd, 0x1f, 0x87, 0x46, 0x96, 0x8a, 0xdb, 0xcb, 0x75, 0xe8, 0x7d, 0xad, 0xc3, 0x96, 0xeb, 0x3a,
-	0x91, 0x82, 0x1b, 0x2a, 0x94, 0x59, 0x8c, 0x2b, 0x4f, 0xf4, 0x06, 0xfc, 0x86, 0x83, 0x83, 0x57,
-	0xe0, 0x07, 0x82, 0x67, 0x5c, 0xcc, 0xc5, 0x84, 0x11, 0x3d, 0x51, 0x33, 0x9e, 0xd2, 0x32, 0x69,
-	0xa7, 0xd7, 0x1c, 0x74, 0x91, 0x1b, 0x15, 0x95, 0xa3, 0x6e, 0x62, 0x2e, 0x69, 0x7a, 0x21, 0x79,
-	0x16, 0xab, 0x2a, 0xa7, 0x5b, 0xef, 0xdf, 0x66, 0x7e, 0xaf, 0xc3, 0x83, 0x05, 0x11, 0xd3, 0xf3,
-	0xa8, 0x4e, 0x45, 0x2f, 0x1f, 0xe1, 0x31, 0xe3, 0xe6, 0x7e, 0x9e, 0xa0, 0x54, 0x0a, 0x5c, 0xed,
-	0xc5, 0x3d, 0xa7, 0xfa, 0xf6, 0x01, 0x9b, 0x85, 0xa2, 0xfa, 0x37, 0x50, 0x8f, 0x5b, 0x95, 0x63,
-	0x48, 0xf4, 0xc8, 0x1a, 0xe2, 0x78, 0x99, 0x43, 0xb0, 0xca, 0x21, 0xf8, 0xcc, 0x21, 0x78, 0x2e,
-	0xa0, 0xb7, 0x2a, 0xa0, 0xf7, 0x5e, 0x40, 0xef, 0xba, 0x57, 0x17, 0xdb, 0x5b, 0x3e, 0xfd, 0xb9,
-	0xa6, 0xd5, 0x27, 0x0d, 0xbb, 0xf6, 0xb3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x6d, 0x01,
-	0xcd, 0xec, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6a, 0xea, 0x40,
+	0x18, 0xc7, 0x13, 0x1f, 0xc8, 0x23, 0xbe, 0x85, 0x84, 0xf7, 0x1e, 0x56, 0x64, 0x22, 0xa1, 0x8b,
+	0x40, 0xdb, 0x09, 0xda, 0x5d, 0x97, 0xa9, 0x20, 0xdd, 0x89, 0x76, 0xd5, 0x4d, 0x3a, 0x89, 0x63,
+	0x3a, 0x34, 0x33, 0x13, 0x32, 0xa3, 0xe8, 0x2d, 0x7a, 0x80, 0x9e, 0xa0, 0x67, 0xe8, 0x01, 0x5c,
+	0xba, 0xec, 0x2a, 0x2d, 0xba, 0xeb, 0xd2, 0x13, 0x94, 0x64, 0x52, 0x2d, 0xe8, 0x2a, 0x61, 0xf2,
+	0xfb, 0xfe, 0xbf, 0x3f, 0x99, 0xcf, 0x38, 0x8d, 0x10, 0x41, 0x6e, 0x14, 0xf3, 0x00, 0xc5, 0x13,
+	0x8c, 0xdd, 0x59, 0x27, 0xc0, 0x12, 0x75, 0xdc, 0x08, 0x33, 0x2c, 0x88, 0x80, 0x49, 0xca, 0x25,
+	0x37, 0xff, 0xe7, 0x14, 0xdc, 0x51, 0xb0, 0xa4, 0x9a, 0x7f, 0x23, 0x1e, 0xf1, 0x02, 0x71, 0xf3,
+	0x37, 0x45, 0x37, 0x41, 0xc8, 0x05, 0xe5, 0xc2, 0x0d, 0x90, 0xd8, 0x07, 0x86, 0x9c, 0x30, 0xf5,
+	0xdd, 0xbe, 0x37, 0xfe, 0xf4, 0x55, 0xfc, 0x48, 0x22, 0x89, 0xcd, 0x81, 0x51, 0x4d, 0x50, 0x8a,
+	0xa8, 0x68, 0xe8, 0x6d, 0xdd, 0xa9, 0x75, 0x01, 0x3c, 0xae, 0x83, 0x83, 0x82, 0xf2, 0x1a, 0xcb,
+	0xcc, 0xd2, 0x3e, 0x33, 0xab, 0xae, 0xa6, 0xce, 0x39, 0x25, 0x12, 0xd3, 0x44, 0x2e, 0x86, 0x65,
+	0x8e, 0xfd, 0x5c, 0x31, 0xaa, 0x0a, 0x36, 0x5f, 0x75, 0xc3, 0xa4, 0x84, 0x11, 0x3a, 0xa5, 0x7e,
+	0x84, 0x84, 0x9f, 0xa4, 0x24, 0xc4, 0xb9, 0xe9, 0x97, 0x53, 0xeb, 0xb6, 0xa0, 0xaa, 0x0a, 0xf3,
+	0xaa, 0x3b, 0x4d, 0x0f, 0x87, 0xd7, 0x9c, 0x30, 0x2f, 0x29, 0x3d, 0xad, 0xc3, 0xf9, 0xbd, 0x73,
+	0x9b, 0x59, 0x27, 0x0b, 0x44, 0xe3, 0x2b, 0xfb, 0x90, 0xb2, 0x5f, 0xde, 0xad, 0xb3, 0x88, 0xc8,
+	0x87, 0x69, 0x00, 0x43, 0x4e, 0xdd, 0xf2, 0xbf, 0xa8, 0xc7, 0x85, 0x18, 0x3f, 0xba, 0x72, 0x91,
+	0x60, 0xf1, 0x2d, 0x14, 0xc3, 0x7a, 0x99, 0xd1, 0x47, 0x62, 0x50, 0x24, 0x98, 0x23, 0xe3, 0x1f,
+	0x66, 0x13, 0x9e, 0x86, 0xd8, 0x27, 0xcc, 0x1f, 0xe3, 0x98, 0xcc, 0x70, 0xea, 0xcb, 0x79, 0xa3,
+	0xd2, 0xd6, 0x9d, 0xdf, 0x5e, 0x7b, 0x9b, 0x59, 0x2d, 0xa5, 0x3f, 0x8a, 0xd9, 0x43, 0xb3, 0x3c,
+	0xbf, 0x61, 0x3d, 0x75, 0x7a, 0x3b, 0xf7, 0xbc, 0xe5, 0x1a, 0xe8, 0xab, 0x35, 0xd0, 0x3f, 0xd6,
+	0x40, 0x7f, 0xda, 0x00, 0x6d, 0xb5, 0x01, 0xda, 0xdb, 0x06, 0x68, 0x77, 0xce, 0x61, 0xdb, 0x62,
+	0x41, 0xe6, 0x3f, 0x56, 0xa4, 0xe8, 0x1c, 0x54, 0x8b, 0xbb, 0xbc, 0xfc, 0x1a, 0x00, 0x0c, 0x45,
+	0xc3, 0x89, 0x41, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -208,6 +224,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnforceInDeliverTx {
+		i--
+		if m.EnforceInDeliverTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.MinimumGasPrices) > 0 {
 		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -259,6 +285,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.EnforceInDeliverTx {
+		n += 2
+	}
 	return n
 }
 
@@ -414,6 +443,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceInDeliverTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceInDeliverTx = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
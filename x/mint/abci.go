@@ -17,13 +17,20 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// fetch stored minter
 	minter := k.GetMinter(ctx)
 
-	// inflation phase end
-	if minter.Inflation.Equal(sdk.ZeroDec()) {
+	// fetch stored params
+	params := k.GetParams(ctx)
+
+	// the previous block time is recorded even if time-based provisioning is
+	// disabled, so that it can be enabled at any time
+	elapsed := minter.BlockElapsedTime(params, ctx.BlockTime())
+	minter.PreviousBlockTime = ctx.BlockTime()
+	k.SetMinter(ctx, minter)
+
+	// inflation schedule end, unless governance extended the schedule
+	if minter.Inflation.IsZero() && minter.Phase > uint64(len(params.PhaseInflationRates)) {
 		return
 	}
 
-	// fetch stored params
-	params := k.GetParams(ctx)
 	currentBlock := uint64(ctx.BlockHeight())
 
 	// fetch current total supply
@@ -34,7 +41,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	if nextPhase != minter.Phase {
 		// store new inflation rate by phase
-		newInflation := minter.PhaseInflationRate(nextPhase)
+		newInflation := minter.PhaseInflationRate(params, nextPhase)
 		minter.Inflation = newInflation
 		minter.Phase = nextPhase
 		minter.StartPhaseBlock = currentBlock
@@ -49,7 +56,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params, totalSupply)
+	var mintedCoin sdk.Coin
+	if params.TimeBasedProvisioning {
+		mintedCoin = minter.TimeBlockProvision(params, totalSupply, elapsed)
+	} else {
+		mintedCoin = minter.BlockProvision(params, totalSupply)
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -57,8 +69,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// distribute the minted coins to stakers, the community pool and the
+	// weighted addresses
+	err = k.DistributeMintedCoin(ctx, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
@@ -26,6 +26,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmqQueryTargetSupply(),
+		GetCmdQueryInflationSchedule(),
+		GetCmdQueryProjectedSupply(),
+		GetCmdQueryTokenomics(),
+		GetCmdQueryContractBurn(),
+		GetCmdQueryContractBurns(),
 	)
 
 	return mintingQueryCmd
@@ -145,3 +150,154 @@ func GetCmqQueryTargetSupply() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryInflationSchedule implements a command to return the inflation
+// rate of every phase.
+func GetCmdQueryInflationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-schedule",
+		Short: "Query the inflation rate of every phase",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryInflationScheduleRequest{}
+			res, err := queryClient.InflationSchedule(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProjectedSupply implements a command to return the projected
+// supply at the end of the current and future phases.
+func GetCmdQueryProjectedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-supply",
+		Short: "Query the projected supply at the end of the current and future phases",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProjectedSupplyRequest{}
+			res, err := queryClient.ProjectedSupply(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenomics implements a command to return the total burned amount,
+// the current phase, the target supply and the progress of the current phase.
+func GetCmdQueryTokenomics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenomics",
+		Short: "Query the total burned amount, current phase, target supply and phase progress",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTokenomicsRequest{}
+			res, err := queryClient.Tokenomics(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractBurn implements a command to return the cumulative amount
+// burned by a contract.
+func GetCmdQueryContractBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-burn [contract-address]",
+		Short: "Query the cumulative amount burned by a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryContractBurnRequest{ContractAddress: args[0]}
+			res, err := queryClient.ContractBurn(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContractBurns implements a command to return the cumulative
+// amounts burned by all contracts.
+func GetCmdQueryContractBurns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-burns",
+		Short: "Query the cumulative amounts burned by all contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryContractBurnsRequest{Pagination: pageReq}
+			res, err := queryClient.ContractBurns(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-burns")
+
+	return cmd
+}
@@ -14,11 +14,22 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 		panic(err)
 	}
 	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, coin := range data.TotalBurned {
+		keeper.SetTotalBurned(ctx, coin)
+	}
+
+	for _, burn := range data.ContractBurns {
+		keeper.SetContractBurn(ctx, burn)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+	genesis := types.NewGenesisState(minter, params)
+	genesis.TotalBurned = keeper.GetTotalBurned(ctx)
+	genesis.ContractBurns = keeper.GetAllContractBurns(ctx)
+	return genesis
 }
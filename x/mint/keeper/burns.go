@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+// GetTotalBurned returns the cumulative amount burned by contracts.
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalBurnedKeyPrefix)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		denom := string(iterator.Key()[len(types.TotalBurnedKeyPrefix):])
+		burned = burned.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return burned
+}
+
+// GetTotalBurnedAmount returns the cumulative amount of a denom burned by
+// contracts.
+func (k Keeper) GetTotalBurnedAmount(ctx sdk.Context, denom string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalBurnedKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// SetTotalBurned sets the cumulative amount of a denom burned by contracts.
+func (k Keeper) SetTotalBurned(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalBurnedKeyPrefix)
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(coin.Denom), bz)
+}
+
+// GetContractBurn returns the cumulative amount burned by a contract.
+func (k Keeper) GetContractBurn(ctx sdk.Context, contract sdk.AccAddress) types.ContractBurn {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractBurnKeyPrefix)
+	bz := store.Get(contract)
+	if bz == nil {
+		return types.ContractBurn{
+			ContractAddress: contract.String(),
+			Amount:          sdk.NewCoins(),
+		}
+	}
+
+	var burn types.ContractBurn
+	k.cdc.MustUnmarshal(bz, &burn)
+	return burn
+}
+
+// SetContractBurn stores the cumulative amount burned by a contract.
+func (k Keeper) SetContractBurn(ctx sdk.Context, burn types.ContractBurn) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractBurnKeyPrefix)
+	bz := k.cdc.MustMarshal(&burn)
+	store.Set(sdk.MustAccAddressFromBech32(burn.ContractAddress), bz)
+}
+
+// GetAllContractBurns returns the cumulative amounts burned by all contracts.
+func (k Keeper) GetAllContractBurns(ctx sdk.Context) []types.ContractBurn {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ContractBurnKeyPrefix)
+	defer iterator.Close()
+
+	burns := []types.ContractBurn{}
+	for ; iterator.Valid(); iterator.Next() {
+		var burn types.ContractBurn
+		k.cdc.MustUnmarshal(iterator.Value(), &burn)
+		burns = append(burns, burn)
+	}
+
+	return burns
+}
+
+// GetContractBurns returns a page of the cumulative amounts burned by all
+// contracts.
+func (k Keeper) GetContractBurns(ctx sdk.Context, pag *query.PageRequest) ([]types.ContractBurn, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractBurnKeyPrefix)
+
+	burns := []types.ContractBurn{}
+	pageRes, err := query.Paginate(store, pag, func(_, value []byte) error {
+		var burn types.ContractBurn
+		if err := k.cdc.Unmarshal(value, &burn); err != nil {
+			return err
+		}
+
+		burns = append(burns, burn)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return burns, pageRes, nil
+}
+
+// AddTotalBurned adds amount to the cumulative burned amounts.
+func (k Keeper) AddTotalBurned(ctx sdk.Context, amount sdk.Coins) {
+	for _, coin := range amount {
+		k.SetTotalBurned(ctx, sdk.NewCoin(coin.Denom, k.GetTotalBurnedAmount(ctx, coin.Denom).Add(coin.Amount)))
+	}
+}
+
+// RecordBurn adds the amount burned by a contract to the cumulative burned
+// amounts and emits a burn event.
+func (k Keeper) RecordBurn(ctx sdk.Context, contract sdk.AccAddress, amount sdk.Coins) error {
+	k.AddTotalBurned(ctx, amount)
+
+	burn := k.GetContractBurn(ctx, contract)
+	burn.Amount = burn.Amount.Add(amount...)
+	k.SetContractBurn(ctx, burn)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Burner: contract.String(),
+		Amount: amount,
+	})
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CosmosContracts/juno/v23/x/mint/types"
@@ -41,3 +44,81 @@ func (k Keeper) TargetSupply(c context.Context, _ *types.QueryTargetSupplyReques
 
 	return &types.QueryTargetSupplyResponse{TargetSupply: minter.TargetSupply}, nil
 }
+
+// InflationSchedule returns the inflation rate of every phase of the mint
+// module.
+func (k Keeper) InflationSchedule(c context.Context, _ *types.QueryInflationScheduleRequest) (*types.QueryInflationScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	phases := make([]types.PhaseInflation, 0, len(params.PhaseInflationRates))
+	for i, rate := range params.PhaseInflationRates {
+		phases = append(phases, types.PhaseInflation{
+			Phase:     uint64(i + 1),
+			Inflation: rate,
+		})
+	}
+
+	return &types.QueryInflationScheduleResponse{CurrentPhase: minter.Phase, Phases: phases}, nil
+}
+
+// ProjectedSupply returns the projected supply at the end of the current and
+// future phases of the mint module.
+func (k Keeper) ProjectedSupply(c context.Context, _ *types.QueryProjectedSupplyRequest) (*types.QueryProjectedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	projections, finalSupply := minter.ProjectSupply(params, k.TokenSupply(ctx, params.MintDenom))
+
+	return &types.QueryProjectedSupplyResponse{Projections: projections, FinalSupply: finalSupply}, nil
+}
+
+// Tokenomics returns the total burned amount, the current phase, the target
+// supply and the progress of the current phase of the mint module.
+func (k Keeper) Tokenomics(c context.Context, _ *types.QueryTokenomicsRequest) (*types.QueryTokenomicsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	totalSupply := k.TokenSupply(ctx, params.MintDenom)
+
+	return &types.QueryTokenomicsResponse{
+		TotalBurned:   k.GetTotalBurned(ctx),
+		CurrentPhase:  minter.Phase,
+		Inflation:     minter.Inflation,
+		TotalSupply:   totalSupply,
+		TargetSupply:  minter.TargetSupply,
+		PhaseProgress: minter.PhaseProgress(totalSupply),
+	}, nil
+}
+
+// ContractBurn returns the cumulative amount burned by a contract.
+func (k Keeper) ContractBurn(c context.Context, req *types.QueryContractBurnRequest) (*types.QueryContractBurnResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address %s", req.ContractAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryContractBurnResponse{ContractBurn: k.GetContractBurn(ctx, contract)}, nil
+}
+
+// ContractBurns returns the cumulative amounts burned by all contracts.
+func (k Keeper) ContractBurns(c context.Context, req *types.QueryContractBurnsRequest) (*types.QueryContractBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	burns, pageRes, err := k.GetContractBurns(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractBurnsResponse{ContractBurns: burns, Pagination: pageRes}, nil
+}
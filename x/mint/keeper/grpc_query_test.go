@@ -4,14 +4,17 @@ import (
 	gocontext "context"
 	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/mint"
 	"github.com/CosmosContracts/juno/v23/x/mint/types"
 )
 
@@ -55,6 +58,115 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.AppKeepers.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCInflationSchedule() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	schedule, err := queryClient.InflationSchedule(gocontext.Background(), &types.QueryInflationScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.AppKeepers.MintKeeper.GetMinter(ctx).Phase, schedule.CurrentPhase)
+	suite.Require().Len(schedule.Phases, len(types.DefaultPhaseInflationRates()))
+	suite.Require().Equal(uint64(1), schedule.Phases[0].Phase)
+	suite.Require().Equal(sdk.NewDecWithPrec(40, 2), schedule.Phases[0].Inflation)
+
+	projected, err := queryClient.ProjectedSupply(gocontext.Background(), &types.QueryProjectedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(projected.Projections)
+
+	last := projected.Projections[len(projected.Projections)-1]
+	suite.Require().Equal(uint64(len(types.DefaultPhaseInflationRates())), last.Phase)
+	suite.Require().Equal(last.TargetSupply, projected.FinalSupply)
+}
+
+func (suite *MintTestSuite) TestDistributeMintedCoin() {
+	app, ctx := suite.app, suite.ctx
+	mintKeeper := app.AppKeepers.MintKeeper
+
+	devFund := sdk.AccAddress([]byte("dev_fund____________"))
+	params := mintKeeper.GetParams(ctx)
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:       sdk.NewDecWithPrec(6, 1),
+		CommunityPool: sdk.NewDecWithPrec(2, 1),
+		WeightedAddresses: []types.WeightedAddress{
+			{Address: devFund.String(), Weight: sdk.NewDecWithPrec(1, 1)},
+		},
+		Burn: sdk.NewDecWithPrec(1, 1),
+	}
+	suite.Require().NoError(mintKeeper.SetParams(ctx, params))
+
+	feeCollector := app.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount
+	communityPoolBefore := app.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom)
+	supplyBefore := app.AppKeepers.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+	mintedCoin := sdk.NewCoin(params.MintDenom, sdk.NewInt(1_005))
+	suite.Require().NoError(mintKeeper.MintCoins(ctx, sdk.NewCoins(mintedCoin)))
+	suite.Require().NoError(mintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+	// the rounding remainder is sent to stakers
+	suite.Require().Equal(sdk.NewInt(100), app.AppKeepers.BankKeeper.GetBalance(ctx, devFund, params.MintDenom).Amount)
+	suite.Require().Equal(communityPoolBefore.Add(sdk.NewDec(201)), app.AppKeepers.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(params.MintDenom))
+	suite.Require().Equal(feesBefore.AddRaw(604), app.AppKeepers.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+
+	// the burn share is burned and recorded
+	suite.Require().Equal(supplyBefore.AddRaw(905), app.AppKeepers.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	tokenomics, err := suite.queryClient.Tokenomics(gocontext.Background(), &types.QueryTokenomicsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewInt(100))), tokenomics.TotalBurned)
+
+	// the x/mint module is not listed as a burning contract
+	burns, err := suite.queryClient.ContractBurns(gocontext.Background(), &types.QueryContractBurnsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(burns.ContractBurns)
+
+	// module accounts cannot be weighted addresses
+	params.DistributionProportions.WeightedAddresses[0].Address = feeCollector.String()
+	suite.Require().Error(mintKeeper.SetParams(ctx, params))
+}
+
+func (suite *MintTestSuite) TestRecordBurn() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	mintKeeper := app.AppKeepers.MintKeeper
+
+	contract := sdk.AccAddress([]byte("burning_contract____"))
+	otherContract := sdk.AccAddress([]byte("other_contract______"))
+
+	suite.Require().NoError(mintKeeper.RecordBurn(ctx, contract, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))))
+	suite.Require().NoError(mintKeeper.RecordBurn(ctx, contract, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(50)), sdk.NewCoin("uatom", sdk.NewInt(1)))))
+	suite.Require().NoError(mintKeeper.RecordBurn(ctx, otherContract, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))))
+
+	// a typed burn event is emitted
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventBurn{}) {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+
+	tokenomics, err := queryClient.Tokenomics(gocontext.Background(), &types.QueryTokenomicsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(160)), sdk.NewCoin("uatom", sdk.NewInt(1))), tokenomics.TotalBurned)
+	suite.Require().Equal(mintKeeper.GetMinter(ctx).Phase, tokenomics.CurrentPhase)
+	suite.Require().Equal(mintKeeper.GetMinter(ctx).TargetSupply, tokenomics.TargetSupply)
+
+	burn, err := queryClient.ContractBurn(gocontext.Background(), &types.QueryContractBurnRequest{ContractAddress: contract.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(150)), sdk.NewCoin("uatom", sdk.NewInt(1))), burn.ContractBurn.Amount)
+
+	_, err = queryClient.ContractBurn(gocontext.Background(), &types.QueryContractBurnRequest{ContractAddress: "invalid"})
+	suite.Require().Error(err)
+
+	burns, err := queryClient.ContractBurns(gocontext.Background(), &types.QueryContractBurnsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(burns.ContractBurns, 2)
+
+	// burns are exported and imported with the genesis
+	genesis := mint.ExportGenesis(ctx, mintKeeper)
+	suite.Require().NoError(types.ValidateGenesis(*genesis))
+	suite.Require().Equal(tokenomics.TotalBurned, genesis.TotalBurned)
+	suite.Require().Len(genesis.ContractBurns, 2)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -21,6 +21,7 @@ type Keeper struct {
 	stakingKeeper    types.StakingKeeper
 	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -35,6 +36,7 @@ func NewKeeper(
 	sk types.StakingKeeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	feeCollectorName string,
 	authority string,
 ) Keeper {
@@ -49,6 +51,7 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		bankKeeper:       bk,
 		accountKeeper:    ak,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
@@ -93,6 +96,12 @@ func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 		return err
 	}
 
+	for _, wa := range p.DistributionProportions.WeightedAddresses {
+		if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(wa.Address)) {
+			return fmt.Errorf("weighted address %s is not allowed to receive funds", wa.Address)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&p)
 	store.Set(types.ParamsKey, bz)
@@ -100,6 +109,15 @@ func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 	return nil
 }
 
+// SyncMinterSchedule updates the minter after the phase inflation schedule of
+// the params changed, so that the current phase matches the new schedule.
+func (k Keeper) SyncMinterSchedule(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	minter := k.GetMinter(ctx)
+
+	k.SetMinter(ctx, minter.SyncSchedule(params, k.TokenSupply(ctx, params.MintDenom), uint64(ctx.BlockHeight())))
+}
+
 // GetParams returns the current x/mint module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -172,3 +190,86 @@ func (k Keeper) ReduceTargetSupply(ctx sdk.Context, burnCoin sdk.Coin) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoin sends the minted coin to the weighted addresses and the
+// community pool, and burns the burn share, according to the distribution
+// proportions. The rest, including any rounding remainder, is sent to the fee
+// collector to be distributed to stakers.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	proportions := k.GetParams(ctx).DistributionProportions
+	remaining := mintedCoin.Amount
+
+	for _, wa := range proportions.WeightedAddresses {
+		amount := wa.Weight.MulInt(mintedCoin.Amount).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(wa.Address), coins); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(amount)
+		emitMintDistributionEvent(ctx, wa.Address, coins)
+	}
+
+	communityPoolAmount := proportions.CommunityPool.MulInt(mintedCoin.Amount).TruncateInt()
+	if communityPoolAmount.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, communityPoolAmount))
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(communityPoolAmount)
+		emitMintDistributionEvent(ctx, types.AttributeValueCommunityPool, coins)
+	}
+
+	burnAmount := proportions.Burn.MulInt(mintedCoin.Amount).TruncateInt()
+	if burnAmount.IsPositive() {
+		burnCoin := sdk.NewCoin(mintedCoin.Denom, burnAmount)
+		if err := k.burnMintedCoin(ctx, burnCoin); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(burnAmount)
+		emitMintDistributionEvent(ctx, types.AttributeValueBurn, sdk.NewCoins(burnCoin))
+	}
+
+	stakingCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, remaining))
+	if err := k.AddCollectedFees(ctx, stakingCoins); err != nil {
+		return err
+	}
+
+	emitMintDistributionEvent(ctx, types.AttributeValueStaking, stakingCoins)
+	return nil
+}
+
+// burnMintedCoin burns a share of the minted coin from the module account. The
+// target supply is reduced like for any other burn, so burning does not delay
+// the end of the phase, and the burn is added to the total burned amounts only,
+// as the x/mint module is not a burning contract.
+func (k Keeper) burnMintedCoin(ctx sdk.Context, burnCoin sdk.Coin) error {
+	burnCoins := sdk.NewCoins(burnCoin)
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return err
+	}
+
+	if err := k.ReduceTargetSupply(ctx, burnCoin); err != nil {
+		return err
+	}
+
+	k.AddTotalBurned(ctx, burnCoins)
+
+	return nil
+}
+
+func emitMintDistributionEvent(ctx sdk.Context, recipient string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
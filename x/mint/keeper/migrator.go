@@ -5,6 +5,7 @@ import (
 
 	v2 "github.com/CosmosContracts/juno/v23/x/mint/migrations/v2"
 	v3 "github.com/CosmosContracts/juno/v23/x/mint/migrations/v3"
+	v4 "github.com/CosmosContracts/juno/v23/x/mint/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -32,3 +33,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc, m.bondDenom)
 }
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it moves the hard-coded inflation schedule into the
+// module params.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
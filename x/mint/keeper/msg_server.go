@@ -34,5 +34,7 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 		return nil, err
 	}
 
+	ms.SyncMinterSchedule(ctx)
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/mint"
+	"github.com/CosmosContracts/juno/v23/x/mint/keeper"
+	"github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+func (suite *MintTestSuite) TestUpdateParamsSyncsPhase() {
+	app, ctx := suite.app, suite.ctx
+	mintKeeper := app.AppKeepers.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(mintKeeper)
+
+	denom := mintKeeper.GetParams(ctx).MintDenom
+	suite.Require().NoError(mintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1_000_000_000)))))
+
+	ctx = ctx.WithBlockHeight(2)
+	mint.BeginBlocker(ctx, mintKeeper)
+	phase := mintKeeper.GetMinter(ctx).Phase
+	suite.Require().NotZero(phase)
+	suite.Require().False(mintKeeper.GetMinter(ctx).Inflation.IsZero())
+
+	params := mintKeeper.GetParams(ctx)
+	rates := params.PhaseInflationRates
+	supply := func() sdk.Int {
+		return mintKeeper.TokenSupply(ctx, params.MintDenom)
+	}
+
+	// shortening the schedule below the current phase stops the inflation
+	params.PhaseInflationRates = rates[:phase-1]
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: mintKeeper.GetAuthority(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().True(mintKeeper.GetMinter(ctx).Inflation.IsZero())
+
+	supplyBefore := supply()
+	ctx = ctx.WithBlockHeight(3)
+	mint.BeginBlocker(ctx, mintKeeper)
+	suite.Require().Equal(supplyBefore, supply())
+
+	// extending the schedule after its end starts the first added phase
+	params.PhaseInflationRates = append(rates[:phase-1:phase-1], sdk.NewDecWithPrec(10, 2))
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: mintKeeper.GetAuthority(), Params: params})
+	suite.Require().NoError(err)
+
+	minter := mintKeeper.GetMinter(ctx)
+	suite.Require().Equal(phase, minter.Phase)
+	suite.Require().Equal(sdk.NewDecWithPrec(10, 2), minter.Inflation)
+	suite.Require().Equal(uint64(3), minter.StartPhaseBlock)
+
+	ctx = ctx.WithBlockHeight(4)
+	mint.BeginBlocker(ctx, mintKeeper)
+	suite.Require().Equal(phase, mintKeeper.GetMinter(ctx).Phase)
+	suite.Require().True(supply().GT(supplyBefore))
+}
@@ -33,9 +33,14 @@ func Migrate(
 
 	fmt.Printf("migrating %s params: %+v\n", ModuleName, currParams)
 
-	if err := currParams.Validate(); err != nil {
+	// only validate the fields known at this version, the params added later
+	// are set by the following migrations
+	if err := sdk.ValidateDenom(currParams.MintDenom); err != nil {
 		return err
 	}
+	if currParams.BlocksPerYear == 0 {
+		return fmt.Errorf("blocks per year must be positive: %d", currParams.BlocksPerYear)
+	}
 
 	bz := cdc.MustMarshal(&currParams)
 	store.Set(ParamsKey, bz)
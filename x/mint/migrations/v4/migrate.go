@@ -0,0 +1,44 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+const (
+	ModuleName = "mint"
+)
+
+var ParamsKey = []byte{0x01}
+
+// Migrate migrates the x/mint module state from the consensus version 3 to
+// version 4. Specifically, it moves the inflation schedule, which was
+// previously hard-coded in Minter.PhaseInflationRate, into the module params,
+// sends all the minted tokens to stakers and keeps the block-based
+// provisioning. The migrated params reproduce the previous behavior.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.PhaseInflationRates = types.DefaultPhaseInflationRates()
+	params.DistributionProportions = types.DefaultDistributionProportions()
+	params.TimeBasedProvisioning = false
+	params.MaxBlockDuration = types.DefaultMaxBlockDuration
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
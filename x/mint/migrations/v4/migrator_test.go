@@ -0,0 +1,53 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/CosmosContracts/juno/v23/x/mint"
+	v4 "github.com/CosmosContracts/juno/v23/x/mint/migrations/v4"
+	"github.com/CosmosContracts/juno/v23/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&types.Params{
+		MintDenom:     "ujuno",
+		BlocksPerYear: 5048093,
+	}))
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, "ujuno", res.MintDenom)
+	require.Equal(t, uint64(5048093), res.BlocksPerYear)
+
+	// the migrated schedule reproduces the previously hard-coded phases
+	expected := []string{"0.4", "0.2", "0.1", "0.09", "0.08", "0.07", "0.06", "0.05", "0.04", "0.03", "0.02", "0.01"}
+	require.Len(t, res.PhaseInflationRates, len(expected))
+	for i, rate := range expected {
+		require.Equal(t, sdk.MustNewDecFromStr(rate), res.PhaseInflationRates[i], "phase %d", i+1)
+	}
+
+	// all the minted tokens are still sent to stakers
+	require.Equal(t, sdk.OneDec(), res.DistributionProportions.Staking)
+	require.True(t, res.DistributionProportions.CommunityPool.IsZero())
+	require.Empty(t, res.DistributionProportions.WeightedAddresses)
+
+	// provisions are still based on blocks per year
+	require.False(t, res.TimeBasedProvisioning)
+	require.Equal(t, types.DefaultMaxBlockDuration, res.MaxBlockDuration)
+}
@@ -40,7 +40,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, blocksPerYear)
+	params := types.NewParams(mintDenom, blocksPerYear, types.DefaultPhaseInflationRates(), types.DefaultDistributionProportions(), false, types.DefaultMaxBlockDuration)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
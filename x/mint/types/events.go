@@ -2,8 +2,14 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
 
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyRecipient        = "recipient"
+
+	AttributeValueStaking       = "staking"
+	AttributeValueCommunityPool = "community_pool"
+	AttributeValueBurn          = "burn"
 )
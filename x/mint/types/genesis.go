@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
@@ -11,8 +17,10 @@ func NewGenesisState(minter Minter, params Params) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultInitialMinter(),
-		Params: DefaultParams(),
+		Minter:        DefaultInitialMinter(),
+		Params:        DefaultParams(),
+		TotalBurned:   sdk.NewCoins(),
+		ContractBurns: []ContractBurn{},
 	}
 }
 
@@ -23,5 +31,25 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.TotalBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid total burned: %w", err)
+	}
+
+	seen := make(map[string]bool, len(data.ContractBurns))
+	for _, burn := range data.ContractBurns {
+		if _, err := sdk.AccAddressFromBech32(burn.ContractAddress); err != nil {
+			return fmt.Errorf("invalid contract burn address %s: %w", burn.ContractAddress, err)
+		}
+
+		if seen[burn.ContractAddress] {
+			return fmt.Errorf("duplicate contract burn for %s", burn.ContractAddress)
+		}
+		seen[burn.ContractAddress] = true
+
+		if err := burn.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid contract burn amount for %s: %w", burn.ContractAddress, err)
+		}
+	}
+
 	return ValidateMinter(data.Minter)
 }
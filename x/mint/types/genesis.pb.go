@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +31,10 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// total_burned is the cumulative amount burned by contracts.
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+	// contract_burns are the cumulative amounts burned by each contract.
+	ContractBurns []ContractBurn `protobuf:"bytes,4,rep,name=contract_burns,json=contractBurns,proto3" json:"contract_burns"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +84,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractBurns() []ContractBurn {
+	if m != nil {
+		return m.ContractBurns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.mint.GenesisState")
 }
@@ -85,21 +105,28 @@ func init() {
 func init() { proto.RegisterFile("juno/mint/genesis.proto", fileDescriptor_6ca2177ac9d4c4e4) }
 
 var fileDescriptor_6ca2177ac9d4c4e4 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0x2a, 0xcd, 0xcb,
-	0xd7, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x04, 0x49, 0xe8, 0x81, 0x24, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xa2, 0xfa, 0x20, 0x16, 0x44, 0x81, 0x94, 0x08, 0x42, 0x27, 0x88, 0x80, 0x88, 0x2a, 0x15,
-	0x70, 0xf1, 0xb8, 0x43, 0xcc, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xd2, 0xe7, 0x62, 0x03, 0xc9,
-	0xa6, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xea, 0xc1, 0xcd, 0xd5, 0xf3, 0x05,
-	0x4b, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x06, 0xd2, 0x50, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0x84, 0xa1, 0x21, 0x00, 0x2c, 0x01, 0xd3, 0x00, 0x51, 0xe6, 0xe4, 0x76,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xce, 0xf9, 0x79, 0x25,
-	0x45, 0x89, 0xc9, 0x25, 0xc5, 0xfa, 0x60, 0xc7, 0x57, 0x40, 0x9c, 0x5f, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x80, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x38, 0xb2, 0x67, 0x12,
-	0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x5b, 0x20, 0x24, 0x16, 0x34, 0xb1, 0x21, 0xa1, 0xb2, 0x18, 0x88, 0x2b, 0x16, 0x3a,
+	0x23, 0x78, 0x83, 0x62, 0x74, 0x65, 0x62, 0x70, 0xe7, 0xc6, 0x4c, 0xcb, 0xa4, 0x56, 0xed, 0x0c,
+	0xe9, 0x1b, 0x8c, 0xde, 0xc2, 0x53, 0xb8, 0xf0, 0x24, 0x2c, 0x59, 0xba, 0x52, 0x03, 0x17, 0x31,
+	0xf3, 0x66, 0xd0, 0x26, 0x6e, 0xda, 0xe6, 0xfd, 0xff, 0xff, 0xfe, 0xaf, 0x2f, 0xe8, 0xde, 0x2f,
+	0xa4, 0x62, 0x45, 0x2e, 0x35, 0xcb, 0x84, 0x14, 0x90, 0x03, 0x9d, 0x97, 0x4a, 0xab, 0x70, 0xc7,
+	0x08, 0xd4, 0x08, 0xbd, 0x4e, 0xa6, 0x32, 0x85, 0x53, 0x66, 0xbe, 0xac, 0xa1, 0x47, 0x52, 0x05,
+	0x85, 0x02, 0x96, 0x70, 0x10, 0xec, 0x69, 0x94, 0x08, 0xcd, 0x47, 0x2c, 0x55, 0xb9, 0x74, 0x7a,
+	0xe7, 0x6f, 0xb3, 0x79, 0xd8, 0xe9, 0xe1, 0x5b, 0x2d, 0x68, 0x5f, 0xd8, 0xa2, 0x6b, 0xcd, 0xb5,
+	0x08, 0x59, 0xd0, 0x34, 0xb2, 0x28, 0x23, 0x7f, 0xe0, 0x0f, 0x5b, 0xe3, 0x7d, 0xfa, 0x5b, 0x4c,
+	0x2f, 0x51, 0x88, 0x1b, 0xcb, 0xcf, 0xbe, 0x37, 0x75, 0x36, 0x13, 0x98, 0xf3, 0x92, 0x17, 0x10,
+	0xd5, 0xfe, 0x05, 0xae, 0x50, 0xd8, 0x06, 0xac, 0x2d, 0x94, 0x41, 0x5b, 0x2b, 0xcd, 0x1f, 0x6f,
+	0x93, 0x45, 0x29, 0xc5, 0x2c, 0xaa, 0x0f, 0xea, 0xc3, 0xd6, 0xf8, 0x80, 0x5a, 0x7e, 0x6a, 0xf8,
+	0xa9, 0xe3, 0xa7, 0x13, 0x95, 0xcb, 0xf8, 0xc4, 0xc4, 0xdf, 0xbf, 0xfa, 0xc3, 0x2c, 0xd7, 0x77,
+	0x8b, 0x84, 0xa6, 0xaa, 0x60, 0xee, 0x67, 0xed, 0xeb, 0x18, 0x66, 0x0f, 0x4c, 0xbf, 0xcc, 0x05,
+	0x60, 0x00, 0xa6, 0x2d, 0x2c, 0x88, 0x71, 0x7f, 0x78, 0x16, 0xec, 0xa5, 0x4a, 0xea, 0x92, 0xa7,
+	0x1a, 0x2b, 0x21, 0x6a, 0x60, 0x63, 0xb7, 0x02, 0x3a, 0x71, 0x06, 0x13, 0x71, 0xb8, 0xbb, 0x69,
+	0x65, 0x06, 0xf1, 0xf9, 0x72, 0x4d, 0xfc, 0xd5, 0x9a, 0xf8, 0xdf, 0x6b, 0xe2, 0xbf, 0x6e, 0x88,
+	0xb7, 0xda, 0x10, 0xef, 0x63, 0x43, 0xbc, 0x9b, 0xa3, 0x0a, 0xd6, 0x04, 0x79, 0xb6, 0xdb, 0x80,
+	0xe1, 0xcd, 0x9f, 0xed, 0xd5, 0x11, 0x30, 0x69, 0xe2, 0xdd, 0x4f, 0x7f, 0x06, 0x00, 0x31, 0x8e,
+	0xd8, 0x6a, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +149,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractBurns) > 0 {
+		for iNdEx := len(m.ContractBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -166,6 +221,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractBurns) > 0 {
+		for _, e := range m.ContractBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -270,6 +337,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractBurns = append(m.ContractBurns, ContractBurn{})
+			if err := m.ContractBurns[len(m.ContractBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,11 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = []byte{0x00}
 	ParamsKey = []byte{0x01}
+
+	// TotalBurnedKeyPrefix is the prefix of the cumulative burned amount per denom.
+	TotalBurnedKeyPrefix = []byte{0x02}
+	// ContractBurnKeyPrefix is the prefix of the cumulative burned amount per contract.
+	ContractBurnKeyPrefix = []byte{0x03}
 )
 
 const (
@@ -6,17 +6,23 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +39,8 @@ type Minter struct {
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
 	TargetSupply     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=target_supply,json=targetSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_supply" yaml:"target_supply"`
+	// time of the previous block, used by time-based provisioning
+	PreviousBlockTime time.Time `protobuf:"bytes,6,opt,name=previous_block_time,json=previousBlockTime,proto3,stdtime" json:"previous_block_time" yaml:"previous_block_time"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -82,12 +90,31 @@ func (m *Minter) GetStartPhaseBlock() uint64 {
 	return 0
 }
 
+func (m *Minter) GetPreviousBlockTime() time.Time {
+	if m != nil {
+		return m.PreviousBlockTime
+	}
+	return time.Time{}
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,2,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// inflation rate of each phase, phase N uses the rate at index N-1. There is
+	// no inflation after the last phase.
+	PhaseInflationRates []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,rep,name=phase_inflation_rates,json=phaseInflationRates,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"phase_inflation_rates" yaml:"phase_inflation_rates"`
+	// distribution of the minted tokens between stakers, the community pool
+	// and weighted addresses
+	DistributionProportions DistributionProportions `protobuf:"bytes,4,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
+	// mint each block the provisions of the time elapsed since the previous
+	// block, instead of the annual provisions divided by blocks_per_year
+	TimeBasedProvisioning bool `protobuf:"varint,5,opt,name=time_based_provisioning,json=timeBasedProvisioning,proto3" json:"time_based_provisioning,omitempty" yaml:"time_based_provisioning"`
+	// maximum elapsed time accounted for a single block by time-based
+	// provisioning
+	MaxBlockDuration time.Duration `protobuf:"bytes,6,opt,name=max_block_duration,json=maxBlockDuration,proto3,stdduration" json:"max_block_duration" yaml:"max_block_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -136,41 +163,251 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDistributionProportions() DistributionProportions {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return DistributionProportions{}
+}
+
+func (m *Params) GetTimeBasedProvisioning() bool {
+	if m != nil {
+		return m.TimeBasedProvisioning
+	}
+	return false
+}
+
+func (m *Params) GetMaxBlockDuration() time.Duration {
+	if m != nil {
+		return m.MaxBlockDuration
+	}
+	return 0
+}
+
+// DistributionProportions defines the share of the minted tokens sent to each
+// destination. The proportions must sum to 1.
+type DistributionProportions struct {
+	// staking is the share sent to the fee collector, distributed to stakers
+	Staking github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking"`
+	// community_pool is the share sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// weighted_addresses are the addresses receiving a share of the minted
+	// tokens, such as a development fund
+	WeightedAddresses []WeightedAddress `protobuf:"bytes,3,rep,name=weighted_addresses,json=weightedAddresses,proto3" json:"weighted_addresses" yaml:"weighted_addresses"`
+	// burn is the share burned right after being minted
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn"`
+}
+
+func (m *DistributionProportions) Reset()         { *m = DistributionProportions{} }
+func (m *DistributionProportions) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportions) ProtoMessage()    {}
+func (*DistributionProportions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{2}
+}
+func (m *DistributionProportions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportions.Merge(m, src)
+}
+func (m *DistributionProportions) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
+
+func (m *DistributionProportions) GetWeightedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.WeightedAddresses
+	}
+	return nil
+}
+
+// WeightedAddress defines an address receiving a share of the minted tokens.
+type WeightedAddress struct {
+	// bech32 address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// share of the minted tokens sent to the address
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{3}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
+func (m *WeightedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ContractBurn defines the cumulative amount burned by a contract.
+type ContractBurn struct {
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount is the cumulative burned amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ContractBurn) Reset()         { *m = ContractBurn{} }
+func (m *ContractBurn) String() string { return proto.CompactTextString(m) }
+func (*ContractBurn) ProtoMessage()    {}
+func (*ContractBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0bccce3b583aa44, []int{4}
+}
+func (m *ContractBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractBurn.Merge(m, src)
+}
+func (m *ContractBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractBurn proto.InternalMessageInfo
+
+func (m *ContractBurn) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractBurn) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "juno.mint.Minter")
 	proto.RegisterType((*Params)(nil), "juno.mint.Params")
+	proto.RegisterType((*DistributionProportions)(nil), "juno.mint.DistributionProportions")
+	proto.RegisterType((*WeightedAddress)(nil), "juno.mint.WeightedAddress")
+	proto.RegisterType((*ContractBurn)(nil), "juno.mint.ContractBurn")
 }
 
 func init() { proto.RegisterFile("juno/mint/mint.proto", fileDescriptor_e0bccce3b583aa44) }
 
 var fileDescriptor_e0bccce3b583aa44 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x13, 0x6e, 0x6f, 0xa5, 0x58, 0x5c, 0x95, 0x5a, 0x11, 0x8a, 0x2a, 0x48, 0xaa, 0x0c,
-	0xa8, 0x03, 0x24, 0x03, 0x5b, 0xc7, 0xb4, 0xaa, 0x00, 0x81, 0x14, 0x85, 0x09, 0x96, 0xc8, 0x4d,
-	0x4d, 0x1a, 0x9a, 0xd8, 0xc1, 0x76, 0x80, 0xbc, 0x05, 0x23, 0x03, 0x03, 0x8f, 0xd3, 0xb1, 0x23,
-	0x62, 0x88, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x64, 0x1b, 0x51, 0xb8, 0x9d, 0xba, 0x24, 0xfe, 0x3f,
-	0xfd, 0x3a, 0xbf, 0x8f, 0xcf, 0x01, 0xf6, 0xfb, 0x86, 0xd0, 0xb0, 0x2a, 0x88, 0x50, 0x9f, 0xa0,
-	0x66, 0x54, 0x50, 0x68, 0x49, 0x1a, 0x48, 0x30, 0xb2, 0x73, 0x9a, 0x53, 0x45, 0x43, 0x79, 0xd2,
-	0x06, 0xff, 0xdb, 0x15, 0xe8, 0xbf, 0x2a, 0x88, 0xc0, 0x0c, 0xbe, 0x04, 0x56, 0x41, 0xde, 0x95,
-	0x48, 0x14, 0x94, 0x38, 0xe6, 0xd8, 0x9c, 0x58, 0x51, 0xb0, 0xed, 0x3c, 0xe3, 0x67, 0xe7, 0x3d,
-	0xca, 0x0b, 0xb1, 0x6e, 0x96, 0x41, 0x46, 0xab, 0x30, 0xa3, 0xbc, 0xa2, 0xfc, 0xcf, 0xef, 0x09,
-	0x5f, 0x6d, 0x42, 0xd1, 0xd6, 0x98, 0x07, 0x73, 0x9c, 0x25, 0xa7, 0x02, 0xd0, 0x06, 0xd7, 0xf5,
-	0x1a, 0x71, 0xec, 0xdc, 0x19, 0x9b, 0x93, 0x5e, 0xa2, 0x05, 0x7c, 0x06, 0x86, 0x5c, 0x20, 0x26,
-	0x52, 0x25, 0xd3, 0x65, 0x49, 0xb3, 0x8d, 0x73, 0x25, 0x1d, 0xd1, 0x83, 0x63, 0xe7, 0x39, 0x2d,
-	0xaa, 0xca, 0xa9, 0x7f, 0x66, 0xf1, 0x93, 0x81, 0x62, 0xb1, 0x44, 0x91, 0x24, 0xf0, 0x13, 0x18,
-	0x22, 0x42, 0x1a, 0x54, 0xa6, 0x35, 0xa3, 0x1f, 0x0b, 0x5e, 0x50, 0xc2, 0x9d, 0x9e, 0xba, 0xf5,
-	0x8b, 0xcb, 0x6e, 0x7d, 0xca, 0x3d, 0x2b, 0xe8, 0x27, 0xf7, 0x34, 0x8b, 0xff, 0x22, 0xb8, 0x01,
-	0x37, 0x02, 0xb1, 0x1c, 0x8b, 0x94, 0x37, 0x75, 0x5d, 0xb6, 0xce, 0xb5, 0x0a, 0x5d, 0x5c, 0x10,
-	0xfa, 0x9c, 0x88, 0x63, 0xe7, 0xd9, 0x3a, 0xf4, 0xbf, 0x62, 0x7e, 0x72, 0x57, 0xeb, 0xd7, 0x5a,
-	0x7e, 0x00, 0xfd, 0x18, 0x31, 0x54, 0x71, 0xf8, 0x10, 0x00, 0x39, 0xc6, 0x74, 0x85, 0x09, 0xad,
-	0xf4, 0x78, 0x12, 0x4b, 0x92, 0xb9, 0x04, 0x30, 0x02, 0x03, 0xf5, 0x52, 0x3c, 0xad, 0x31, 0x4b,
-	0x5b, 0x8c, 0x98, 0x7e, 0xf8, 0x68, 0x74, 0xec, 0xbc, 0xfb, 0x3a, 0xe9, 0x96, 0xc1, 0x4f, 0x6e,
-	0x34, 0x89, 0x31, 0x7b, 0x83, 0x11, 0x9b, 0xf6, 0xbe, 0x7e, 0xf7, 0x8c, 0x68, 0xb1, 0xdd, 0xbb,
-	0xe6, 0x6e, 0xef, 0x9a, 0xbf, 0xf6, 0xae, 0xf9, 0xe5, 0xe0, 0x1a, 0xbb, 0x83, 0x6b, 0xfc, 0x38,
-	0xb8, 0xc6, 0xdb, 0xc7, 0xff, 0xb4, 0x36, 0x53, 0x3d, 0xcd, 0x28, 0x11, 0x0c, 0x65, 0x82, 0x87,
-	0x6a, 0xfb, 0x3e, 0xeb, 0xfd, 0x53, 0x4d, 0x2e, 0xfb, 0x6a, 0xc1, 0x9e, 0xfe, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0xa2, 0xd3, 0xd4, 0x99, 0x02, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0x6a, 0xd7, 0x9d, 0xd9, 0x66, 0x49, 0xd8, 0x64, 0x51, 0x8d, 0xce, 0xca, 0x08, 0xac,
+	0xf3, 0x80, 0x4d, 0x5a, 0xbb, 0x5b, 0x6f, 0x63, 0x82, 0xac, 0x1d, 0xb6, 0xc1, 0xd0, 0x06, 0x0c,
+	0xeb, 0x45, 0xa0, 0x25, 0xd6, 0xd1, 0x22, 0x91, 0x02, 0x49, 0x25, 0xf1, 0x75, 0xc0, 0x76, 0xce,
+	0xb1, 0xa7, 0x61, 0xe7, 0xfd, 0x92, 0x02, 0xbb, 0xf4, 0x38, 0xec, 0xe0, 0x0e, 0xc9, 0x3f, 0xf0,
+	0x2f, 0x18, 0x48, 0x4a, 0x76, 0x1c, 0x27, 0x40, 0x93, 0x4b, 0xe2, 0xf7, 0xf1, 0x7b, 0xdf, 0x7b,
+	0xe4, 0xfb, 0x28, 0x82, 0x8d, 0x5f, 0x4a, 0xc6, 0x83, 0x3c, 0x65, 0xca, 0xfc, 0xf1, 0x0b, 0xc1,
+	0x15, 0x87, 0x1d, 0x8d, 0xfa, 0x1a, 0xe8, 0x6e, 0x8c, 0xf8, 0x88, 0x1b, 0x34, 0xd0, 0xbf, 0x2c,
+	0xa1, 0xdb, 0x8b, 0xb9, 0xcc, 0xb9, 0x0c, 0x86, 0x44, 0xd2, 0xe0, 0xf0, 0xf1, 0x90, 0x2a, 0xf2,
+	0x38, 0x88, 0x79, 0xca, 0xea, 0xf5, 0x11, 0xe7, 0xa3, 0x8c, 0x06, 0x26, 0x1a, 0x96, 0x2f, 0x83,
+	0xa4, 0x14, 0x44, 0xa5, 0xbc, 0x5e, 0xf7, 0x2e, 0xae, 0xab, 0x34, 0xa7, 0x52, 0x91, 0xbc, 0xb0,
+	0x04, 0x74, 0xd2, 0x02, 0xed, 0xef, 0x52, 0xa6, 0xa8, 0x80, 0xdf, 0x82, 0x4e, 0xca, 0x5e, 0x66,
+	0x26, 0xdd, 0x75, 0xb6, 0x9d, 0x7e, 0x07, 0xfb, 0xaf, 0x27, 0x5e, 0xe3, 0xdf, 0x89, 0xf7, 0x68,
+	0x94, 0xaa, 0xfd, 0x72, 0xe8, 0xc7, 0x3c, 0x0f, 0xaa, 0x8e, 0xec, 0xbf, 0xcf, 0x65, 0x72, 0x10,
+	0xa8, 0x71, 0x41, 0xa5, 0xbf, 0x4b, 0xe3, 0x70, 0x2e, 0x00, 0x37, 0xc0, 0xed, 0x62, 0x9f, 0x48,
+	0xea, 0xde, 0xda, 0x76, 0xfa, 0xad, 0xd0, 0x06, 0xf0, 0x19, 0x58, 0x97, 0x8a, 0x08, 0x15, 0x99,
+	0x30, 0x1a, 0x66, 0x3c, 0x3e, 0x70, 0x9b, 0x9a, 0x81, 0x1f, 0x4e, 0x27, 0x9e, 0x3b, 0x26, 0x79,
+	0xf6, 0x14, 0x2d, 0x51, 0x50, 0xb8, 0x6a, 0xb0, 0x81, 0x86, 0xb0, 0x46, 0xe0, 0x11, 0x58, 0x27,
+	0x8c, 0x95, 0x24, 0x8b, 0x0a, 0xc1, 0x0f, 0x53, 0x99, 0x72, 0x26, 0xdd, 0x96, 0xe9, 0xfa, 0x9b,
+	0xeb, 0x75, 0x3d, 0xaf, 0xbb, 0x24, 0x88, 0xc2, 0x35, 0x8b, 0x0d, 0x66, 0x10, 0x3c, 0x00, 0x2b,
+	0x8a, 0x88, 0x11, 0x55, 0x91, 0x2c, 0x8b, 0x22, 0x1b, 0xbb, 0xb7, 0x4d, 0xd1, 0xbd, 0x6b, 0x14,
+	0x7d, 0xce, 0xd4, 0x74, 0xe2, 0x6d, 0xd8, 0xa2, 0x0b, 0x62, 0x28, 0xbc, 0x67, 0xe3, 0x1f, 0x4c,
+	0x08, 0x05, 0xb8, 0x5f, 0x08, 0x7a, 0x98, 0xf2, 0x52, 0xda, 0x93, 0x88, 0xf4, 0x00, 0xdd, 0xf6,
+	0xb6, 0xd3, 0xbf, 0xfb, 0xa4, 0xeb, 0xdb, 0xe9, 0xfa, 0xf5, 0x74, 0xfd, 0x1f, 0xeb, 0xe9, 0xe2,
+	0x47, 0xba, 0x9d, 0xe9, 0xc4, 0xeb, 0xda, 0x22, 0x97, 0x88, 0xa0, 0x93, 0xb7, 0x9e, 0x13, 0xae,
+	0xd7, 0x2b, 0xe6, 0x54, 0x75, 0x3e, 0xfa, 0xbb, 0x05, 0xda, 0x03, 0x22, 0x48, 0x2e, 0xe1, 0x87,
+	0x00, 0x68, 0x73, 0x46, 0x09, 0x65, 0x3c, 0xb7, 0x9e, 0x08, 0x3b, 0x1a, 0xd9, 0xd5, 0x00, 0xc4,
+	0x60, 0xd5, 0xe8, 0xc9, 0xa8, 0xa0, 0x22, 0x1a, 0x53, 0x22, 0xec, 0xb4, 0x71, 0x77, 0x3a, 0xf1,
+	0x3e, 0xb0, 0x95, 0x2f, 0x10, 0x50, 0xb8, 0x62, 0x91, 0x01, 0x15, 0x3f, 0x53, 0x22, 0xe0, 0xaf,
+	0x0e, 0xd8, 0xb4, 0x93, 0x9e, 0x79, 0x27, 0x12, 0x44, 0x51, 0xe9, 0x36, 0xb7, 0x9b, 0xfd, 0x0e,
+	0xfe, 0xfe, 0xda, 0xc3, 0x7c, 0x58, 0x6d, 0xf9, 0x32, 0x51, 0x14, 0xde, 0x37, 0xf8, 0xf3, 0x1a,
+	0x0e, 0x35, 0x0a, 0x7f, 0x77, 0x80, 0x9b, 0xa4, 0x52, 0x89, 0x74, 0x58, 0x1a, 0x72, 0x21, 0x78,
+	0xc1, 0x85, 0x9a, 0x99, 0xea, 0xee, 0x13, 0xe4, 0xcf, 0xee, 0xaa, 0xbf, 0x7b, 0x8e, 0x3a, 0x98,
+	0x33, 0xf1, 0x27, 0xd5, 0xa1, 0x7b, 0xb6, 0x83, 0xab, 0x14, 0x51, 0xb8, 0x95, 0x5c, 0xae, 0x00,
+	0x5f, 0x80, 0x2d, 0x3d, 0x9b, 0x48, 0xdf, 0xf7, 0x64, 0x6e, 0xc4, 0x94, 0x8d, 0x8c, 0xcd, 0xde,
+	0xc3, 0x68, 0x3a, 0xf1, 0x7a, 0x95, 0x71, 0x2e, 0x27, 0xa2, 0x70, 0x53, 0xaf, 0x60, 0xbd, 0x30,
+	0x38, 0x87, 0x43, 0x06, 0x60, 0x4e, 0x8e, 0x2b, 0x07, 0xd4, 0xdf, 0x89, 0xca, 0x4a, 0x0f, 0x96,
+	0xac, 0xb4, 0x5b, 0x11, 0xf0, 0xc7, 0xd5, 0xa6, 0x1e, 0xd8, 0xaa, 0xcb, 0x12, 0xe8, 0x95, 0x36,
+	0xd2, 0x5a, 0x4e, 0x8e, 0x8d, 0x87, 0xea, 0xc4, 0xa7, 0xad, 0x57, 0x7f, 0x7a, 0x0d, 0xf4, 0x5b,
+	0x13, 0x6c, 0x5d, 0x71, 0x5e, 0xf0, 0x19, 0xb8, 0x23, 0x15, 0x39, 0xd0, 0xbb, 0xbb, 0xd9, 0xf7,
+	0xa6, 0x4e, 0x87, 0x0c, 0xbc, 0x1f, 0xf3, 0x3c, 0x2f, 0x59, 0xaa, 0xc6, 0x51, 0xc1, 0x79, 0x66,
+	0x8c, 0xd8, 0xc1, 0x5f, 0x5f, 0xdb, 0x3d, 0x9b, 0x76, 0x9b, 0x8b, 0x6a, 0x28, 0x5c, 0x99, 0x01,
+	0x03, 0xce, 0x33, 0x98, 0x01, 0x78, 0x44, 0xd3, 0xd1, 0xbe, 0xa2, 0x49, 0x44, 0x92, 0x44, 0x50,
+	0x29, 0x2b, 0xc7, 0xea, 0x6b, 0x39, 0x77, 0xca, 0x4f, 0x15, 0xe9, 0x2b, 0xcb, 0xc1, 0x1f, 0x2d,
+	0x1e, 0xe6, 0xb2, 0x06, 0x0a, 0xd7, 0x8f, 0x16, 0x73, 0xa8, 0x84, 0x18, 0xb4, 0x86, 0xa5, 0x60,
+	0x6e, 0xeb, 0x46, 0x87, 0x64, 0x72, 0x91, 0x04, 0xab, 0x17, 0x9a, 0x81, 0x2e, 0xb8, 0x53, 0xd5,
+	0xad, 0xae, 0x76, 0x1d, 0xc2, 0x3d, 0xd0, 0xb6, 0x5d, 0xb8, 0xb7, 0x6e, 0x54, 0xb2, 0xca, 0x46,
+	0x7f, 0x38, 0xe0, 0xde, 0x0e, 0x67, 0x4a, 0x90, 0x58, 0xe1, 0x52, 0x30, 0xf8, 0x29, 0x58, 0x8b,
+	0xab, 0x38, 0x5a, 0xac, 0xbd, 0x5a, 0xe3, 0x75, 0x77, 0x31, 0x68, 0x93, 0x9c, 0x97, 0x4c, 0xf7,
+	0xd0, 0x34, 0x16, 0xb5, 0xa5, 0x7c, 0x6d, 0x79, 0xbf, 0x7a, 0x0b, 0xfd, 0x1d, 0x9e, 0x32, 0xfc,
+	0x85, 0x6e, 0xef, 0xaf, 0xb7, 0x5e, 0xff, 0x1d, 0xda, 0xd3, 0x09, 0x32, 0xac, 0xa4, 0xf1, 0xde,
+	0xeb, 0xd3, 0x9e, 0xf3, 0xe6, 0xb4, 0xe7, 0xfc, 0x77, 0xda, 0x73, 0x4e, 0xce, 0x7a, 0x8d, 0x37,
+	0x67, 0xbd, 0xc6, 0x3f, 0x67, 0xbd, 0xc6, 0x8b, 0xcf, 0xce, 0x69, 0xed, 0x18, 0x91, 0x7a, 0x23,
+	0x32, 0x30, 0x6f, 0xf9, 0xb1, 0x7d, 0xcd, 0x8d, 0xea, 0xb0, 0x6d, 0xee, 0xcd, 0x97, 0xff, 0x0f,
+	0x00, 0xb8, 0x84, 0xb9, 0x57, 0xe7, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -193,6 +430,14 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreviousBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousBlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.TargetSupply.Size()
 		i -= size
@@ -229,103 +474,812 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxBlockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBlockDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.TimeBasedProvisioning {
+		i--
+		if m.TimeBasedProvisioning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PhaseInflationRates) > 0 {
+		for iNdEx := len(m.PhaseInflationRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PhaseInflationRates[iNdEx].Size()
+				i -= size
+				if _, err := m.PhaseInflationRates[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.WeightedAddresses) > 0 {
+		for iNdEx := len(m.WeightedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Staking.Size()
+		i -= size
+		if _, err := m.Staking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Phase != 0 {
+		n += 1 + sovMint(uint64(m.Phase))
+	}
+	if m.StartPhaseBlock != 0 {
+		n += 1 + sovMint(uint64(m.StartPhaseBlock))
+	}
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreviousBlockTime)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if len(m.PhaseInflationRates) > 0 {
+		for _, e := range m.PhaseInflationRates {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.TimeBasedProvisioning {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBlockDuration)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Staking.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedAddresses) > 0 {
+		for _, e := range m.WeightedAddresses {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.Burn.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ContractBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPhaseBlock", wireType)
+			}
+			m.StartPhaseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPhaseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreviousBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseInflationRates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.PhaseInflationRates = append(m.PhaseInflationRates, v)
+			if err := m.PhaseInflationRates[len(m.PhaseInflationRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBasedProvisioning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeBasedProvisioning = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxBlockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.Phase != 0 {
-		n += 1 + sovMint(uint64(m.Phase))
-	}
-	if m.StartPhaseBlock != 0 {
-		n += 1 + sovMint(uint64(m.StartPhaseBlock))
-	}
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.TargetSupply.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -348,15 +1302,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -384,15 +1338,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Staking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
-			m.Phase = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -402,16 +1356,31 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartPhaseBlock", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAddresses", wireType)
 			}
-			m.StartPhaseBlock = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -421,14 +1390,29 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartPhaseBlock |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedAddresses = append(m.WeightedAddresses, WeightedAddress{})
+			if err := m.WeightedAddresses[len(m.WeightedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -456,13 +1440,63 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -490,7 +1524,39 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -515,7 +1581,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ContractBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -538,15 +1604,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -574,13 +1640,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.BlocksPerYear = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -590,11 +1656,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
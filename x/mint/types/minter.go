@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -48,33 +49,20 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
-// PhaseInflationRate returns the inflation rate by phase.
-func (m Minter) PhaseInflationRate(phase uint64) sdk.Dec {
-	switch {
-	case phase > 12:
+// PhaseInflationRate returns the inflation rate by phase, as defined by the
+// phase inflation rates of the params. There is no inflation after the last
+// phase of the schedule.
+func (m Minter) PhaseInflationRate(params Params, phase uint64) sdk.Dec {
+	if phase == 0 || phase > uint64(len(params.PhaseInflationRates)) {
 		return sdk.ZeroDec()
-
-	case phase == 1:
-		return sdk.NewDecWithPrec(40, 2)
-
-	case phase == 2:
-		return sdk.NewDecWithPrec(20, 2)
-
-	case phase == 3:
-		return sdk.NewDecWithPrec(10, 2)
-
-	default:
-		// Phase4:  9%
-		// Phase5:  8%
-		// Phase6:  7%
-		// ...
-		// Phase12: 1%
-		return sdk.NewDecWithPrec(13-int64(phase), 2)
 	}
+
+	return params.PhaseInflationRates[phase-1]
 }
 
-// NextPhase returns the new phase.
-func (m Minter) NextPhase(_ Params, currentSupply math.Int) uint64 {
+// NextPhase returns the new phase. The phase does not move past the end of
+// the inflation schedule.
+func (m Minter) NextPhase(params Params, currentSupply math.Int) uint64 {
 	nonePhase := m.Phase == 0
 	if nonePhase {
 		return 1
@@ -84,20 +72,138 @@ func (m Minter) NextPhase(_ Params, currentSupply math.Int) uint64 {
 		return m.Phase
 	}
 
+	if m.Phase > uint64(len(params.PhaseInflationRates)) {
+		return m.Phase
+	}
+
 	return m.Phase + 1
 }
 
+// SyncSchedule returns the minter updated for a new phase inflation schedule.
+// When the current phase is dropped from the schedule, the inflation stops at
+// once. When phases are added after the end of the schedule, the first added
+// phase starts at the given height, instead of being skipped.
+func (m Minter) SyncSchedule(params Params, totalSupply math.Int, height uint64) Minter {
+	if m.Phase == 0 {
+		return m
+	}
+
+	rate := m.PhaseInflationRate(params, m.Phase)
+	switch {
+	case m.Phase > uint64(len(params.PhaseInflationRates)) && !m.Inflation.IsZero():
+		m.Inflation = sdk.ZeroDec()
+		m.AnnualProvisions = sdk.ZeroDec()
+		m.TargetSupply = totalSupply
+	case m.Inflation.IsZero() && !rate.IsZero():
+		m.Inflation = rate
+		m.StartPhaseBlock = height
+		m.AnnualProvisions = m.NextAnnualProvisions(params, totalSupply)
+		m.TargetSupply = totalSupply.Add(m.AnnualProvisions.TruncateInt())
+	}
+
+	return m
+}
+
+// ProjectSupply returns the projected supply at the end of the current phase
+// and of every following phase of the inflation schedule, along with the final
+// supply once the schedule has ended. Each phase mints its inflation rate of
+// the supply at the start of the phase.
+func (m Minter) ProjectSupply(params Params, totalSupply math.Int) ([]PhaseProjection, math.Int) {
+	projections := []PhaseProjection{}
+	supply := totalSupply
+
+	phase := m.Phase
+	if phase > 0 && phase <= uint64(len(params.PhaseInflationRates)) {
+		if m.TargetSupply.GT(supply) {
+			supply = m.TargetSupply
+		}
+
+		projections = append(projections, PhaseProjection{
+			Phase:        phase,
+			Inflation:    m.Inflation,
+			TargetSupply: supply,
+		})
+	}
+
+	for phase++; phase <= uint64(len(params.PhaseInflationRates)); phase++ {
+		inflation := m.PhaseInflationRate(params, phase)
+		supply = supply.Add(inflation.MulInt(supply).TruncateInt())
+
+		projections = append(projections, PhaseProjection{
+			Phase:        phase,
+			Inflation:    inflation,
+			TargetSupply: supply,
+		})
+	}
+
+	return projections, supply
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) sdk.Dec {
 	return m.Inflation.MulInt(totalSupply)
 }
 
+// PhaseProgress returns the share of the current phase provisions already
+// minted, between 0 and 1. Burns reduce both the supply and the target supply,
+// so they do not affect the progress.
+func (m Minter) PhaseProgress(totalSupply math.Int) sdk.Dec {
+	if !m.AnnualProvisions.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	remaining := sdk.NewDecFromInt(m.TargetSupply.Sub(totalSupply))
+	progress := sdk.OneDec().Sub(remaining.Quo(m.AnnualProvisions))
+
+	switch {
+	case progress.IsNegative():
+		return sdk.ZeroDec()
+	case progress.GT(sdk.OneDec()):
+		return sdk.OneDec()
+	default:
+		return progress
+	}
+}
+
+// YearDuration is the duration of a year used by time-based provisioning.
+const YearDuration = 8766 * time.Hour
+
 // BlockProvision returns the provisions for a block based on the annual
 // provisions rate.
 func (m Minter) BlockProvision(params Params, totalSupply math.Int) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 
+	return m.capProvision(params, totalSupply, provisionAmt)
+}
+
+// BlockElapsedTime returns the time elapsed between the previous block and the
+// given block time, capped to the max block duration. It is zero if the
+// previous block time is unknown.
+func (m Minter) BlockElapsedTime(params Params, blockTime time.Time) time.Duration {
+	if m.PreviousBlockTime.IsZero() || !blockTime.After(m.PreviousBlockTime) {
+		return 0
+	}
+
+	elapsed := blockTime.Sub(m.PreviousBlockTime)
+	if elapsed > params.MaxBlockDuration {
+		return params.MaxBlockDuration
+	}
+
+	return elapsed
+}
+
+// TimeBlockProvision returns the provisions for a block based on the annual
+// provisions rate and the time elapsed since the previous block.
+func (m Minter) TimeBlockProvision(params Params, totalSupply math.Int, elapsed time.Duration) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.MulInt64(elapsed.Nanoseconds()).QuoInt64(YearDuration.Nanoseconds())
+
+	return m.capProvision(params, totalSupply, provisionAmt)
+}
+
+// capProvision limits the provisions so that the target supply of the phase is
+// not exceeded.
+func (m Minter) capProvision(params Params, totalSupply math.Int, provisionAmt sdk.Dec) sdk.Coin {
 	// Because of rounding, we might mint too many tokens in this phase, let's limit it
 	futureSupply := totalSupply.Add(provisionAmt.TruncateInt())
 	if futureSupply.GT(m.TargetSupply) {
@@ -3,6 +3,7 @@ package types
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 
 func TestPhaseInflation(t *testing.T) {
 	minter := DefaultInitialMinter()
+	params := DefaultParams()
 
 	// Governing Mechanism:
 	//    Juno tokenomics
@@ -51,7 +53,7 @@ func TestPhaseInflation(t *testing.T) {
 		{23, sdk.NewDecWithPrec(0, 2)},
 	}
 	for i, tc := range tests {
-		inflation := minter.PhaseInflationRate(tc.phase)
+		inflation := minter.PhaseInflationRate(params, tc.phase)
 
 		require.True(t, inflation.Equal(tc.expInflation),
 			"Test Index: %v\nInflation:  %v\nExpected: %v\n", i, inflation, tc.expInflation)
@@ -92,6 +94,136 @@ func TestNextPhase(t *testing.T) {
 	}
 }
 
+func TestCustomPhaseInflation(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.PhaseInflationRates = []sdk.Dec{sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(5, 2)}
+
+	require.Equal(t, sdk.ZeroDec(), minter.PhaseInflationRate(params, 0))
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), minter.PhaseInflationRate(params, 1))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), minter.PhaseInflationRate(params, 2))
+	require.Equal(t, sdk.ZeroDec(), minter.PhaseInflationRate(params, 3))
+
+	// the phase does not move past the end of the schedule
+	minter.TargetSupply = sdk.NewInt(100)
+	minter.Phase = 2
+	require.Equal(t, uint64(3), minter.NextPhase(params, sdk.NewInt(100)))
+	minter.Phase = 3
+	require.Equal(t, uint64(3), minter.NextPhase(params, sdk.NewInt(100)))
+}
+
+func TestSyncSchedule(t *testing.T) {
+	params := DefaultParams()
+	params.PhaseInflationRates = []sdk.Dec{sdk.NewDecWithPrec(15, 2), sdk.NewDecWithPrec(5, 2)}
+	supply := sdk.NewInt(1_000)
+
+	// the minter is in the second phase of the schedule
+	minter := NewMinter(sdk.NewDecWithPrec(5, 2), sdk.NewDec(50), 2, 10, sdk.NewInt(1_050))
+	require.Equal(t, minter, minter.SyncSchedule(params, supply, 20))
+
+	// the schedule is shortened below the current phase
+	shortened := params
+	shortened.PhaseInflationRates = params.PhaseInflationRates[:1]
+	ended := minter.SyncSchedule(shortened, supply, 20)
+	require.Equal(t, uint64(2), ended.Phase)
+	require.True(t, ended.Inflation.IsZero())
+	require.True(t, ended.AnnualProvisions.IsZero())
+	require.Equal(t, supply, ended.TargetSupply)
+	require.True(t, ended.BlockProvision(shortened, supply).IsZero())
+
+	// the schedule is extended after its end, the first added phase starts
+	ended.Phase = 3
+	extended := params
+	extended.PhaseInflationRates = append(params.PhaseInflationRates, sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	started := ended.SyncSchedule(extended, supply, 30)
+	require.Equal(t, uint64(3), started.Phase)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), started.Inflation)
+	require.Equal(t, uint64(30), started.StartPhaseBlock)
+	require.Equal(t, sdk.NewDec(20), started.AnnualProvisions)
+	require.Equal(t, sdk.NewInt(1_020), started.TargetSupply)
+	require.Equal(t, uint64(3), started.NextPhase(extended, supply))
+
+	// the first phase is started by the BeginBlocker
+	initial := DefaultInitialMinter()
+	require.Equal(t, initial, initial.SyncSchedule(params, supply, 1))
+}
+
+func TestProjectSupply(t *testing.T) {
+	params := DefaultParams()
+	params.PhaseInflationRates = []sdk.Dec{sdk.NewDecWithPrec(40, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(10, 2)}
+
+	// before the first phase, the projection starts from the current supply
+	minter := DefaultInitialMinter()
+	projections, finalSupply := minter.ProjectSupply(params, sdk.NewInt(1_000))
+	require.Len(t, projections, 3)
+	require.Equal(t, sdk.NewInt(1_400), projections[0].TargetSupply)
+	require.Equal(t, sdk.NewInt(1_680), projections[1].TargetSupply)
+	require.Equal(t, sdk.NewInt(1_848), projections[2].TargetSupply)
+	require.Equal(t, sdk.NewInt(1_848), finalSupply)
+
+	// during a phase, the projection starts from the phase target supply
+	minter = NewMinter(sdk.NewDecWithPrec(20, 2), sdk.NewDec(280), 2, 10, sdk.NewInt(1_680))
+	projections, finalSupply = minter.ProjectSupply(params, sdk.NewInt(1_500))
+	require.Len(t, projections, 2)
+	require.Equal(t, uint64(2), projections[0].Phase)
+	require.Equal(t, sdk.NewInt(1_680), projections[0].TargetSupply)
+	require.Equal(t, uint64(3), projections[1].Phase)
+	require.Equal(t, sdk.NewInt(1_848), finalSupply)
+
+	// after the schedule, the supply does not change
+	minter.Phase = 4
+	projections, finalSupply = minter.ProjectSupply(params, sdk.NewInt(2_000))
+	require.Empty(t, projections)
+	require.Equal(t, sdk.NewInt(2_000), finalSupply)
+}
+
+func TestTimeBlockProvision(t *testing.T) {
+	params := DefaultParams()
+	params.TimeBasedProvisioning = true
+	params.MaxBlockDuration = 30 * time.Second
+
+	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	minter.AnnualProvisions = sdk.NewDec(YearDuration.Nanoseconds() / int64(time.Second))
+	minter.TargetSupply = sdk.NewInt(1_000_000_000)
+	totalSupply := sdk.NewInt(100_000_000)
+	now := time.Now().UTC()
+
+	// the first block does not know the previous block time
+	elapsed := minter.BlockElapsedTime(params, now)
+	require.Zero(t, elapsed)
+	require.True(t, minter.TimeBlockProvision(params, totalSupply, elapsed).IsZero())
+
+	// one token is minted per elapsed second, whatever the block time
+	for _, blockTime := range []time.Duration{time.Second, 5 * time.Second, 17 * time.Second} {
+		minter.PreviousBlockTime = now.Add(-blockTime)
+		elapsed = minter.BlockElapsedTime(params, now)
+		require.Equal(t, blockTime, elapsed)
+		require.Equal(t, sdk.NewInt(int64(blockTime/time.Second)), minter.TimeBlockProvision(params, totalSupply, elapsed).Amount)
+	}
+
+	// the elapsed time is capped
+	minter.PreviousBlockTime = now.Add(-time.Hour)
+	elapsed = minter.BlockElapsedTime(params, now)
+	require.Equal(t, params.MaxBlockDuration, elapsed)
+	require.Equal(t, sdk.NewInt(30), minter.TimeBlockProvision(params, totalSupply, elapsed).Amount)
+
+	// the target supply is not exceeded
+	minter.TargetSupply = totalSupply.AddRaw(10)
+	require.Equal(t, sdk.NewInt(10), minter.TimeBlockProvision(params, totalSupply, elapsed).Amount)
+}
+
+func TestPhaseProgress(t *testing.T) {
+	minter := NewMinter(sdk.NewDecWithPrec(1, 1), sdk.NewDec(1_000), 1, 1, sdk.NewInt(11_000))
+
+	require.True(t, sdk.ZeroDec().Equal(minter.PhaseProgress(sdk.NewInt(10_000))))
+	require.True(t, sdk.NewDecWithPrec(25, 2).Equal(minter.PhaseProgress(sdk.NewInt(10_250))))
+	require.True(t, sdk.OneDec().Equal(minter.PhaseProgress(sdk.NewInt(11_000))))
+	require.True(t, sdk.OneDec().Equal(minter.PhaseProgress(sdk.NewInt(12_000))))
+
+	minter.AnnualProvisions = sdk.ZeroDec()
+	require.True(t, sdk.ZeroDec().Equal(minter.PhaseProgress(sdk.NewInt(10_000))))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -163,11 +295,12 @@ func BenchmarkBlockProvision(b *testing.B) {
 func BenchmarkPhaseInflation(b *testing.B) {
 	b.ReportAllocs()
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
 	phase := uint64(4)
 
 	// run the PhaseInflationRate function b.N times
 	for n := 0; n < b.N; n++ {
-		minter.PhaseInflationRate(phase)
+		minter.PhaseInflationRate(params, phase)
 	}
 }
 
@@ -4,26 +4,68 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultMaxBlockDuration is the default maximum elapsed time accounted for a
+// single block by time-based provisioning.
+const DefaultMaxBlockDuration = time.Minute
+
 func NewParams(
-	mintDenom string, blocksPerYear uint64,
+	mintDenom string, blocksPerYear uint64, phaseInflationRates []sdk.Dec, distributionProportions DistributionProportions,
+	timeBasedProvisioning bool, maxBlockDuration time.Duration,
 ) Params {
 	return Params{
-		MintDenom:     mintDenom,
-		BlocksPerYear: blocksPerYear,
+		MintDenom:               mintDenom,
+		BlocksPerYear:           blocksPerYear,
+		PhaseInflationRates:     phaseInflationRates,
+		DistributionProportions: distributionProportions,
+		TimeBasedProvisioning:   timeBasedProvisioning,
+		MaxBlockDuration:        maxBlockDuration,
 	}
 }
 
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		MintDenom:     sdk.DefaultBondDenom,
-		BlocksPerYear: uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:               sdk.DefaultBondDenom,
+		BlocksPerYear:           uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		PhaseInflationRates:     DefaultPhaseInflationRates(),
+		DistributionProportions: DefaultDistributionProportions(),
+		TimeBasedProvisioning:   false,
+		MaxBlockDuration:        DefaultMaxBlockDuration,
+	}
+}
+
+// DefaultPhaseInflationRates returns the Juno tokenomics inflation schedule:
+// 40%, 20% and 10% for the first three phases, then decreasing by 1% per phase
+// from 9% in phase 4 down to 1% in phase 12.
+func DefaultPhaseInflationRates() []sdk.Dec {
+	rates := []sdk.Dec{
+		sdk.NewDecWithPrec(40, 2),
+		sdk.NewDecWithPrec(20, 2),
+		sdk.NewDecWithPrec(10, 2),
+	}
+
+	for phase := int64(4); phase <= 12; phase++ {
+		rates = append(rates, sdk.NewDecWithPrec(13-phase, 2))
+	}
+
+	return rates
+}
+
+// DefaultDistributionProportions returns the default distribution of the
+// minted tokens, which are all sent to stakers.
+func DefaultDistributionProportions() DistributionProportions {
+	return DistributionProportions{
+		Staking:           sdk.OneDec(),
+		CommunityPool:     sdk.ZeroDec(),
+		WeightedAddresses: []WeightedAddress{},
+		Burn:              sdk.ZeroDec(),
 	}
 }
 
@@ -32,9 +74,23 @@ func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
 		return err
 	}
-	err := validateBlocksPerYear(p.BlocksPerYear)
+	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
+		return err
+	}
+	if err := validatePhaseInflationRates(p.PhaseInflationRates); err != nil {
+		return err
+	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateMaxBlockDuration(p.MaxBlockDuration); err != nil {
+		return err
+	}
+	if p.TimeBasedProvisioning && p.MaxBlockDuration == 0 {
+		return errors.New("max block duration must be positive with time-based provisioning")
+	}
 
-	return err
+	return nil
 }
 
 // String implements the Stringer interface.
@@ -68,3 +124,81 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validatePhaseInflationRates(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for phase, rate := range v {
+		if rate.IsNil() {
+			return fmt.Errorf("phase %d inflation rate cannot be nil", phase+1)
+		}
+		if rate.IsNegative() {
+			return fmt.Errorf("phase %d inflation rate cannot be negative: %s", phase+1, rate)
+		}
+		if rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("phase %d inflation rate too large: %s", phase+1, rate)
+		}
+	}
+
+	return nil
+}
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.(DistributionProportions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Staking.IsNil() || v.Staking.IsNegative() {
+		return errors.New("staking distribution proportion must be non-negative")
+	}
+
+	if v.CommunityPool.IsNil() || v.CommunityPool.IsNegative() {
+		return errors.New("community pool distribution proportion must be non-negative")
+	}
+
+	if v.Burn.IsNil() || v.Burn.IsNegative() {
+		return errors.New("burn distribution proportion must be non-negative")
+	}
+
+	total := v.Staking.Add(v.CommunityPool).Add(v.Burn)
+	seen := make(map[string]bool, len(v.WeightedAddresses))
+	for _, wa := range v.WeightedAddresses {
+		if _, err := sdk.AccAddressFromBech32(wa.Address); err != nil {
+			return fmt.Errorf("invalid weighted address %s: %w", wa.Address, err)
+		}
+
+		if seen[wa.Address] {
+			return fmt.Errorf("duplicate weighted address %s", wa.Address)
+		}
+		seen[wa.Address] = true
+
+		if wa.Weight.IsNil() || !wa.Weight.IsPositive() {
+			return fmt.Errorf("weight of address %s must be positive", wa.Address)
+		}
+
+		total = total.Add(wa.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+func validateMaxBlockDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max block duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
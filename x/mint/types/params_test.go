@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateDistributionProportions(t *testing.T) {
+	addr := sdk.AccAddress([]byte("dev_fund")).String()
+	addr2 := sdk.AccAddress([]byte("other_fund")).String()
+
+	testCases := []struct {
+		name        string
+		proportions DistributionProportions
+		expErr      bool
+	}{
+		{"default", DefaultDistributionProportions(), false},
+		{
+			"staking, community pool and addresses",
+			DistributionProportions{
+				Staking:       sdk.NewDecWithPrec(7, 1),
+				CommunityPool: sdk.NewDecWithPrec(2, 1),
+				WeightedAddresses: []WeightedAddress{
+					{Address: addr, Weight: sdk.NewDecWithPrec(5, 2)},
+					{Address: addr2, Weight: sdk.NewDecWithPrec(5, 2)},
+				},
+				Burn: sdk.ZeroDec(),
+			},
+			false,
+		},
+		{
+			"staking and burn",
+			DistributionProportions{Staking: sdk.NewDecWithPrec(9, 1), CommunityPool: sdk.ZeroDec(), Burn: sdk.NewDecWithPrec(1, 1)},
+			false,
+		},
+		{
+			"negative burn",
+			DistributionProportions{Staking: sdk.NewDecWithPrec(11, 1), CommunityPool: sdk.ZeroDec(), Burn: sdk.NewDecWithPrec(-1, 1)},
+			true,
+		},
+		{
+			"nil burn",
+			DistributionProportions{Staking: sdk.OneDec(), CommunityPool: sdk.ZeroDec()},
+			true,
+		},
+		{
+			"does not sum to 1",
+			DistributionProportions{Staking: sdk.NewDecWithPrec(7, 1), CommunityPool: sdk.NewDecWithPrec(2, 1)},
+			true,
+		},
+		{
+			"negative proportion",
+			DistributionProportions{Staking: sdk.NewDecWithPrec(12, 1), CommunityPool: sdk.NewDecWithPrec(-2, 1)},
+			true,
+		},
+		{
+			"nil proportion",
+			DistributionProportions{Staking: sdk.OneDec()},
+			true,
+		},
+		{
+			"invalid address",
+			DistributionProportions{
+				Staking:           sdk.NewDecWithPrec(9, 1),
+				CommunityPool:     sdk.ZeroDec(),
+				WeightedAddresses: []WeightedAddress{{Address: "invalid", Weight: sdk.NewDecWithPrec(1, 1)}},
+			},
+			true,
+		},
+		{
+			"duplicate address",
+			DistributionProportions{
+				Staking:       sdk.NewDecWithPrec(8, 1),
+				CommunityPool: sdk.ZeroDec(),
+				WeightedAddresses: []WeightedAddress{
+					{Address: addr, Weight: sdk.NewDecWithPrec(1, 1)},
+					{Address: addr, Weight: sdk.NewDecWithPrec(1, 1)},
+				},
+			},
+			true,
+		},
+		{
+			"zero weight",
+			DistributionProportions{
+				Staking:           sdk.OneDec(),
+				CommunityPool:     sdk.ZeroDec(),
+				WeightedAddresses: []WeightedAddress{{Address: addr, Weight: sdk.ZeroDec()}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateDistributionProportions(tc.proportions)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateTimeBasedProvisioning(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.TimeBasedProvisioning = true
+	require.NoError(t, params.Validate())
+
+	params.MaxBlockDuration = 0
+	require.Error(t, params.Validate())
+
+	params.TimeBasedProvisioning = false
+	require.NoError(t, params.Validate())
+
+	params.MaxBlockDuration = -time.Second
+	require.Error(t, params.Validate())
+}
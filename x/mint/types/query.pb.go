@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -346,574 +348,2832 @@ func (m *QueryTargetSupplyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTargetSupplyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "juno.mint.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "juno.mint.QueryParamsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "juno.mint.QueryInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "juno.mint.QueryInflationResponse")
-	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "juno.mint.QueryAnnualProvisionsRequest")
-	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "juno.mint.QueryAnnualProvisionsResponse")
-	proto.RegisterType((*QueryTargetSupplyRequest)(nil), "juno.mint.QueryTargetSupplyRequest")
-	proto.RegisterType((*QueryTargetSupplyResponse)(nil), "juno.mint.QueryTargetSupplyResponse")
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
 }
 
-func init() { proto.RegisterFile("juno/mint/query.proto", fileDescriptor_a6f0d4f2a25816bd) }
-
-var fileDescriptor_a6f0d4f2a25816bd = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x41,
-	0x14, 0xc6, 0xb3, 0x52, 0x0b, 0x79, 0x46, 0x68, 0xc7, 0x54, 0xeb, 0xda, 0x6c, 0xe3, 0xb6, 0xc4,
-	0x20, 0xba, 0x43, 0xeb, 0x5f, 0x60, 0x2a, 0x85, 0x82, 0x87, 0x9a, 0x7a, 0xd2, 0x43, 0x99, 0xc4,
-	0xed, 0xba, 0xba, 0x99, 0x99, 0xee, 0xcc, 0x96, 0x06, 0x3c, 0x09, 0x9e, 0x15, 0xc4, 0xff, 0xa9,
-	0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0xf1, 0x0f, 0x91, 0x7d, 0x3b, 0x9b, 0xa6, 0xdb, 0x4d, 0x85,
-	0x5e, 0x92, 0xf0, 0xbe, 0xc7, 0xf7, 0xfd, 0xc8, 0xfb, 0x18, 0x58, 0xfa, 0x90, 0x70, 0x41, 0x07,
-	0x21, 0xd7, 0xf4, 0x30, 0xf1, 0xe3, 0xa1, 0x27, 0x63, 0xa1, 0x05, 0xa9, 0xa6, 0x63, 0x2f, 0x1d,
-	0xdb, 0xf5, 0x40, 0x04, 0x02, 0xa7, 0x34, 0xfd, 0x95, 0x2d, 0xd8, 0x2b, 0x81, 0x10, 0x41, 0xe4,
-	0x53, 0x26, 0x43, 0xca, 0x38, 0x17, 0x9a, 0xe9, 0x50, 0x70, 0x65, 0xd4, 0xfa, 0xb9, 0x6b, 0xfa,
-	0x91, 0x4d, 0xdd, 0x3a, 0x90, 0x57, 0x69, 0xc6, 0x2e, 0x8b, 0xd9, 0x40, 0x75, 0xfd, 0xc3, 0xc4,
-	0x57, 0xda, 0xdd, 0x86, 0x3b, 0x17, 0xa6, 0x4a, 0x0a, 0xae, 0x7c, 0x42, 0x61, 0x5e, 0xe2, 0x64,
-	0xd9, 0x6a, 0x5a, 0xed, 0x5b, 0x9b, 0x8b, 0xde, 0x04, 0xc9, 0xcb, 0x56, 0x3b, 0x73, 0x27, 0x67,
-	0xab, 0x95, 0xae, 0x59, 0x73, 0xef, 0xc1, 0x12, 0xfa, 0xec, 0xf0, 0x83, 0x08, 0x61, 0xf2, 0x80,
-	0x03, 0xb8, 0x5b, 0x14, 0x4c, 0xc6, 0x4b, 0xa8, 0x86, 0xf9, 0x10, 0x63, 0x6a, 0x1d, 0x2f, 0xf5,
-	0xfc, 0x7d, 0xb6, 0xda, 0x0a, 0x42, 0xfd, 0x3e, 0xe9, 0x79, 0x7d, 0x31, 0xa0, 0x7d, 0xa1, 0x06,
-	0x42, 0x99, 0xaf, 0xa7, 0xea, 0xdd, 0x47, 0xaa, 0x87, 0xd2, 0x57, 0xde, 0x0b, 0xbf, 0xdf, 0x3d,
-	0x37, 0x70, 0x1d, 0x58, 0xc1, 0x9c, 0xe7, 0x9c, 0x27, 0x2c, 0xda, 0x8d, 0xc5, 0x51, 0xa8, 0xd2,
-	0xff, 0x24, 0xe7, 0xf8, 0x04, 0x8d, 0x19, 0xba, 0xc1, 0x79, 0x0b, 0x8b, 0x0c, 0xb5, 0x7d, 0x39,
-	0x11, 0xaf, 0x89, 0xb5, 0xc0, 0x0a, 0x21, 0xae, 0x0d, 0xcb, 0x98, 0xfe, 0x9a, 0xc5, 0x81, 0xaf,
-	0xf7, 0x12, 0x29, 0xa3, 0x61, 0x4e, 0x26, 0xe1, 0x7e, 0x89, 0x66, 0xa8, 0xf6, 0xe0, 0xb6, 0xc6,
-	0xf9, 0xbe, 0x42, 0xe1, 0x1a, 0x44, 0x3b, 0x5c, 0x77, 0x6b, 0x7a, 0xca, 0x7c, 0xf3, 0xeb, 0x1c,
-	0xdc, 0xc4, 0x48, 0x12, 0xc1, 0x7c, 0x76, 0x4e, 0xd2, 0x98, 0xba, 0xf0, 0xe5, 0x9e, 0xd8, 0xce,
-	0x2c, 0x39, 0xe3, 0x74, 0xd7, 0x3e, 0xff, 0xfc, 0xfb, 0xfd, 0x46, 0x83, 0x3c, 0xc8, 0x31, 0xb0,
-	0x7e, 0x47, 0x1b, 0x3d, 0x5f, 0xb3, 0x0d, 0x9a, 0x95, 0x84, 0x1c, 0x43, 0x75, 0x52, 0x03, 0xd2,
-	0x2c, 0x3a, 0x16, 0xab, 0x63, 0x3f, 0xbc, 0x62, 0xc3, 0xc4, 0xb6, 0x30, 0xb6, 0x49, 0x9c, 0xd2,
-	0xd8, 0x49, 0x3b, 0xc8, 0x0f, 0x0b, 0x16, 0x8a, 0x97, 0x27, 0x8f, 0x8a, 0xfe, 0x33, 0xba, 0x63,
-	0xb7, 0xff, 0xbf, 0x68, 0x78, 0x3c, 0xe4, 0x69, 0x93, 0x56, 0x29, 0xcf, 0xa5, 0x7e, 0x91, 0x2f,
-	0x16, 0xd4, 0xa6, 0xef, 0x4e, 0xd6, 0x8a, 0x51, 0x25, 0x8d, 0xb1, 0xd7, 0xaf, 0x5e, 0x32, 0x2c,
-	0x8f, 0x91, 0x65, 0x9d, 0xb8, 0xa5, 0x2c, 0x17, 0x5a, 0xd5, 0xd9, 0x3e, 0x19, 0x39, 0xd6, 0xe9,
-	0xc8, 0xb1, 0xfe, 0x8c, 0x1c, 0xeb, 0xdb, 0xd8, 0xa9, 0x9c, 0x8e, 0x9d, 0xca, 0xaf, 0xb1, 0x53,
-	0x79, 0xf3, 0x64, 0xaa, 0x61, 0x5b, 0xe8, 0xb3, 0x25, 0xb8, 0x8e, 0x59, 0x5f, 0x2b, 0x8a, 0xef,
-	0xcc, 0x71, 0xe6, 0x8b, 0x5d, 0xeb, 0xcd, 0xe3, 0x5b, 0xf3, 0xec, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa7, 0x3d, 0x8c, 0xe6, 0xd9, 0x04, 0x00, 0x00,
+func (m *QueryInflationScheduleRequest) Reset()         { *m = QueryInflationScheduleRequest{} }
+func (m *QueryInflationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleRequest) ProtoMessage()    {}
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{8}
+}
+func (m *QueryInflationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleRequest.Merge(m, src)
+}
+func (m *QueryInflationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryInflationScheduleRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
-	// TargetSupply current target supply for this phase value.
-	TargetSupply(ctx context.Context, in *QueryTargetSupplyRequest, opts ...grpc.CallOption) (*QueryTargetSupplyResponse, error)
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
+	// current_phase is the current inflation phase.
+	CurrentPhase uint64 `protobuf:"varint,1,opt,name=current_phase,json=currentPhase,proto3" json:"current_phase,omitempty"`
+	// phases are the inflation rates of every phase.
+	Phases []PhaseInflation `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryInflationScheduleResponse) Reset()         { *m = QueryInflationScheduleResponse{} }
+func (m *QueryInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleResponse) ProtoMessage()    {}
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{9}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/juno.mint.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleResponse.Merge(m, src)
+}
+func (m *QueryInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/juno.mint.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInflationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleResponse) GetCurrentPhase() uint64 {
+	if m != nil {
+		return m.CurrentPhase
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
-	out := new(QueryAnnualProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/juno.mint.Query/AnnualProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInflationScheduleResponse) GetPhases() []PhaseInflation {
+	if m != nil {
+		return m.Phases
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TargetSupply(ctx context.Context, in *QueryTargetSupplyRequest, opts ...grpc.CallOption) (*QueryTargetSupplyResponse, error) {
-	out := new(QueryTargetSupplyResponse)
-	err := c.cc.Invoke(ctx, "/juno.mint.Query/TargetSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+// PhaseInflation defines the inflation rate of a phase.
+type PhaseInflation struct {
+	// phase is the phase number, starting at 1.
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// inflation is the inflation rate of the phase.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *PhaseInflation) Reset()         { *m = PhaseInflation{} }
+func (m *PhaseInflation) String() string { return proto.CompactTextString(m) }
+func (*PhaseInflation) ProtoMessage()    {}
+func (*PhaseInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{10}
+}
+func (m *PhaseInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PhaseInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PhaseInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseInflation.Merge(m, src)
+}
+func (m *PhaseInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseInflation.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
-	// TargetSupply current target supply for this phase value.
-	TargetSupply(context.Context, *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error)
+var xxx_messageInfo_PhaseInflation proto.InternalMessageInfo
+
+func (m *PhaseInflation) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryProjectedSupplyRequest is the request type for the
+// Query/ProjectedSupply RPC method.
+type QueryProjectedSupplyRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryProjectedSupplyRequest) Reset()         { *m = QueryProjectedSupplyRequest{} }
+func (m *QueryProjectedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyRequest) ProtoMessage()    {}
+func (*QueryProjectedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{11}
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+func (m *QueryProjectedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+func (m *QueryProjectedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TargetSupply(ctx context.Context, req *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TargetSupply not implemented")
+func (m *QueryProjectedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyRequest.Merge(m, src)
+}
+func (m *QueryProjectedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryProjectedSupplyRequest proto.InternalMessageInfo
+
+// QueryProjectedSupplyResponse is the response type for the
+// Query/ProjectedSupply RPC method.
+type QueryProjectedSupplyResponse struct {
+	// projections are the projected supplies at the end of the current and
+	// future phases.
+	Projections []PhaseProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+	// final_supply is the projected supply once the last phase has ended.
+	FinalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=final_supply,json=finalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"final_supply"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.mint.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryProjectedSupplyResponse) Reset()         { *m = QueryProjectedSupplyResponse{} }
+func (m *QueryProjectedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedSupplyResponse) ProtoMessage()    {}
+func (*QueryProjectedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{12}
+}
+func (m *QueryProjectedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedSupplyResponse.Merge(m, src)
+}
+func (m *QueryProjectedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedSupplyResponse.DiscardUnknown(m)
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.mint.Query/Inflation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+var xxx_messageInfo_QueryProjectedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedSupplyResponse) GetProjections() []PhaseProjection {
+	if m != nil {
+		return m.Projections
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAnnualProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AnnualProvisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.mint.Query/AnnualProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// PhaseProjection defines the projected supply at the end of a phase.
+type PhaseProjection struct {
+	// phase is the phase number, starting at 1.
+	Phase uint64 `protobuf:"varint,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	// inflation is the inflation rate of the phase.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// target_supply is the projected supply at the end of the phase.
+	TargetSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=target_supply,json=targetSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_supply"`
 }
 
-func _Query_TargetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTargetSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TargetSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.mint.Query/TargetSupply",
+func (m *PhaseProjection) Reset()         { *m = PhaseProjection{} }
+func (m *PhaseProjection) String() string { return proto.CompactTextString(m) }
+func (*PhaseProjection) ProtoMessage()    {}
+func (*PhaseProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{13}
+}
+func (m *PhaseProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PhaseProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TargetSupply(ctx, req.(*QueryTargetSupplyRequest))
+}
+func (m *PhaseProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseProjection.Merge(m, src)
+}
+func (m *PhaseProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseProjection proto.InternalMessageInfo
+
+func (m *PhaseProjection) GetPhase() uint64 {
+	if m != nil {
+		return m.Phase
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "juno.mint.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-		{
-			MethodName: "AnnualProvisions",
-			Handler:    _Query_AnnualProvisions_Handler,
-		},
-		{
-			MethodName: "TargetSupply",
-			Handler:    _Query_TargetSupply_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "juno/mint/query.proto",
+// QueryTokenomicsRequest is the request type for the Query/Tokenomics RPC
+// method.
+type QueryTokenomicsRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokenomicsRequest) Reset()         { *m = QueryTokenomicsRequest{} }
+func (m *QueryTokenomicsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenomicsRequest) ProtoMessage()    {}
+func (*QueryTokenomicsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{14}
+}
+func (m *QueryTokenomicsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenomicsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenomicsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTokenomicsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenomicsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryTokenomicsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTokenomicsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenomicsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTokenomicsRequest proto.InternalMessageInfo
+
+// QueryTokenomicsResponse is the response type for the Query/Tokenomics RPC
+// method.
+type QueryTokenomicsResponse struct {
+	// total_burned is the cumulative amount burned by contracts.
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+	// current_phase is the current inflation phase.
+	CurrentPhase uint64 `protobuf:"varint,2,opt,name=current_phase,json=currentPhase,proto3" json:"current_phase,omitempty"`
+	// inflation is the inflation rate of the current phase.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// total_supply is the current supply of the mint denom.
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+	// target_supply is the target supply of the current phase.
+	TargetSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=target_supply,json=targetSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_supply"`
+	// phase_progress is the share of the current phase provisions already
+	// minted, between 0 and 1.
+	PhaseProgress github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=phase_progress,json=phaseProgress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"phase_progress"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTokenomicsResponse) Reset()         { *m = QueryTokenomicsResponse{} }
+func (m *QueryTokenomicsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenomicsResponse) ProtoMessage()    {}
+func (*QueryTokenomicsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{15}
+}
+func (m *QueryTokenomicsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenomicsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenomicsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTokenomicsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenomicsResponse.Merge(m, src)
 }
-
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTokenomicsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenomicsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenomicsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryTokenomicsResponse proto.InternalMessageInfo
+
+func (m *QueryTokenomicsResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
 }
 
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokenomicsResponse) GetCurrentPhase() uint64 {
+	if m != nil {
+		return m.CurrentPhase
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractBurnRequest is the request type for the Query/ContractBurn RPC
+// method.
+type QueryContractBurnRequest struct {
+	// contract_address is the bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryContractBurnRequest) Reset()         { *m = QueryContractBurnRequest{} }
+func (m *QueryContractBurnRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractBurnRequest) ProtoMessage()    {}
+func (*QueryContractBurnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{16}
+}
+func (m *QueryContractBurnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractBurnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractBurnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractBurnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractBurnRequest.Merge(m, src)
 }
-
-func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractBurnRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryContractBurnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractBurnRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractBurnRequest proto.InternalMessageInfo
+
+func (m *QueryContractBurnRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractBurnResponse is the response type for the Query/ContractBurn
+// RPC method.
+type QueryContractBurnResponse struct {
+	// contract_burn is the cumulative amount burned by the contract.
+	ContractBurn ContractBurn `protobuf:"bytes,1,opt,name=contract_burn,json=contractBurn,proto3" json:"contract_burn"`
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryContractBurnResponse) Reset()         { *m = QueryContractBurnResponse{} }
+func (m *QueryContractBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractBurnResponse) ProtoMessage()    {}
+func (*QueryContractBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{17}
+}
+func (m *QueryContractBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractBurnResponse.Merge(m, src)
+}
+func (m *QueryContractBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractBurnResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTargetSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractBurnResponse proto.InternalMessageInfo
+
+func (m *QueryContractBurnResponse) GetContractBurn() ContractBurn {
+	if m != nil {
+		return m.ContractBurn
 	}
-	return dAtA[:n], nil
+	return ContractBurn{}
 }
 
-func (m *QueryTargetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractBurnsRequest is the request type for the Query/ContractBurns RPC
+// method.
+type QueryContractBurnsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTargetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryContractBurnsRequest) Reset()         { *m = QueryContractBurnsRequest{} }
+func (m *QueryContractBurnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractBurnsRequest) ProtoMessage()    {}
+func (*QueryContractBurnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{18}
+}
+func (m *QueryContractBurnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractBurnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractBurnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractBurnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractBurnsRequest.Merge(m, src)
+}
+func (m *QueryContractBurnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractBurnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractBurnsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTargetSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractBurnsRequest proto.InternalMessageInfo
+
+func (m *QueryContractBurnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTargetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractBurnsResponse is the response type for the Query/ContractBurns
+// RPC method.
+type QueryContractBurnsResponse struct {
+	// contract_burns are the cumulative amounts burned by each contract.
+	ContractBurns []ContractBurn `protobuf:"bytes,1,rep,name=contract_burns,json=contractBurns,proto3" json:"contract_burns"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTargetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TargetSupply.Size()
-		i -= size
-		if _, err := m.TargetSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryContractBurnsResponse) Reset()         { *m = QueryContractBurnsResponse{} }
+func (m *QueryContractBurnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractBurnsResponse) ProtoMessage()    {}
+func (*QueryContractBurnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f0d4f2a25816bd, []int{19}
+}
+func (m *QueryContractBurnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractBurnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractBurnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractBurnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractBurnsResponse.Merge(m, src)
+}
+func (m *QueryContractBurnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractBurnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractBurnsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryContractBurnsResponse proto.InternalMessageInfo
+
+func (m *QueryContractBurnsResponse) GetContractBurns() []ContractBurn {
+	if m != nil {
+		return m.ContractBurns
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryContractBurnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "juno.mint.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "juno.mint.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "juno.mint.QueryInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "juno.mint.QueryInflationResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "juno.mint.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "juno.mint.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryTargetSupplyRequest)(nil), "juno.mint.QueryTargetSupplyRequest")
+	proto.RegisterType((*QueryTargetSupplyResponse)(nil), "juno.mint.QueryTargetSupplyResponse")
+	proto.RegisterType((*QueryInflationScheduleRequest)(nil), "juno.mint.QueryInflationScheduleRequest")
+	proto.RegisterType((*QueryInflationScheduleResponse)(nil), "juno.mint.QueryInflationScheduleResponse")
+	proto.RegisterType((*PhaseInflation)(nil), "juno.mint.PhaseInflation")
+	proto.RegisterType((*QueryProjectedSupplyRequest)(nil), "juno.mint.QueryProjectedSupplyRequest")
+	proto.RegisterType((*QueryProjectedSupplyResponse)(nil), "juno.mint.QueryProjectedSupplyResponse")
+	proto.RegisterType((*PhaseProjection)(nil), "juno.mint.PhaseProjection")
+	proto.RegisterType((*QueryTokenomicsRequest)(nil), "juno.mint.QueryTokenomicsRequest")
+	proto.RegisterType((*QueryTokenomicsResponse)(nil), "juno.mint.QueryTokenomicsResponse")
+	proto.RegisterType((*QueryContractBurnRequest)(nil), "juno.mint.QueryContractBurnRequest")
+	proto.RegisterType((*QueryContractBurnResponse)(nil), "juno.mint.QueryContractBurnResponse")
+	proto.RegisterType((*QueryContractBurnsRequest)(nil), "juno.mint.QueryContractBurnsRequest")
+	proto.RegisterType((*QueryContractBurnsResponse)(nil), "juno.mint.QueryContractBurnsResponse")
+}
+
+func init() { proto.RegisterFile("juno/mint/query.proto", fileDescriptor_a6f0d4f2a25816bd) }
+
+var fileDescriptor_a6f0d4f2a25816bd = []byte{
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x89, 0x25, 0x3f, 0xdb, 0x69, 0x32, 0xa4, 0xc4, 0xd9, 0xc6, 0x6b, 0x77, 0x93,
+	0xd8, 0x4e, 0xa1, 0xbb, 0x34, 0x48, 0xc0, 0x09, 0xa9, 0x4e, 0x09, 0xaa, 0xc4, 0xc1, 0x71, 0xca,
+	0x05, 0x0e, 0xd6, 0x7a, 0xbd, 0x71, 0xb6, 0xb5, 0x77, 0xb6, 0x3b, 0xeb, 0xaa, 0x11, 0x85, 0x03,
+	0x12, 0x07, 0x6e, 0x20, 0xc4, 0xa1, 0x5f, 0x01, 0xce, 0x7c, 0x87, 0x8a, 0x53, 0x25, 0x2e, 0x88,
+	0x43, 0x41, 0x09, 0x7c, 0x0a, 0x2e, 0x68, 0x66, 0x67, 0xd7, 0xfb, 0xcf, 0x76, 0x70, 0xa4, 0x5e,
+	0xf2, 0xe7, 0xbd, 0x37, 0xef, 0xf7, 0x7b, 0x7f, 0xe6, 0xcd, 0x5b, 0xb8, 0xfe, 0x70, 0x64, 0x61,
+	0x75, 0x68, 0x5a, 0xae, 0xfa, 0x78, 0x64, 0x38, 0x67, 0x8a, 0xed, 0x60, 0x17, 0xa3, 0x1c, 0x15,
+	0x2b, 0x54, 0x2c, 0xae, 0xf7, 0x71, 0x1f, 0x33, 0xa9, 0x4a, 0xff, 0xf2, 0x0c, 0x44, 0x49, 0xc7,
+	0x64, 0x88, 0x89, 0xda, 0xd5, 0x88, 0xa1, 0x3e, 0xb9, 0xd3, 0x35, 0x5c, 0xed, 0x8e, 0xaa, 0x63,
+	0xd3, 0xe2, 0xfa, 0x5b, 0x61, 0x3d, 0xf3, 0x1c, 0x58, 0xd9, 0x5a, 0xdf, 0xb4, 0x34, 0xd7, 0xc4,
+	0xbe, 0xed, 0x56, 0x1f, 0xe3, 0xfe, 0xc0, 0x50, 0x35, 0xdb, 0x54, 0x35, 0xcb, 0xc2, 0x2e, 0x53,
+	0x12, 0xae, 0x5d, 0x1f, 0x33, 0xa4, 0x3f, 0x3c, 0xa9, 0xbc, 0x0e, 0xe8, 0x88, 0x7a, 0x6d, 0x69,
+	0x8e, 0x36, 0x24, 0x6d, 0xe3, 0xf1, 0xc8, 0x20, 0xae, 0x7c, 0x08, 0x6f, 0x44, 0xa4, 0xc4, 0xc6,
+	0x16, 0x31, 0x90, 0x0a, 0x59, 0x9b, 0x49, 0x4a, 0x42, 0x55, 0x68, 0xe4, 0xf7, 0xd7, 0x94, 0x20,
+	0x3c, 0xc5, 0x33, 0x6d, 0x2e, 0xbd, 0x78, 0x55, 0x59, 0x68, 0x73, 0x33, 0x79, 0x03, 0xae, 0x33,
+	0x3f, 0xf7, 0xad, 0x93, 0x01, 0x23, 0xe3, 0x03, 0x9c, 0xc0, 0x9b, 0x71, 0x05, 0xc7, 0xf8, 0x04,
+	0x72, 0xa6, 0x2f, 0x64, 0x30, 0x85, 0xa6, 0x42, 0x7d, 0xfe, 0xf1, 0xaa, 0x52, 0xeb, 0x9b, 0xee,
+	0xe9, 0xa8, 0xab, 0xe8, 0x78, 0xa8, 0xf2, 0xb4, 0x78, 0xbf, 0x6e, 0x93, 0xde, 0x23, 0xd5, 0x3d,
+	0xb3, 0x0d, 0xa2, 0xdc, 0x33, 0xf4, 0xf6, 0xd8, 0x81, 0x2c, 0xc1, 0x16, 0xc3, 0xb9, 0x6b, 0x59,
+	0x23, 0x6d, 0xd0, 0x72, 0xf0, 0x13, 0x93, 0xd0, 0x9c, 0xf8, 0x3c, 0x9e, 0x41, 0x79, 0x82, 0x9e,
+	0xd3, 0xf9, 0x1c, 0xd6, 0x34, 0xa6, 0xeb, 0xd8, 0x81, 0x72, 0x4e, 0x5a, 0xab, 0x5a, 0x0c, 0x44,
+	0x16, 0xa1, 0xc4, 0xd0, 0x1f, 0x68, 0x4e, 0xdf, 0x70, 0x8f, 0x47, 0xb6, 0x3d, 0x38, 0xf3, 0x99,
+	0xd9, 0xb0, 0x99, 0xa2, 0xe3, 0xac, 0x8e, 0xa1, 0xe8, 0x32, 0x79, 0x87, 0x30, 0xc5, 0x1c, 0x8c,
+	0xee, 0x5b, 0x6e, 0xbb, 0xe0, 0x86, 0x9c, 0xcb, 0x15, 0x28, 0x47, 0x6b, 0x72, 0xac, 0x9f, 0x1a,
+	0xbd, 0xd1, 0xc0, 0xf0, 0x29, 0x7d, 0x05, 0xd2, 0x24, 0x03, 0xce, 0x6b, 0x1b, 0x8a, 0xfa, 0xc8,
+	0x71, 0x0c, 0xcb, 0xed, 0xd8, 0xa7, 0x1a, 0x31, 0x18, 0xaf, 0xa5, 0x76, 0x81, 0x0b, 0x5b, 0x54,
+	0x86, 0xde, 0x87, 0x2c, 0x53, 0x92, 0x52, 0xa6, 0xba, 0xd8, 0xc8, 0xef, 0x6f, 0x86, 0xbb, 0x88,
+	0x2a, 0x02, 0xff, 0x41, 0x37, 0x31, 0x73, 0xd9, 0x85, 0x95, 0xa8, 0x1e, 0xad, 0xc3, 0x72, 0x18,
+	0xc7, 0xfb, 0x27, 0xda, 0x42, 0x99, 0xaa, 0xd0, 0xc8, 0x5d, 0xa5, 0x85, 0xca, 0x70, 0xc3, 0xbb,
+	0x0b, 0x0e, 0x7e, 0x68, 0xe8, 0xae, 0xd1, 0x8b, 0xd6, 0xe9, 0x17, 0x01, 0xb6, 0xd2, 0xf5, 0x3c,
+	0x27, 0x4d, 0xc8, 0xdb, 0x9e, 0x8a, 0xf7, 0x0e, 0x8d, 0x59, 0x8c, 0xc7, 0xdc, 0x0a, 0x4c, 0x78,
+	0xd0, 0xe1, 0x43, 0xe8, 0x08, 0x0a, 0x27, 0xa6, 0xa5, 0x0d, 0xfc, 0x72, 0xff, 0xff, 0xa0, 0x68,
+	0xb9, 0xf3, 0xcc, 0x07, 0xaf, 0xf6, 0xaf, 0x02, 0x5c, 0x8b, 0x21, 0xbf, 0x8e, 0x74, 0x26, 0x5b,
+	0x77, 0x71, 0xae, 0x58, 0xa2, 0xad, 0x5b, 0xe2, 0xe3, 0xe4, 0x01, 0x7e, 0x64, 0x58, 0x78, 0x68,
+	0xea, 0xc1, 0x05, 0xff, 0x77, 0x11, 0x36, 0x12, 0x2a, 0x5e, 0x19, 0x0b, 0x0a, 0x2e, 0x76, 0xb5,
+	0x41, 0xa7, 0x3b, 0x72, 0x2c, 0xa3, 0xc7, 0x4b, 0xb3, 0xa9, 0x78, 0x80, 0x0a, 0x1d, 0xb9, 0x0a,
+	0x1f, 0xb6, 0xca, 0x01, 0x36, 0xad, 0xe6, 0x3b, 0x94, 0xe4, 0x4f, 0x7f, 0x56, 0x1a, 0x97, 0x20,
+	0x49, 0x0f, 0x90, 0x76, 0x9e, 0x01, 0x34, 0x99, 0xff, 0xe4, 0xed, 0xc8, 0xa4, 0xdc, 0x8e, 0x48,
+	0xb6, 0x17, 0xaf, 0x9a, 0xed, 0x23, 0x3f, 0x44, 0x9e, 0xec, 0xa5, 0xf9, 0x1a, 0x87, 0xf9, 0xf0,
+	0x72, 0x9d, 0x2c, 0xe0, 0xf2, 0xd5, 0x0b, 0x88, 0x3e, 0x85, 0x15, 0x96, 0x12, 0x3a, 0x65, 0xfb,
+	0x8e, 0x41, 0x48, 0x29, 0x3b, 0x57, 0xe8, 0x45, 0x9b, 0xb7, 0x34, 0x73, 0x22, 0x7f, 0xc4, 0x07,
+	0xec, 0x01, 0xb6, 0x5c, 0x47, 0xd3, 0x5d, 0x5a, 0x08, 0xde, 0x19, 0x68, 0x0f, 0x56, 0x75, 0x2e,
+	0xee, 0x68, 0xbd, 0x1e, 0x03, 0xa5, 0x7d, 0x9f, 0x6b, 0x5f, 0xf3, 0xe5, 0x77, 0x3d, 0xb1, 0xdc,
+	0x81, 0xcd, 0x14, 0x37, 0xc1, 0xfd, 0x2e, 0x06, 0x7e, 0x68, 0x23, 0xf1, 0xb7, 0x71, 0x23, 0x74,
+	0xc3, 0xc3, 0xe7, 0xf8, 0xf5, 0x2e, 0xe8, 0x21, 0x99, 0xac, 0xa7, 0x00, 0xf8, 0x2d, 0x8c, 0x0e,
+	0x01, 0xc6, 0x4f, 0x3d, 0xf7, 0x5e, 0x8b, 0x34, 0xa9, 0xb7, 0x71, 0xf8, 0xad, 0xda, 0xd2, 0xfa,
+	0xfe, 0xc8, 0x6e, 0x87, 0x4e, 0xca, 0x3f, 0x0b, 0x20, 0xa6, 0xa1, 0xf0, 0x38, 0xee, 0xc1, 0x4a,
+	0x24, 0x0e, 0x7f, 0x54, 0xcd, 0x08, 0xa4, 0x18, 0x0e, 0x84, 0xa0, 0x8f, 0x23, 0x64, 0x33, 0x8c,
+	0x6c, 0x7d, 0x26, 0x59, 0x8f, 0x42, 0x98, 0xed, 0xfe, 0x3f, 0x39, 0x58, 0x66, 0x6c, 0xd1, 0x00,
+	0xb2, 0xde, 0x72, 0x81, 0xca, 0x21, 0x2a, 0xc9, 0xad, 0x45, 0x94, 0x26, 0xa9, 0x3d, 0xf7, 0xf2,
+	0xf6, 0xd7, 0xbf, 0xfd, 0xfd, 0x43, 0xa6, 0x8c, 0x6e, 0xf8, 0x2d, 0x44, 0x2d, 0x43, 0xeb, 0x14,
+	0xc3, 0x78, 0x0a, 0xb9, 0xf1, 0xfb, 0x52, 0x8d, 0x7b, 0x8c, 0x2f, 0x32, 0xe2, 0xcd, 0x29, 0x16,
+	0x1c, 0xb6, 0xc6, 0x60, 0xab, 0x48, 0x4a, 0x85, 0x1d, 0xdf, 0xd5, 0x1f, 0x05, 0x58, 0x8d, 0xef,
+	0x21, 0xa8, 0x1e, 0xf7, 0x3f, 0x61, 0x93, 0x11, 0x1b, 0xb3, 0x0d, 0x39, 0x1f, 0x85, 0xf1, 0x69,
+	0xa0, 0x5a, 0x2a, 0x9f, 0xc4, 0xb6, 0x83, 0xbe, 0x11, 0xa0, 0x10, 0xde, 0x42, 0xd0, 0x76, 0x1c,
+	0x2a, 0x65, 0x7f, 0x11, 0x77, 0xa6, 0x1b, 0x71, 0x2e, 0xb7, 0x18, 0x97, 0x1d, 0x24, 0xa7, 0x72,
+	0x89, 0xcc, 0x19, 0xf4, 0x5c, 0x80, 0xb5, 0xc4, 0xea, 0x81, 0x1a, 0x13, 0x0b, 0x10, 0x5b, 0x5f,
+	0xc4, 0xbd, 0x4b, 0x58, 0x72, 0x5a, 0x2a, 0xa3, 0xb5, 0x87, 0xea, 0xd3, 0x4b, 0xd6, 0x21, 0x3e,
+	0x8b, 0xef, 0xe9, 0x6b, 0x1a, 0x5d, 0x00, 0x50, 0x2d, 0xd1, 0x8e, 0xa9, 0x1b, 0x84, 0x58, 0x9f,
+	0x69, 0xc7, 0x59, 0xdd, 0x66, 0xac, 0xea, 0x68, 0x37, 0xbd, 0x7f, 0xfd, 0x53, 0x7e, 0xbe, 0x9e,
+	0x01, 0x8c, 0x1f, 0x3d, 0x94, 0x68, 0xd4, 0xc4, 0x5b, 0x29, 0xca, 0xd3, 0x4c, 0x38, 0x87, 0x3a,
+	0xe3, 0x70, 0x13, 0x55, 0xd2, 0x0b, 0x36, 0xc6, 0x7b, 0x2e, 0x40, 0x21, 0x3c, 0x2e, 0x92, 0x5d,
+	0x93, 0x32, 0x94, 0xc5, 0x9d, 0xe9, 0x46, 0x9c, 0xc4, 0x87, 0x8c, 0xc4, 0x07, 0xe8, 0xbd, 0x54,
+	0x12, 0xd1, 0x29, 0xa6, 0x7e, 0x11, 0x9f, 0xf2, 0x5f, 0xa2, 0x6f, 0x05, 0x28, 0x1e, 0x44, 0xc6,
+	0xd6, 0x54, 0xdc, 0x20, 0x41, 0xbb, 0x33, 0xac, 0x38, 0xbd, 0xb7, 0x18, 0xbd, 0x5d, 0xb4, 0x7d,
+	0x09, 0x7a, 0xcd, 0xc3, 0x17, 0xe7, 0x92, 0xf0, 0xf2, 0x5c, 0x12, 0xfe, 0x3a, 0x97, 0x84, 0xef,
+	0x2e, 0xa4, 0x85, 0x97, 0x17, 0xd2, 0xc2, 0xef, 0x17, 0xd2, 0xc2, 0x67, 0x6f, 0x87, 0xde, 0xbc,
+	0x03, 0xe6, 0xc8, 0x87, 0x23, 0x2a, 0xfb, 0x96, 0x7b, 0xea, 0x39, 0x66, 0xaf, 0x5f, 0x37, 0xcb,
+	0xbe, 0xe7, 0xde, 0xfd, 0x6f, 0x00, 0xcc, 0x8c, 0x5d, 0x36, 0x89, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// TargetSupply current target supply for this phase value.
+	TargetSupply(ctx context.Context, in *QueryTargetSupplyRequest, opts ...grpc.CallOption) (*QueryTargetSupplyResponse, error)
+	// InflationSchedule returns the inflation rate of every phase.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
+	// ProjectedSupply returns the projected supply at the end of the current and
+	// future phases.
+	ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error)
+	// Tokenomics returns the total burned amount, the current phase, the target
+	// supply and the progress of the current phase.
+	Tokenomics(ctx context.Context, in *QueryTokenomicsRequest, opts ...grpc.CallOption) (*QueryTokenomicsResponse, error)
+	// ContractBurn returns the cumulative amount burned by a contract.
+	ContractBurn(ctx context.Context, in *QueryContractBurnRequest, opts ...grpc.CallOption) (*QueryContractBurnResponse, error)
+	// ContractBurns returns the cumulative amounts burned by all contracts.
+	ContractBurns(ctx context.Context, in *QueryContractBurnsRequest, opts ...grpc.CallOption) (*QueryContractBurnsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TargetSupply(ctx context.Context, in *QueryTargetSupplyRequest, opts ...grpc.CallOption) (*QueryTargetSupplyResponse, error) {
+	out := new(QueryTargetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/TargetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTargetSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ProjectedSupply(ctx context.Context, in *QueryProjectedSupplyRequest, opts ...grpc.CallOption) (*QueryProjectedSupplyResponse, error) {
+	out := new(QueryProjectedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/ProjectedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tokenomics(ctx context.Context, in *QueryTokenomicsRequest, opts ...grpc.CallOption) (*QueryTokenomicsResponse, error) {
+	out := new(QueryTokenomicsResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/Tokenomics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractBurn(ctx context.Context, in *QueryContractBurnRequest, opts ...grpc.CallOption) (*QueryContractBurnResponse, error) {
+	out := new(QueryContractBurnResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/ContractBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractBurns(ctx context.Context, in *QueryContractBurnsRequest, opts ...grpc.CallOption) (*QueryContractBurnsResponse, error) {
+	out := new(QueryContractBurnsResponse)
+	err := c.cc.Invoke(ctx, "/juno.mint.Query/ContractBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// TargetSupply current target supply for this phase value.
+	TargetSupply(context.Context, *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error)
+	// InflationSchedule returns the inflation rate of every phase.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
+	// ProjectedSupply returns the projected supply at the end of the current and
+	// future phases.
+	ProjectedSupply(context.Context, *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error)
+	// Tokenomics returns the total burned amount, the current phase, the target
+	// supply and the progress of the current phase.
+	Tokenomics(context.Context, *QueryTokenomicsRequest) (*QueryTokenomicsResponse, error)
+	// ContractBurn returns the cumulative amount burned by a contract.
+	ContractBurn(context.Context, *QueryContractBurnRequest) (*QueryContractBurnResponse, error)
+	// ContractBurns returns the cumulative amounts burned by all contracts.
+	ContractBurns(context.Context, *QueryContractBurnsRequest) (*QueryContractBurnsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) TargetSupply(ctx context.Context, req *QueryTargetSupplyRequest) (*QueryTargetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TargetSupply not implemented")
+}
+func (*UnimplementedQueryServer) InflationSchedule(ctx context.Context, req *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedSupply(ctx context.Context, req *QueryProjectedSupplyRequest) (*QueryProjectedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedSupply not implemented")
+}
+func (*UnimplementedQueryServer) Tokenomics(ctx context.Context, req *QueryTokenomicsRequest) (*QueryTokenomicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokenomics not implemented")
+}
+func (*UnimplementedQueryServer) ContractBurn(ctx context.Context, req *QueryContractBurnRequest) (*QueryContractBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractBurn not implemented")
+}
+func (*UnimplementedQueryServer) ContractBurns(ctx context.Context, req *QueryContractBurnsRequest) (*QueryContractBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractBurns not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TargetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTargetSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TargetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/TargetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TargetSupply(ctx, req.(*QueryTargetSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/ProjectedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedSupply(ctx, req.(*QueryProjectedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tokenomics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenomicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tokenomics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/Tokenomics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tokenomics(ctx, req.(*QueryTokenomicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractBurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/ContractBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractBurn(ctx, req.(*QueryContractBurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.mint.Query/ContractBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractBurns(ctx, req.(*QueryContractBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.mint.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "TargetSupply",
+			Handler:    _Query_TargetSupply_Handler,
+		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
+		{
+			MethodName: "ProjectedSupply",
+			Handler:    _Query_ProjectedSupply_Handler,
+		},
+		{
+			MethodName: "Tokenomics",
+			Handler:    _Query_Tokenomics_Handler,
+		},
+		{
+			MethodName: "ContractBurn",
+			Handler:    _Query_ContractBurn_Handler,
+		},
+		{
+			MethodName: "ContractBurns",
+			Handler:    _Query_ContractBurns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/mint/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTargetSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTargetSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTargetSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetSupply.Size()
+		i -= size
+		if _, err := m.TargetSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentPhase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPhase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PhaseInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FinalSupply.Size()
+		i -= size
+		if _, err := m.FinalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PhaseProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetSupply.Size()
+		i -= size
+		if _, err := m.TargetSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenomicsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenomicsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenomicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenomicsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenomicsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenomicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PhaseProgress.Size()
+		i -= size
+		if _, err := m.PhaseProgress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetSupply.Size()
+		i -= size
+		if _, err := m.TargetSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentPhase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPhase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractBurnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractBurnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractBurnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractBurns) > 0 {
+		for iNdEx := len(m.ContractBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTargetSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTargetSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentPhase != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPhase))
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PhaseInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProjectedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.FinalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PhaseProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenomicsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTokenomicsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentPhase != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPhase))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PhaseProgress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractBurnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractBurns) > 0 {
+		for _, e := range m.ContractBurns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTargetSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTargetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPhase", wireType)
+			}
+			m.CurrentPhase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPhase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, PhaseInflation{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PhaseInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PhaseInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PhaseInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryTargetSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TargetSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -936,10 +3196,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -963,7 +3223,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectedSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -986,15 +3246,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1021,7 +3281,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Projections = append(m.Projections, PhaseProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1046,7 +3341,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *PhaseProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,10 +3364,147 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PhaseProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PhaseProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenomicsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenomicsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenomicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1096,7 +3528,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokenomicsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1119,17 +3551,70 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokenomicsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokenomicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPhase", wireType)
+			}
+			m.CurrentPhase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPhase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1139,15 +3624,16 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
@@ -1158,6 +3644,108 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhaseProgress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PhaseProgress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1179,7 +3767,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractBurnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1202,12 +3790,44 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractBurnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractBurnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1229,7 +3849,7 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1252,17 +3872,17 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBurn", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1272,22 +3892,22 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContractBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1312,7 +3932,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractBurnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,12 +3955,48 @@ func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractBurnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1362,7 +4018,7 @@ func (m *QueryTargetSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractBurnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1385,17 +4041,17 @@ func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTargetSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractBurnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTargetSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractBurns", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1405,22 +4061,59 @@ func (m *QueryTargetSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractBurns = append(m.ContractBurns, ContractBurn{})
+			if err := m.ContractBurns[len(m.ContractBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
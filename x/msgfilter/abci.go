@@ -0,0 +1,18 @@
+package msgfilter
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+// BeginBlocker removes the message blocks which expired.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.PruneExpiredBlocks(ctx)
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+const (
+	// FlagStartHeight defines the flag for the first height a message is blocked at
+	FlagStartHeight = "start-height"
+	// FlagEndHeight defines the flag for the height a message block expires at
+	FlagEndHeight = "end-height"
+)
+
+// NewTxCmd returns a root CLI command handler for certain modules transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Message filter subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewBlockMessages(),
+		NewUnblockMessages(),
+	)
+	return txCmd
+}
+
+// NewBlockMessages returns a CLI command handler for blocking message types.
+func NewBlockMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-messages [type-url] [type-url...]",
+		Short: "Block message types as an emergency authority.",
+		Long:  "Block message types between the start and the end heights. Emergency authorities must set an end height within the max emergency block duration; governance blocks messages with a proposal instead.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			blockedMessages := make([]types.BlockedMessage, 0, len(args))
+			for _, typeURL := range args {
+				blockedMessages = append(blockedMessages, types.NewBlockedMessage(typeURL, startHeight, endHeight))
+			}
+
+			msg := types.NewMsgBlockMessages(cliCtx.GetFromAddress(), blockedMessages)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "First height at which the messages are blocked (defaults to immediately)")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Height at which the block expires (defaults to never)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnblockMessages returns a CLI command handler for unblocking message
+// types.
+func NewUnblockMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-messages [type-url] [type-url...]",
+		Short: "Unblock message types as an emergency authority.",
+		Long:  "Remove the blocks of message types. Emergency authorities can only remove the blocks added by an emergency authority.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnblockMessages(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
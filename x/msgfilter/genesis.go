@@ -0,0 +1,31 @@
+package msgfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, blocked := range data.BlockedMessages {
+		k.SetBlockedMessage(ctx, blocked)
+	}
+}
+
+// ExportGenesis export module state
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:          k.GetParams(ctx),
+		BlockedMessages: k.GetAllBlockedMessages(ctx),
+	}
+}
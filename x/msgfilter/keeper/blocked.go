@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+// GetBlockedMessage returns the block of a message type, if any.
+func (k Keeper) GetBlockedMessage(ctx sdk.Context, typeURL string) (types.BlockedMessage, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedMessageKeyPrefix)
+	bz := store.Get([]byte(typeURL))
+	if bz == nil {
+		return types.BlockedMessage{}, false
+	}
+
+	var blocked types.BlockedMessage
+	k.cdc.MustUnmarshal(bz, &blocked)
+	return blocked, true
+}
+
+// SetBlockedMessage stores the block of a message type, replacing the
+// existing one.
+func (k Keeper) SetBlockedMessage(ctx sdk.Context, blocked types.BlockedMessage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedMessageKeyPrefix)
+	bz := k.cdc.MustMarshal(&blocked)
+	store.Set([]byte(blocked.TypeUrl), bz)
+}
+
+// DeleteBlockedMessage removes the block of a message type.
+func (k Keeper) DeleteBlockedMessage(ctx sdk.Context, typeURL string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedMessageKeyPrefix)
+	store.Delete([]byte(typeURL))
+}
+
+// GetAllBlockedMessages returns the blocks of all message types.
+func (k Keeper) GetAllBlockedMessages(ctx sdk.Context) []types.BlockedMessage {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockedMessageKeyPrefix)
+	defer iterator.Close()
+
+	blockedMessages := []types.BlockedMessage{}
+	for ; iterator.Valid(); iterator.Next() {
+		var blocked types.BlockedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &blocked)
+		blockedMessages = append(blockedMessages, blocked)
+	}
+
+	return blockedMessages
+}
+
+// GetBlockedMessages returns a page of the blocks of all message types.
+func (k Keeper) GetBlockedMessages(ctx sdk.Context, pag *query.PageRequest) ([]types.BlockedMessage, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedMessageKeyPrefix)
+
+	blockedMessages := []types.BlockedMessage{}
+	pageRes, err := query.Paginate(store, pag, func(_, value []byte) error {
+		var blocked types.BlockedMessage
+		if err := k.cdc.Unmarshal(value, &blocked); err != nil {
+			return err
+		}
+
+		blockedMessages = append(blockedMessages, blocked)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blockedMessages, pageRes, nil
+}
+
+// GetActiveBlockedMessages returns the blocks which apply at the current
+// height.
+func (k Keeper) GetActiveBlockedMessages(ctx sdk.Context) []types.BlockedMessage {
+	active := []types.BlockedMessage{}
+	for _, blocked := range k.GetAllBlockedMessages(ctx) {
+		if blocked.IsActive(ctx.BlockHeight()) {
+			active = append(active, blocked)
+		}
+	}
+
+	return active
+}
+
+// IsMessageBlocked returns whether a message type is blocked at the current
+// height.
+func (k Keeper) IsMessageBlocked(ctx sdk.Context, typeURL string) bool {
+	blocked, found := k.GetBlockedMessage(ctx, typeURL)
+	return found && blocked.IsActive(ctx.BlockHeight())
+}
+
+// AssertMsgsAllowed returns an error if any of the messages, or of the
+// messages nested in an authz MsgExec, is blocked at the current height.
+func (k Keeper) AssertMsgsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if k.IsMessageBlocked(ctx, typeURL) {
+			return types.ErrMessageBlocked.Wrapf("%s at height %d", typeURL, ctx.BlockHeight())
+		}
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := k.AssertMsgsAllowed(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PruneExpiredBlocks removes the blocks which expired at the current height.
+func (k Keeper) PruneExpiredBlocks(ctx sdk.Context) {
+	for _, blocked := range k.GetAllBlockedMessages(ctx) {
+		if !blocked.IsExpired(ctx.BlockHeight()) {
+			continue
+		}
+
+		k.DeleteBlockedMessage(ctx, blocked.TypeUrl)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnblockMessage,
+				sdk.NewAttribute(types.AttributeKeyTypeURL, blocked.TypeUrl),
+			),
+		)
+	}
+}
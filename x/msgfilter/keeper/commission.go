@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+// AssertCommissionRatesAllowed returns an error if any of the messages, or of
+// the messages nested in an authz MsgExec, creates or edits a validator with a
+// commission out of the bounds set in the params.
+func (k Keeper) AssertCommissionRatesAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	params := k.GetParams(ctx)
+	return k.assertCommissionRatesAllowed(ctx, params, msgs)
+}
+
+func (k Keeper) assertCommissionRatesAllowed(ctx sdk.Context, params types.Params, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := k.assertCommissionRatesAllowed(ctx, params, innerMsgs); err != nil {
+				return err
+			}
+		case *stakingtypes.MsgCreateValidator:
+			if err := validateCreateValidator(params, msg); err != nil {
+				return err
+			}
+		case *stakingtypes.MsgEditValidator:
+			if err := k.validateEditValidator(ctx, params, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCreateValidator checks the max change rate and the commission rate
+// of a new validator.
+func validateCreateValidator(params types.Params, msg *stakingtypes.MsgCreateValidator) error {
+	if msg.Commission.MaxChangeRate.GT(params.MaxCommissionChangeRate) {
+		return types.ErrInvalidCommission.Wrapf("max change rate must not exceed %s", params.MaxCommissionChangeRate)
+	}
+
+	return validateCommissionRate(params, msg.Commission.Rate)
+}
+
+// validateEditValidator checks the new commission rate of an existing
+// validator, which cannot change by more than the max commission change rate.
+func (k Keeper) validateEditValidator(ctx sdk.Context, params types.Params, msg *stakingtypes.MsgEditValidator) error {
+	// Skip if the commission rate is not being modified
+	if msg.CommissionRate == nil {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return types.ErrInvalidCommission.Wrap("invalid validator address")
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrInvalidCommission.Wrap("validator not found")
+	}
+
+	if msg.CommissionRate.Sub(validator.Commission.Rate).Abs().GT(params.MaxCommissionChangeRate) {
+		return types.ErrInvalidCommission.Wrapf("commission rate cannot change by more than %s", params.MaxCommissionChangeRate)
+	}
+
+	return validateCommissionRate(params, *msg.CommissionRate)
+}
+
+func validateCommissionRate(params types.Params, rate sdk.Dec) error {
+	if rate.LT(params.MinCommissionRate) {
+		return types.ErrInvalidCommission.Wrapf("commission rate must be at least %s", params.MinCommissionRate)
+	}
+
+	if rate.GT(params.MaxCommissionRate) {
+		return types.ErrInvalidCommission.Wrapf("commission rate must not exceed %s", params.MaxCommissionRate)
+	}
+
+	return nil
+}
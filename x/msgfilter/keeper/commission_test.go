@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+func (s *IntegrationTestSuite) createValidatorMsg(rate, maxChangeRate string) *stakingtypes.MsgCreateValidator {
+	valPub := secp256k1.GenPrivKey().PubKey()
+	commission := stakingtypes.NewCommissionRates(
+		sdk.MustNewDecFromStr(rate),
+		sdk.OneDec(),
+		sdk.MustNewDecFromStr(maxChangeRate),
+	)
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(valPub.Address()),
+		valPub,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		stakingtypes.NewDescription("test_moniker", "", "", "", ""),
+		commission,
+		sdk.OneInt(),
+	)
+	s.Require().NoError(err)
+
+	return msg
+}
+
+func (s *IntegrationTestSuite) TestAssertCommissionRatesAllowed() {
+	k := s.app.AppKeepers.MsgFilterKeeper
+	err := k.SetParams(s.ctx, types.NewParams(nil, 100, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.02")))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc          string
+		rate          string
+		maxChangeRate string
+		success       bool
+	}{
+		{"Success - within the bounds", "0.1", "0.1", true},
+		{"Fail - max change rate above the param", "0.1", "0.11", false},
+		{"Fail - commission below the min", "0.01", "0.05", false},
+		{"Fail - commission above the max", "0.51", "0.05", false},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			msg := s.createValidatorMsg(tc.rate, tc.maxChangeRate)
+			exec := authz.NewMsgExec(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), []sdk.Msg{msg})
+
+			for _, msgs := range [][]sdk.Msg{{msg}, {&exec}} {
+				err := k.AssertCommissionRatesAllowed(s.ctx, msgs)
+				if tc.success {
+					s.Require().NoError(err)
+				} else {
+					s.Require().ErrorIs(err, types.ErrInvalidCommission)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAssertEditCommissionRatesAllowed() {
+	k := s.app.AppKeepers.MsgFilterKeeper
+	err := k.SetParams(s.ctx, types.NewParams(nil, 100, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.02")))
+	s.Require().NoError(err)
+
+	createMsg := s.createValidatorMsg("0.2", "0.1")
+	valAddr, err := sdk.ValAddressFromBech32(createMsg.ValidatorAddress)
+	s.Require().NoError(err)
+
+	validator, err := stakingtypes.NewValidator(valAddr, secp256k1.GenPrivKey().PubKey(), createMsg.Description)
+	s.Require().NoError(err)
+	validator.Commission = stakingtypes.NewCommission(createMsg.Commission.Rate, createMsg.Commission.MaxRate, createMsg.Commission.MaxChangeRate)
+	s.app.AppKeepers.StakingKeeper.SetValidator(s.ctx, validator)
+
+	for _, tc := range []struct {
+		desc    string
+		rate    string
+		success bool
+	}{
+		{"Success - increase within the max change rate", "0.3", true},
+		{"Success - decrease within the max change rate", "0.1", true},
+		{"Fail - increase above the max change rate", "0.31", false},
+		{"Fail - decrease above the max change rate", "0.09", false},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			newRate := sdk.MustNewDecFromStr(tc.rate)
+			msg := stakingtypes.NewMsgEditValidator(valAddr, createMsg.Description, &newRate, nil)
+
+			err := k.AssertCommissionRatesAllowed(s.ctx, []sdk.Msg{msg})
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrInvalidCommission)
+			}
+		})
+	}
+
+	// the commission of a validator which is not edited is not checked
+	s.Require().NoError(k.AssertCommissionRatesAllowed(s.ctx, []sdk.Msg{
+		stakingtypes.NewMsgEditValidator(valAddr, createMsg.Description, nil, nil),
+	}))
+}
+
+func (s *IntegrationTestSuite) TestMessageRouterCommission() {
+	router := keeper.NewMessageRouter(s.app.AppKeepers.MsgFilterKeeper, s.app.MsgServiceRouter())
+
+	msg := s.createValidatorMsg("0.1", "0.2")
+	handler := router.Handler(msg)
+	s.Require().NotNil(handler)
+
+	_, err := handler(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidCommission)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/msgfilter keeper providing gRPC
+// method handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+// Params returns the msgfilter module params
+func (q Querier) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BlockedMessages returns all the blocked message types
+func (q Querier) BlockedMessages(
+	c context.Context,
+	req *types.QueryBlockedMessagesRequest,
+) (*types.QueryBlockedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	blockedMessages, pageRes, err := q.GetBlockedMessages(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockedMessagesResponse{
+		BlockedMessages: blockedMessages,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ActiveBlockedMessages returns the message types blocked at the current
+// height
+func (q Querier) ActiveBlockedMessages(
+	c context.Context,
+	_ *types.QueryActiveBlockedMessagesRequest,
+) (*types.QueryActiveBlockedMessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryActiveBlockedMessagesResponse{
+		BlockedMessages: q.GetActiveBlockedMessages(ctx),
+	}, nil
+}
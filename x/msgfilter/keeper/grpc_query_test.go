@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+func (s *IntegrationTestSuite) TestMsgFilterQueries() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.AppKeepers.MsgFilterKeeper
+
+	paramsRes, err := s.queryClient.Params(goCtx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage(msgSendTypeURL, 0, 20))
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgMultiSend", 100, 0))
+
+	blockedRes, err := s.queryClient.BlockedMessages(goCtx, &types.QueryBlockedMessagesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(blockedRes.BlockedMessages, 1)
+	s.Require().Equal(uint64(3), blockedRes.Pagination.Total)
+
+	// the MultiSend block is not active yet
+	activeRes, err := s.queryClient.ActiveBlockedMessages(goCtx, &types.QueryActiveBlockedMessagesRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]types.BlockedMessage{
+		types.NewBlockedMessage("/ibc.core.channel.v1.MsgTimeoutOnClose", 0, 0),
+		types.NewBlockedMessage(msgSendTypeURL, 0, 20),
+	}, activeRes.BlockedMessages)
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/keeper"
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+const msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	ctx                sdk.Context
+	app                *app.App
+	queryClient        types.QueryClient
+	msgFilterMsgServer types.MsgServer
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	isCheckTx := false
+	s.app = app.Setup(s.T())
+
+	s.ctx = s.app.BaseApp.NewContext(isCheckTx, tmproto.Header{
+		ChainID: "testing",
+		Height:  9,
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.app.AppKeepers.MsgFilterKeeper))
+
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.msgFilterMsgServer = s.app.AppKeepers.MsgFilterKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) TestAssertMsgsAllowed() {
+	k := s.app.AppKeepers.MsgFilterKeeper
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)))
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send})
+
+	s.Require().NoError(k.AssertMsgsAllowed(s.ctx, []sdk.Msg{send, &exec}))
+
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage(msgSendTypeURL, 10, 20))
+
+	for _, tc := range []struct {
+		desc    string
+		height  int64
+		msgs    []sdk.Msg
+		success bool
+	}{
+		{"Success - before the start height", 9, []sdk.Msg{send}, true},
+		{"Fail - at the start height", 10, []sdk.Msg{send}, false},
+		{"Fail - nested in authz", 15, []sdk.Msg{&exec}, false},
+		{"Success - at the end height", 20, []sdk.Msg{send, &exec}, true},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			err := k.AssertMsgsAllowed(s.ctx.WithBlockHeight(tc.height), tc.msgs)
+			if tc.success {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrMessageBlocked)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestMessageRouter() {
+	k := s.app.AppKeepers.MsgFilterKeeper
+	router := keeper.NewMessageRouter(k, s.app.MsgServiceRouter())
+
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, recipient := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1))
+	s.Require().NoError(s.app.AppKeepers.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.app.AppKeepers.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, minttypes.ModuleName, sender, coins))
+
+	send := banktypes.NewMsgSend(sender, recipient, coins)
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage(msgSendTypeURL, 0, 0))
+
+	handler := router.Handler(send)
+	s.Require().NotNil(handler)
+
+	_, err := handler(s.ctx, send)
+	s.Require().ErrorIs(err, types.ErrMessageBlocked)
+
+	k.DeleteBlockedMessage(s.ctx, msgSendTypeURL)
+
+	_, err = handler(s.ctx, send)
+	s.Require().NoError(err)
+	s.Require().Equal(coins, s.app.AppKeepers.BankKeeper.GetAllBalances(s.ctx, recipient))
+}
+
+func (s *IntegrationTestSuite) TestPruneExpiredBlocks() {
+	k := s.app.AppKeepers.MsgFilterKeeper
+
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage(msgSendTypeURL, 0, 10))
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgMultiSend", 0, 11))
+
+	k.PruneExpiredBlocks(s.ctx)
+	s.Require().Len(k.GetAllBlockedMessages(s.ctx), 3)
+
+	k.PruneExpiredBlocks(s.ctx.WithBlockHeight(10))
+
+	_, found := k.GetBlockedMessage(s.ctx, msgSendTypeURL)
+	s.Require().False(found)
+
+	// the default MsgTimeoutOnClose block does not expire
+	s.Require().Len(k.GetAllBlockedMessages(s.ctx), 2)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// BlockMessages blocks message types. Emergency authorities can only add
+// blocks expiring within the max emergency block duration, and cannot replace
+// the blocks added by governance.
+func (k Keeper) BlockMessages(
+	goCtx context.Context,
+	msg *types.MsgBlockMessages,
+) (*types.MsgBlockMessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	isEmergency, err := k.assertAuthority(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	for _, blocked := range msg.BlockedMessages {
+		if k.router.HandlerByTypeURL(blocked.TypeUrl) == nil {
+			return nil, types.ErrUnknownMessage.Wrapf("type URL: %s", blocked.TypeUrl)
+		}
+
+		if isEmergency {
+			if blocked.EndHeight == 0 || uint64(blocked.EndHeight) > uint64(ctx.BlockHeight())+params.MaxEmergencyBlockDuration {
+				return nil, types.ErrUnauthorized.Wrapf("emergency blocks must expire within %d blocks", params.MaxEmergencyBlockDuration)
+			}
+
+			if existing, found := k.GetBlockedMessage(ctx, blocked.TypeUrl); found && !params.IsEmergencyAuthority(existing.Authority) {
+				return nil, types.ErrUnauthorized.Wrapf("%s is blocked by governance", blocked.TypeUrl)
+			}
+		}
+
+		blocked.Authority = msg.Authority
+		k.SetBlockedMessage(ctx, blocked)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlockMessage,
+				sdk.NewAttribute(types.AttributeKeyTypeURL, blocked.TypeUrl),
+				sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(blocked.StartHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(blocked.EndHeight, 10)),
+			),
+		)
+	}
+
+	return &types.MsgBlockMessagesResponse{}, nil
+}
+
+// UnblockMessages removes the blocks of message types. Emergency authorities
+// can only remove the blocks added by an emergency authority.
+func (k Keeper) UnblockMessages(
+	goCtx context.Context,
+	msg *types.MsgUnblockMessages,
+) (*types.MsgUnblockMessagesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	isEmergency, err := k.assertAuthority(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	for _, typeURL := range msg.TypeUrls {
+		blocked, found := k.GetBlockedMessage(ctx, typeURL)
+		if !found {
+			return nil, types.ErrInvalidBlockedMessage.Wrapf("%s is not blocked", typeURL)
+		}
+
+		if isEmergency && !params.IsEmergencyAuthority(blocked.Authority) {
+			return nil, types.ErrUnauthorized.Wrapf("%s is blocked by governance", typeURL)
+		}
+
+		k.DeleteBlockedMessage(ctx, typeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnblockMessage,
+				sdk.NewAttribute(types.AttributeKeyTypeURL, typeURL),
+			),
+		)
+	}
+
+	return &types.MsgUnblockMessagesResponse{}, nil
+}
+
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// assertAuthority returns an error if the address is neither the module
+// authority nor an emergency authority, and whether it is an emergency one.
+func (k Keeper) assertAuthority(ctx sdk.Context, address string) (isEmergency bool, err error) {
+	if address == k.authority {
+		return false, nil
+	}
+
+	if k.GetParams(ctx).IsEmergencyAuthority(address) {
+		return true, nil
+	}
+
+	return false, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s or an emergency authority, got %s", k.authority, address)
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmosContracts/juno/v23/x/msgfilter/types"
+)
+
+func (s *IntegrationTestSuite) TestBlockMessagesMsg() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, _, emergency := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+
+	err := s.app.AppKeepers.MsgFilterKeeper.SetParams(s.ctx, types.NewParams([]string{emergency.String()}, 100, types.DefaultMaxCommissionChangeRate, types.DefaultMaxCommissionRate, types.DefaultMinCommissionRate))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc      string
+		authority sdk.AccAddress
+		blocked   types.BlockedMessage
+		success   bool
+	}{
+		{
+			desc:      "Success - gov blocks indefinitely",
+			authority: govAddr,
+			blocked:   types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgMultiSend", 0, 0),
+			success:   true,
+		},
+		{
+			desc:      "Success - emergency block within the max duration",
+			authority: emergency,
+			blocked:   types.NewBlockedMessage(msgSendTypeURL, 0, s.ctx.BlockHeight()+100),
+			success:   true,
+		},
+		{
+			desc:      "Fail - emergency block above the max duration",
+			authority: emergency,
+			blocked:   types.NewBlockedMessage(msgSendTypeURL, 0, s.ctx.BlockHeight()+101),
+			success:   false,
+		},
+		{
+			desc:      "Fail - emergency block without an end height",
+			authority: emergency,
+			blocked:   types.NewBlockedMessage(msgSendTypeURL, 0, 0),
+			success:   false,
+		},
+		{
+			desc:      "Fail - emergency block replacing a gov block",
+			authority: emergency,
+			blocked:   types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgMultiSend", 0, s.ctx.BlockHeight()+10),
+			success:   false,
+		},
+		{
+			desc:      "Success - gov block which expires",
+			authority: govAddr,
+			blocked:   types.NewBlockedMessage("/cosmos.staking.v1beta1.MsgDelegate", 0, s.ctx.BlockHeight()+10),
+			success:   true,
+		},
+		{
+			desc:      "Fail - emergency block replacing an expiring gov block",
+			authority: emergency,
+			blocked:   types.NewBlockedMessage("/cosmos.staking.v1beta1.MsgDelegate", 0, s.ctx.BlockHeight()+5),
+			success:   false,
+		},
+		{
+			desc:      "Fail - not an authority",
+			authority: other,
+			blocked:   types.NewBlockedMessage(msgSendTypeURL, 0, s.ctx.BlockHeight()+10),
+			success:   false,
+		},
+		{
+			desc:      "Fail - unknown message type",
+			authority: govAddr,
+			blocked:   types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgUnknown", 0, 0),
+			success:   false,
+		},
+		{
+			desc:      "Fail - protected message type",
+			authority: govAddr,
+			blocked:   types.NewBlockedMessage("/cosmos.gov.v1.MsgVote", 0, 0),
+			success:   false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			msg := types.NewMsgBlockMessages(tc.authority, []types.BlockedMessage{tc.blocked})
+
+			_, err := s.msgFilterMsgServer.BlockMessages(s.ctx, msg)
+			if !tc.success {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			blocked, found := s.app.AppKeepers.MsgFilterKeeper.GetBlockedMessage(s.ctx, tc.blocked.TypeUrl)
+			s.Require().True(found)
+
+			// the block records the authority which added it
+			expected := tc.blocked
+			expected.Authority = tc.authority.String()
+			s.Require().Equal(expected, blocked)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestUnblockMessagesMsg() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, _, emergency := testdata.KeyTestPubAddr()
+	k := s.app.AppKeepers.MsgFilterKeeper
+
+	err := k.SetParams(s.ctx, types.NewParams([]string{emergency.String()}, 100, types.DefaultMaxCommissionChangeRate, types.DefaultMaxCommissionRate, types.DefaultMinCommissionRate))
+	s.Require().NoError(err)
+
+	_, err = s.msgFilterMsgServer.BlockMessages(s.ctx, types.NewMsgBlockMessages(emergency, []types.BlockedMessage{
+		types.NewBlockedMessage(msgSendTypeURL, 0, s.ctx.BlockHeight()+50),
+	}))
+	s.Require().NoError(err)
+	_, err = s.msgFilterMsgServer.BlockMessages(s.ctx, types.NewMsgBlockMessages(govAddr, []types.BlockedMessage{
+		types.NewBlockedMessage("/cosmos.bank.v1beta1.MsgMultiSend", 0, 0),
+		types.NewBlockedMessage("/cosmos.staking.v1beta1.MsgDelegate", 0, s.ctx.BlockHeight()+50),
+	}))
+	s.Require().NoError(err)
+
+	// emergency authorities can only remove the blocks added by an emergency
+	// authority, even when the gov block expires
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(emergency, []string{"/cosmos.bank.v1beta1.MsgMultiSend"}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(emergency, []string{"/cosmos.staking.v1beta1.MsgDelegate"}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	s.Require().True(k.IsMessageBlocked(s.ctx, "/cosmos.staking.v1beta1.MsgDelegate"))
+
+	// nor the blocks set at genesis
+	k.SetBlockedMessage(s.ctx, types.NewBlockedMessage("/cosmos.staking.v1beta1.MsgUndelegate", 0, s.ctx.BlockHeight()+50))
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(emergency, []string{"/cosmos.staking.v1beta1.MsgUndelegate"}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(emergency, []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+	s.Require().False(k.IsMessageBlocked(s.ctx, msgSendTypeURL))
+
+	// a message type which is not blocked cannot be unblocked
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(govAddr, []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, types.ErrInvalidBlockedMessage)
+
+	_, err = s.msgFilterMsgServer.UnblockMessages(s.ctx, types.NewMsgUnblockMessages(govAddr, []string{"/cosmos.bank.v1beta1.MsgMultiSend"}))
+	s.Require().NoError(err)
+	s.Require().False(k.IsMessageBlocked(s.ctx, "/cosmos.bank.v1beta1.MsgMultiSend"))
+}
+
+func (s *IntegrationTestSuite) TestUpdateParamsMsg() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, _, emergency := testdata.KeyTestPubAddr()
+	params := types.NewParams([]string{emergency.String()}, 10, types.DefaultMaxCommissionChangeRate, types.DefaultMaxCommissionRate, types.DefaultMinCommissionRate)
+
+	_, err := s.msgFilterMsgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: emergency.String(), Params: params})
+	s.Require().Error(err)
+
+	_, err = s.msgFilterMsgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: govAddr, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.app.AppKeepers.MsgFilterKeeper.GetParams(s.ctx))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ icatypes.MessageRouter = MessageRouter{}
+
+// MessageRouter wraps the message router of the ICA host so that the
+// messages executed by interchain accounts are filtered as well, including
+// their validator commissions.
+type MessageRouter struct {
+	keeper Keeper
+	router icatypes.MessageRouter
+}
+
+// NewMessageRouter creates a new MessageRouter rejecting the blocked messages
+// before routing them to router.
+func NewMessageRouter(k Keeper, router icatypes.MessageRouter) MessageRouter {
+	return MessageRouter{
+		keeper: k,
+		router: router,
+	}
+}
+
+// Handler returns the handler of msg, which fails if msg is blocked.
+func (r MessageRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		if err := r.keeper.AssertMsgsAllowed(ctx, []sdk.Msg{req}); err != nil {
+			return nil, err
+		}
+
+		if err := r.keeper.AssertCommissionRatesAllowed(ctx, []sdk.Msg{req}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrMessageBlocked        = errorsmod.Register(ModuleName, 1, "message type is blocked")
+	ErrInvalidBlockedMessage = errorsmod.Register(ModuleName, 2, "invalid blocked message")
+	ErrUnknownMessage        = errorsmod.Register(ModuleName, 3, "unknown message type")
+	ErrDuplicate             = errorsmod.Register(ModuleName, 4, "duplicate")
+	ErrUnauthorized          = errorsmod.Register(ModuleName, 5, "unauthorized")
+	ErrInvalidCommission     = errorsmod.Register(ModuleName, 6, "invalid commission")
+)
@@ -0,0 +1,10 @@
+package types
+
+const (
+	EventTypeBlockMessage   = "block_message"
+	EventTypeUnblockMessage = "unblock_message"
+
+	AttributeKeyTypeURL     = "type_url"
+	AttributeKeyStartHeight = "start_height"
+	AttributeKeyEndHeight   = "end_height"
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MessageRouter defines the expected message router, used to reject blocks of
+// message types which the chain does not handle.
+type MessageRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
+
+// StakingKeeper defines the expected staking keeper, used to bound the
+// commission changes of the existing validators.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
@@ -0,0 +1,34 @@
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	blockedMessages []BlockedMessage,
+) GenesisState {
+	return GenesisState{
+		Params:          params,
+		BlockedMessages: blockedMessages,
+	}
+}
+
+// DefaultGenesisState sets default msgfilter genesis state with default
+// params. MsgTimeoutOnClose stays blocked due to incorrect behavior that could
+// occur if a packet is re-enabled.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		BlockedMessages: []BlockedMessage{
+			NewBlockedMessage("/ibc.core.channel.v1.MsgTimeoutOnClose", 0, 0),
+		},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := ValidateBlockedMessages(gs.BlockedMessages); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
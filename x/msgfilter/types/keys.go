@@ -0,0 +1,18 @@
+package types
+
+const (
+	// module name
+	ModuleName = "msgfilter"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey               = []byte{0x00} // Prefix for params key
+	BlockedMessageKeyPrefix = []byte{0x01} // Prefix for the blocked messages by type URL
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	fmt "fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgBlockMessages{}
+	_ sdk.Msg = &MsgUnblockMessages{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+const (
+	TypeMsgBlockMessages   = "block_messages"
+	TypeMsgUnblockMessages = "unblock_messages"
+)
+
+// NewMsgBlockMessages creates new instance of MsgBlockMessages
+func NewMsgBlockMessages(
+	authority sdk.Address,
+	blockedMessages []BlockedMessage,
+) *MsgBlockMessages {
+	return &MsgBlockMessages{
+		Authority:       authority.String(),
+		BlockedMessages: blockedMessages,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgBlockMessages) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgBlockMessages) Type() string { return TypeMsgBlockMessages }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBlockMessages) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.BlockedMessages) == 0 {
+		return fmt.Errorf("blocked messages cannot be empty")
+	}
+
+	return ValidateBlockedMessages(msg.BlockedMessages)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBlockMessages) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBlockMessages) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUnblockMessages creates new instance of MsgUnblockMessages
+func NewMsgUnblockMessages(
+	authority sdk.Address,
+	typeURLs []string,
+) *MsgUnblockMessages {
+	return &MsgUnblockMessages{
+		Authority: authority.String(),
+		TypeUrls:  typeURLs,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUnblockMessages) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgUnblockMessages) Type() string { return TypeMsgUnblockMessages }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnblockMessages) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.TypeUrls) == 0 {
+		return fmt.Errorf("type URLs cannot be empty")
+	}
+
+	seen := make(map[string]struct{}, len(msg.TypeUrls))
+	for _, typeURL := range msg.TypeUrls {
+		if _, exists := seen[typeURL]; exists {
+			return ErrDuplicate.Wrapf("type URL: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUnblockMessages) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnblockMessages) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProtectedTypeURLPrefixes are the message types which cannot be blocked, so
+// that governance can always lift a block.
+var ProtectedTypeURLPrefixes = []string{
+	"/juno.msgfilter.",
+	"/cosmos.gov.",
+}
+
+// NewBlockedMessage creates a new BlockedMessage object
+func NewBlockedMessage(typeURL string, startHeight, endHeight int64) BlockedMessage {
+	return BlockedMessage{
+		TypeUrl:     typeURL,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// IsActive returns whether the message type is blocked at the given height.
+func (b BlockedMessage) IsActive(height int64) bool {
+	return height >= b.StartHeight && !b.IsExpired(height)
+}
+
+// IsExpired returns whether the block no longer applies from the given height.
+func (b BlockedMessage) IsExpired(height int64) bool {
+	return b.EndHeight != 0 && height >= b.EndHeight
+}
+
+// Validate performs a stateless validation of the blocked message.
+func (b BlockedMessage) Validate() error {
+	if !strings.HasPrefix(b.TypeUrl, "/") || strings.TrimSpace(b.TypeUrl) != b.TypeUrl || len(b.TypeUrl) == 1 {
+		return ErrInvalidBlockedMessage.Wrapf("invalid type URL: %q", b.TypeUrl)
+	}
+
+	for _, prefix := range ProtectedTypeURLPrefixes {
+		if strings.HasPrefix(b.TypeUrl, prefix) {
+			return ErrInvalidBlockedMessage.Wrapf("%s cannot be blocked", b.TypeUrl)
+		}
+	}
+
+	if b.StartHeight < 0 || b.EndHeight < 0 {
+		return ErrInvalidBlockedMessage.Wrapf("heights of %s cannot be negative", b.TypeUrl)
+	}
+
+	if b.EndHeight != 0 && b.EndHeight <= b.StartHeight {
+		return ErrInvalidBlockedMessage.Wrapf("end height of %s must be greater than its start height", b.TypeUrl)
+	}
+
+	if b.Authority != "" {
+		if _, err := sdk.AccAddressFromBech32(b.Authority); err != nil {
+			return ErrInvalidBlockedMessage.Wrapf("invalid authority of %s: %s", b.TypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBlockedMessages validates a list of blocked messages, which must not
+// block a message type twice.
+func ValidateBlockedMessages(blockedMessages []BlockedMessage) error {
+	seen := make(map[string]struct{}, len(blockedMessages))
+	for _, b := range blockedMessages {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if _, exists := seen[b.TypeUrl]; exists {
+			return ErrDuplicate.Wrapf("blocked message: %s", b.TypeUrl)
+		}
+		seen[b.TypeUrl] = struct{}{}
+	}
+
+	return nil
+}
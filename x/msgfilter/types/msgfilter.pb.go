@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/msgfilter/v1/msgfilter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the msgfilter module params
+type Params struct {
+	// emergency_authorities are the addresses, besides x/gov, allowed to
+	// temporarily block messages during an incident
+	EmergencyAuthorities []string `protobuf:"bytes,1,rep,name=emergency_authorities,json=emergencyAuthorities,proto3" json:"emergency_authorities,omitempty"`
+	// max_emergency_block_duration is the maximum number of blocks a block
+	// added by an emergency authority can last
+	MaxEmergencyBlockDuration uint64 `protobuf:"varint,2,opt,name=max_emergency_block_duration,json=maxEmergencyBlockDuration,proto3" json:"max_emergency_block_duration,omitempty"`
+	// max_commission_change_rate is the maximum max change rate of the
+	// commission of a validator, and the maximum change of its commission rate
+	// in a single edit
+	MaxCommissionChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_change_rate"`
+	// max_commission_rate is the maximum commission rate of a validator
+	MaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate"`
+	// min_commission_rate is the minimum commission rate of a validator
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a007c33d93d8cc66, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEmergencyAuthorities() []string {
+	if m != nil {
+		return m.EmergencyAuthorities
+	}
+	return nil
+}
+
+func (m *Params) GetMaxEmergencyBlockDuration() uint64 {
+	if m != nil {
+		return m.MaxEmergencyBlockDuration
+	}
+	return 0
+}
+
+// BlockedMessage defines a message type rejected by the chain during a range
+// of heights
+type BlockedMessage struct {
+	// type_url is the type URL of the blocked message, e.g.
+	// "/ibc.core.channel.v1.MsgTimeoutOnClose"
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// start_height is the first height at which the message is blocked. Zero
+	// blocks it immediately.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which the block expires. Zero blocks the
+	// message until it is unblocked.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// authority is the address which added the block. It is set by the chain
+	// and is empty for the blocks set at genesis.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *BlockedMessage) Reset()         { *m = BlockedMessage{} }
+func (m *BlockedMessage) String() string { return proto.CompactTextString(m) }
+func (*BlockedMessage) ProtoMessage()    {}
+func (*BlockedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a007c33d93d8cc66, []int{1}
+}
+func (m *BlockedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedMessage.Merge(m, src)
+}
+func (m *BlockedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedMessage proto.InternalMessageInfo
+
+func (m *BlockedMessage) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *BlockedMessage) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BlockedMessage) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *BlockedMessage) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "juno.msgfilter.v1.Params")
+	proto.RegisterType((*BlockedMessage)(nil), "juno.msgfilter.v1.BlockedMessage")
+}
+
+func init() { proto.RegisterFile("juno/msgfilter/v1/msgfilter.proto", fileDescriptor_a007c33d93d8cc66) }
+
+var fileDescriptor_a007c33d93d8cc66 = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x32, 0x06, 0x35, 0x08, 0x69, 0xa1, 0x88, 0xb4, 0x82, 0xac, 0xdb, 0x01, 0xf5,
+	0xd2, 0x46, 0xd5, 0x24, 0x4e, 0x48, 0x68, 0x6d, 0x91, 0x90, 0x10, 0x12, 0x0a, 0xe2, 0xc2, 0xc5,
+	0x72, 0x9d, 0x0f, 0xc7, 0x2c, 0xb6, 0x27, 0xdb, 0x99, 0xda, 0xb7, 0xe0, 0x25, 0x78, 0x83, 0x3d,
+	0xc4, 0x8e, 0xd3, 0x24, 0x24, 0xc4, 0x61, 0x42, 0xed, 0x8b, 0xa0, 0xb8, 0x69, 0xb3, 0xb1, 0x6b,
+	0x4f, 0xc9, 0xf7, 0xfd, 0xff, 0xf9, 0xff, 0xe2, 0xcf, 0x36, 0x3a, 0xf8, 0x5e, 0x48, 0x15, 0x0b,
+	0xc3, 0xbe, 0xf1, 0xdc, 0x82, 0x8e, 0xcf, 0x86, 0x75, 0x31, 0x38, 0xd5, 0xca, 0xaa, 0x60, 0xaf,
+	0xb4, 0x0c, 0xea, 0xee, 0xd9, 0xb0, 0xd3, 0x62, 0x8a, 0x29, 0xa7, 0xc6, 0xe5, 0xdb, 0xca, 0xd8,
+	0x69, 0x53, 0x65, 0x84, 0x32, 0x78, 0x25, 0xac, 0x8a, 0x95, 0x74, 0xf8, 0xcb, 0x47, 0xbb, 0x9f,
+	0x88, 0x26, 0xc2, 0x04, 0x47, 0xe8, 0x19, 0x08, 0xd0, 0x0c, 0x24, 0x9d, 0x63, 0x52, 0xd8, 0x4c,
+	0x69, 0x6e, 0x39, 0x98, 0xd0, 0xeb, 0xfa, 0xbd, 0x66, 0xd2, 0xda, 0x88, 0xc7, 0xb5, 0x16, 0xbc,
+	0x45, 0x2f, 0x04, 0x99, 0xe1, 0xfa, 0xc3, 0x69, 0xae, 0xe8, 0x09, 0x4e, 0x0b, 0x4d, 0x2c, 0x57,
+	0x32, 0xbc, 0xd7, 0xf5, 0x7a, 0x3b, 0x49, 0x5b, 0x90, 0xd9, 0xbb, 0xb5, 0x65, 0x54, 0x3a, 0x26,
+	0x95, 0x21, 0x98, 0xa3, 0x4e, 0x19, 0x40, 0x95, 0x10, 0xdc, 0x18, 0xae, 0x24, 0xa6, 0x19, 0x91,
+	0x0c, 0xb0, 0x26, 0x16, 0x42, 0xbf, 0xeb, 0xf5, 0x9a, 0xa3, 0x37, 0x17, 0xd7, 0xfb, 0x8d, 0x3f,
+	0xd7, 0xfb, 0xaf, 0x18, 0xb7, 0x59, 0x31, 0x1d, 0x50, 0x25, 0xaa, 0x55, 0x54, 0x8f, 0xbe, 0x49,
+	0x4f, 0x62, 0x3b, 0x3f, 0x05, 0x33, 0x98, 0x00, 0xbd, 0x3a, 0xef, 0xa3, 0x6a, 0x91, 0x13, 0xa0,
+	0xc9, 0x73, 0x41, 0x66, 0xe3, 0x4d, 0xfc, 0xd8, 0xa5, 0x27, 0xc4, 0x42, 0x90, 0xa3, 0xa7, 0xff,
+	0xa1, 0x1d, 0x73, 0x67, 0x0b, 0xcc, 0xbd, 0x5b, 0xcc, 0x0d, 0x8d, 0xcb, 0x3b, 0xb4, 0xfb, 0x5b,
+	0xa1, 0x71, 0x79, 0x9b, 0x76, 0xf8, 0xd3, 0x43, 0x4f, 0xdc, 0xa0, 0x21, 0xfd, 0x08, 0xc6, 0x10,
+	0x06, 0x41, 0x1b, 0x3d, 0x2c, 0x03, 0x70, 0xa1, 0xf3, 0xd0, 0x2b, 0xa9, 0xc9, 0x83, 0xb2, 0xfe,
+	0xa2, 0xf3, 0xe0, 0x00, 0x3d, 0x36, 0x96, 0x68, 0x8b, 0x33, 0xe0, 0x2c, 0xb3, 0x6e, 0xd7, 0xfc,
+	0xe4, 0x91, 0xeb, 0xbd, 0x77, 0xad, 0xe0, 0x25, 0x42, 0x20, 0xd3, 0xb5, 0xc1, 0x77, 0x86, 0x26,
+	0xc8, 0xb4, 0x92, 0x5f, 0xa3, 0xe6, 0xfa, 0xc8, 0xcc, 0xab, 0x09, 0x86, 0x57, 0xe7, 0xfd, 0x56,
+	0xf5, 0x97, 0xc7, 0x69, 0xaa, 0xc1, 0x98, 0xcf, 0x56, 0x73, 0xc9, 0x92, 0xda, 0x3a, 0xfa, 0x70,
+	0xb1, 0x88, 0xbc, 0xcb, 0x45, 0xe4, 0xfd, 0x5d, 0x44, 0xde, 0x8f, 0x65, 0xd4, 0xb8, 0x5c, 0x46,
+	0x8d, 0xdf, 0xcb, 0xa8, 0xf1, 0x75, 0x78, 0x63, 0x14, 0x63, 0x97, 0x32, 0x56, 0xd2, 0x6a, 0x42,
+	0xad, 0x89, 0xdd, 0xdd, 0x98, 0xdd, 0xb8, 0x1d, 0x6e, 0x32, 0xd3, 0x5d, 0x77, 0xa6, 0x8f, 0xfe,
+	0x0d, 0x00, 0x47, 0xcd, 0xdb, 0x49, 0x3c, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxCommissionChangeRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgfilter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxEmergencyBlockDuration != 0 {
+		i = encodeVarintMsgfilter(dAtA, i, uint64(m.MaxEmergencyBlockDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EmergencyAuthorities) > 0 {
+		for iNdEx := len(m.EmergencyAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencyAuthorities[iNdEx])
+			copy(dAtA[i:], m.EmergencyAuthorities[iNdEx])
+			i = encodeVarintMsgfilter(dAtA, i, uint64(len(m.EmergencyAuthorities[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgfilter(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintMsgfilter(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMsgfilter(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintMsgfilter(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EmergencyAuthorities) > 0 {
+		for _, s := range m.EmergencyAuthorities {
+			l = len(s)
+			n += 1 + l + sovMsgfilter(uint64(l))
+		}
+	}
+	if m.MaxEmergencyBlockDuration != 0 {
+		n += 1 + sovMsgfilter(uint64(m.MaxEmergencyBlockDuration))
+	}
+	l = m.MaxCommissionChangeRate.Size()
+	n += 1 + l + sovMsgfilter(uint64(l))
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovMsgfilter(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovMsgfilter(uint64(l))
+	return n
+}
+
+func (m *BlockedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfilter(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMsgfilter(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMsgfilter(uint64(m.EndHeight))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgfilter(uint64(l))
+	}
+	return n
+}
+
+func sovMsgfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgfilter(x uint64) (n int) {
+	return sovMsgfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthorities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthorities = append(m.EmergencyAuthorities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEmergencyBlockDuration", wireType)
+			}
+			m.MaxEmergencyBlockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEmergencyBlockDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgfilter = fmt.Errorf("proto: unexpected end of group")
+)
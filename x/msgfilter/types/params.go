@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	DefaultEmergencyAuthorities = []string(nil)
+	// about a week of 6 second blocks, enough for a governance proposal to
+	// take over an emergency block
+	DefaultMaxEmergencyBlockDuration = uint64(100_800)
+
+	DefaultMaxCommissionChangeRate = sdk.NewDecWithPrec(5, 2)
+	DefaultMaxCommissionRate       = sdk.OneDec()
+	DefaultMinCommissionRate       = sdk.ZeroDec()
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	emergencyAuthorities []string,
+	maxEmergencyBlockDuration uint64,
+	maxCommissionChangeRate sdk.Dec,
+	maxCommissionRate sdk.Dec,
+	minCommissionRate sdk.Dec,
+) Params {
+	return Params{
+		EmergencyAuthorities:      emergencyAuthorities,
+		MaxEmergencyBlockDuration: maxEmergencyBlockDuration,
+		MaxCommissionChangeRate:   maxCommissionChangeRate,
+		MaxCommissionRate:         maxCommissionRate,
+		MinCommissionRate:         minCommissionRate,
+	}
+}
+
+// DefaultParams returns default x/msgfilter module parameters.
+func DefaultParams() Params {
+	return Params{
+		EmergencyAuthorities:      DefaultEmergencyAuthorities,
+		MaxEmergencyBlockDuration: DefaultMaxEmergencyBlockDuration,
+		MaxCommissionChangeRate:   DefaultMaxCommissionChangeRate,
+		MaxCommissionRate:         DefaultMaxCommissionRate,
+		MinCommissionRate:         DefaultMinCommissionRate,
+	}
+}
+
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.EmergencyAuthorities))
+	for _, authority := range p.EmergencyAuthorities {
+		if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+			return fmt.Errorf("invalid emergency authority %s: %w", authority, err)
+		}
+		if _, exists := seen[authority]; exists {
+			return ErrDuplicate.Wrapf("emergency authority: %s", authority)
+		}
+		seen[authority] = struct{}{}
+	}
+
+	if p.MaxEmergencyBlockDuration == 0 {
+		return fmt.Errorf("max emergency block duration must be positive")
+	}
+
+	if err := validateRate("max commission change rate", p.MaxCommissionChangeRate); err != nil {
+		return err
+	}
+	if p.MaxCommissionChangeRate.IsZero() {
+		return fmt.Errorf("max commission change rate must be positive")
+	}
+
+	if err := validateRate("max commission rate", p.MaxCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateRate("min commission rate", p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if p.MinCommissionRate.GT(p.MaxCommissionRate) {
+		return fmt.Errorf("min commission rate %s cannot exceed max commission rate %s", p.MinCommissionRate, p.MaxCommissionRate)
+	}
+
+	return nil
+}
+
+func validateRate(name string, rate sdk.Dec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, rate)
+	}
+
+	return nil
+}
+
+// IsEmergencyAuthority returns whether the address is an emergency authority.
+func (p Params) IsEmergencyAuthority(address string) bool {
+	for _, authority := range p.EmergencyAuthorities {
+		if authority == address {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	authority := sdk.AccAddress([]byte("emergency_authority_")).String()
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid: emergency authority", NewParams([]string{authority}, 100, DefaultMaxCommissionChangeRate, DefaultMaxCommissionRate, DefaultMinCommissionRate), false},
+		{"invalid: malformed emergency authority", NewParams([]string{"invalid"}, 100, DefaultMaxCommissionChangeRate, DefaultMaxCommissionRate, DefaultMinCommissionRate), true},
+		{"invalid: duplicated emergency authority", NewParams([]string{authority, authority}, 100, DefaultMaxCommissionChangeRate, DefaultMaxCommissionRate, DefaultMinCommissionRate), true},
+		{"invalid: zero max emergency block duration", NewParams(nil, 0, DefaultMaxCommissionChangeRate, DefaultMaxCommissionRate, DefaultMinCommissionRate), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlockedMessageValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		blocked  BlockedMessage
+		expError bool
+	}{
+		{"valid: indefinite block", NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 0, 0), false},
+		{"valid: height range", NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 10, 20), false},
+		{"invalid: missing leading slash", NewBlockedMessage("cosmos.bank.v1beta1.MsgSend", 0, 0), true},
+		{"invalid: empty type URL", NewBlockedMessage("", 0, 0), true},
+		{"invalid: negative height", NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", -1, 0), true},
+		{"invalid: end before start", NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 20, 20), true},
+		{"invalid: msgfilter message", NewBlockedMessage("/juno.msgfilter.v1.MsgUnblockMessages", 0, 0), true},
+		{"invalid: gov message", NewBlockedMessage("/cosmos.gov.v1.MsgSubmitProposal", 0, 0), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.blocked.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlockedMessageIsActive(t *testing.T) {
+	blocked := NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 10, 20)
+
+	require.False(t, blocked.IsActive(9))
+	require.True(t, blocked.IsActive(10))
+	require.True(t, blocked.IsActive(19))
+	require.False(t, blocked.IsActive(20))
+	require.True(t, blocked.IsExpired(20))
+
+	indefinite := NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 0, 0)
+	require.True(t, indefinite.IsActive(1_000_000))
+	require.False(t, indefinite.IsExpired(1_000_000))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	require.NoError(t, DefaultGenesisState().Validate())
+
+	duplicated := NewGenesisState(DefaultParams(), []BlockedMessage{
+		NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 0, 0),
+		NewBlockedMessage("/cosmos.bank.v1beta1.MsgSend", 10, 20),
+	})
+	require.Error(t, duplicated.Validate())
+}
+
+func TestParamsValidateCommission(t *testing.T) {
+	testCases := []struct {
+		name          string
+		maxChangeRate sdk.Dec
+		maxRate       sdk.Dec
+		minRate       sdk.Dec
+		expError      bool
+	}{
+		{"valid: bounded commission", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 2), false},
+		{"valid: fixed commission", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), false},
+		{"invalid: zero max change rate", sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec(), true},
+		{"invalid: max change rate above one", sdk.NewDec(2), sdk.OneDec(), sdk.ZeroDec(), true},
+		{"invalid: negative min rate", sdk.NewDecWithPrec(5, 2), sdk.OneDec(), sdk.NewDecWithPrec(-1, 2), true},
+		{"invalid: min rate above max rate", sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), true},
+		{"invalid: nil max rate", sdk.NewDecWithPrec(5, 2), sdk.Dec{}, sdk.ZeroDec(), true},
+	}
+
+	for _, tc := range testCases {
+		err := NewParams(nil, 100, tc.maxChangeRate, tc.maxRate, tc.minRate).Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,1362 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/msgfilter/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params is the returned parameter from the module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryBlockedMessagesRequest is the request type for the
+// Query/BlockedMessages RPC method.
+type QueryBlockedMessagesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedMessagesRequest) Reset()         { *m = QueryBlockedMessagesRequest{} }
+func (m *QueryBlockedMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedMessagesRequest) ProtoMessage()    {}
+func (*QueryBlockedMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{2}
+}
+func (m *QueryBlockedMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedMessagesRequest.Merge(m, src)
+}
+func (m *QueryBlockedMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedMessagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedMessagesResponse is the response type for the
+// Query/BlockedMessages RPC method.
+type QueryBlockedMessagesResponse struct {
+	// blocked_messages are the blocked message types
+	BlockedMessages []BlockedMessage `protobuf:"bytes,1,rep,name=blocked_messages,json=blockedMessages,proto3" json:"blocked_messages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedMessagesResponse) Reset()         { *m = QueryBlockedMessagesResponse{} }
+func (m *QueryBlockedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedMessagesResponse) ProtoMessage()    {}
+func (*QueryBlockedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{3}
+}
+func (m *QueryBlockedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedMessagesResponse.Merge(m, src)
+}
+func (m *QueryBlockedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedMessagesResponse) GetBlockedMessages() []BlockedMessage {
+	if m != nil {
+		return m.BlockedMessages
+	}
+	return nil
+}
+
+func (m *QueryBlockedMessagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryActiveBlockedMessagesRequest is the request type for the
+// Query/ActiveBlockedMessages RPC method.
+type QueryActiveBlockedMessagesRequest struct {
+}
+
+func (m *QueryActiveBlockedMessagesRequest) Reset()         { *m = QueryActiveBlockedMessagesRequest{} }
+func (m *QueryActiveBlockedMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveBlockedMessagesRequest) ProtoMessage()    {}
+func (*QueryActiveBlockedMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{4}
+}
+func (m *QueryActiveBlockedMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveBlockedMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveBlockedMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveBlockedMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveBlockedMessagesRequest.Merge(m, src)
+}
+func (m *QueryActiveBlockedMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveBlockedMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveBlockedMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveBlockedMessagesRequest proto.InternalMessageInfo
+
+// QueryActiveBlockedMessagesResponse is the response type for the
+// Query/ActiveBlockedMessages RPC method.
+type QueryActiveBlockedMessagesResponse struct {
+	// blocked_messages are the message types blocked at the current height
+	BlockedMessages []BlockedMessage `protobuf:"bytes,1,rep,name=blocked_messages,json=blockedMessages,proto3" json:"blocked_messages"`
+}
+
+func (m *QueryActiveBlockedMessagesResponse) Reset()         { *m = QueryActiveBlockedMessagesResponse{} }
+func (m *QueryActiveBlockedMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveBlockedMessagesResponse) ProtoMessage()    {}
+func (*QueryActiveBlockedMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_499732ffccddcd2a, []int{5}
+}
+func (m *QueryActiveBlockedMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveBlockedMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveBlockedMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveBlockedMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveBlockedMessagesResponse.Merge(m, src)
+}
+func (m *QueryActiveBlockedMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveBlockedMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveBlockedMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveBlockedMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryActiveBlockedMessagesResponse) GetBlockedMessages() []BlockedMessage {
+	if m != nil {
+		return m.BlockedMessages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "juno.msgfilter.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "juno.msgfilter.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlockedMessagesRequest)(nil), "juno.msgfilter.v1.QueryBlockedMessagesRequest")
+	proto.RegisterType((*QueryBlockedMessagesResponse)(nil), "juno.msgfilter.v1.QueryBlockedMessagesResponse")
+	proto.RegisterType((*QueryActiveBlockedMessagesRequest)(nil), "juno.msgfilter.v1.QueryActiveBlockedMessagesRequest")
+	proto.RegisterType((*QueryActiveBlockedMessagesResponse)(nil), "juno.msgfilter.v1.QueryActiveBlockedMessagesResponse")
+}
+
+func init() { proto.RegisterFile("juno/msgfilter/v1/query.proto", fileDescriptor_499732ffccddcd2a) }
+
+var fileDescriptor_499732ffccddcd2a = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6e, 0xd4, 0x30,
+	0x14, 0x86, 0xc7, 0x2d, 0xcc, 0xc2, 0x5d, 0x14, 0x4c, 0x91, 0xda, 0xb4, 0x84, 0x4e, 0xaa, 0x96,
+	0xaa, 0x20, 0x5b, 0x19, 0x40, 0xac, 0x99, 0x4a, 0xb0, 0x40, 0xa0, 0x32, 0x4b, 0x36, 0x95, 0x13,
+	0x8c, 0x09, 0x4c, 0xe2, 0x34, 0x76, 0xa2, 0x96, 0x25, 0x27, 0x40, 0xe2, 0x00, 0x5c, 0x03, 0x89,
+	0x0b, 0x74, 0x59, 0x89, 0x0d, 0x2b, 0x54, 0xcd, 0x70, 0x10, 0x14, 0xdb, 0xd0, 0x66, 0xe2, 0xb6,
+	0xb0, 0xe8, 0x2e, 0xf2, 0x7b, 0xef, 0x7f, 0xdf, 0xff, 0xdb, 0x0a, 0xbc, 0xf5, 0xae, 0xcc, 0x04,
+	0x49, 0x25, 0x7f, 0x93, 0x8c, 0x14, 0x2b, 0x48, 0x15, 0x92, 0xbd, 0x92, 0x15, 0x07, 0x38, 0x2f,
+	0x84, 0x12, 0xe8, 0x7a, 0x5d, 0xc6, 0x7f, 0xcb, 0xb8, 0x0a, 0xbd, 0xad, 0x58, 0xc8, 0x54, 0x48,
+	0x12, 0x51, 0xc9, 0x4c, 0x2f, 0xa9, 0xc2, 0x88, 0x29, 0x1a, 0x92, 0x9c, 0xf2, 0x24, 0xa3, 0x2a,
+	0x11, 0x99, 0x19, 0xf7, 0x7a, 0x6d, 0xf5, 0x13, 0x2d, 0xd3, 0xb2, 0xc0, 0x05, 0x17, 0xfa, 0x93,
+	0xd4, 0x5f, 0xf6, 0x74, 0x85, 0x0b, 0xc1, 0x47, 0x8c, 0xd0, 0x3c, 0x21, 0x34, 0xcb, 0x84, 0xd2,
+	0xaa, 0xd2, 0x54, 0x83, 0x05, 0x88, 0x5e, 0xd6, 0x8b, 0x77, 0x68, 0x41, 0x53, 0x39, 0x64, 0x7b,
+	0x25, 0x93, 0x2a, 0x78, 0x01, 0x6f, 0x34, 0x4e, 0x65, 0x2e, 0x32, 0xc9, 0xd0, 0x23, 0xd8, 0xcd,
+	0xf5, 0xc9, 0x22, 0x58, 0x05, 0x9b, 0x73, 0xfd, 0x25, 0xdc, 0xf2, 0x84, 0xcd, 0xc8, 0xe0, 0xca,
+	0xe1, 0xcf, 0xdb, 0x9d, 0xa1, 0x6d, 0x0f, 0x18, 0x5c, 0xd6, 0x7a, 0x83, 0x91, 0x88, 0xdf, 0xb3,
+	0xd7, 0xcf, 0x99, 0x94, 0x94, 0xb3, 0x3f, 0xeb, 0xd0, 0x13, 0x08, 0x4f, 0xfc, 0x5a, 0xed, 0x0d,
+	0x6c, 0xc2, 0xc1, 0x75, 0x38, 0xd8, 0x04, 0x69, 0xc3, 0xc1, 0x3b, 0x94, 0x33, 0x3b, 0x3b, 0x3c,
+	0x35, 0x19, 0x7c, 0x03, 0x70, 0xc5, 0xbd, 0xc7, 0x1a, 0x18, 0xc2, 0x6b, 0x91, 0x29, 0xed, 0xa6,
+	0xb6, 0xb6, 0x08, 0x56, 0x67, 0x37, 0xe7, 0xfa, 0x3d, 0x87, 0x95, 0xa6, 0x8a, 0xb5, 0x34, 0x1f,
+	0x35, 0xb5, 0xd1, 0xd3, 0x06, 0xfc, 0x8c, 0x86, 0xbf, 0x73, 0x21, 0xbc, 0x01, 0x6a, 0xd0, 0xaf,
+	0xc1, 0x9e, 0x86, 0x7f, 0x1c, 0xab, 0xa4, 0x62, 0xee, 0xa8, 0x82, 0x7d, 0x18, 0x9c, 0xd7, 0x74,
+	0x79, 0x3e, 0xfb, 0xc7, 0xb3, 0xf0, 0xaa, 0x5e, 0x8d, 0x3e, 0xc0, 0xae, 0xb9, 0x65, 0xb4, 0xee,
+	0x50, 0x6b, 0x3f, 0x27, 0x6f, 0xe3, 0xa2, 0x36, 0x83, 0x1d, 0xf4, 0x3e, 0x7e, 0xff, 0xf5, 0x79,
+	0x66, 0x19, 0x2d, 0x91, 0xf6, 0x63, 0x37, 0x2f, 0x09, 0x7d, 0x01, 0x70, 0x7e, 0xca, 0x35, 0xc2,
+	0x67, 0xc9, 0xbb, 0x33, 0xf4, 0xc8, 0x3f, 0xf7, 0x5b, 0xae, 0xbb, 0x9a, 0x6b, 0x1d, 0xad, 0x39,
+	0xb8, 0xa6, 0x73, 0x46, 0x5f, 0x01, 0xbc, 0xe9, 0xbc, 0x1d, 0xf4, 0xe0, 0xac, 0xbd, 0xe7, 0xdd,
+	0xb8, 0xf7, 0xf0, 0x3f, 0xa7, 0x2c, 0x73, 0x5f, 0x33, 0xdf, 0x43, 0x5b, 0x0e, 0x66, 0xaa, 0x27,
+	0x77, 0xa7, 0xd1, 0x07, 0xcf, 0x0e, 0xc7, 0x3e, 0x38, 0x1a, 0xfb, 0xe0, 0x78, 0xec, 0x83, 0x4f,
+	0x13, 0xbf, 0x73, 0x34, 0xf1, 0x3b, 0x3f, 0x26, 0x7e, 0xe7, 0x55, 0xc8, 0x13, 0xf5, 0xb6, 0x8c,
+	0x70, 0x2c, 0x52, 0xb2, 0xad, 0x9f, 0xf6, 0xb6, 0xc8, 0x54, 0x41, 0x63, 0x25, 0x8d, 0xfe, 0xfe,
+	0xa9, 0x0d, 0xea, 0x20, 0x67, 0x32, 0xea, 0xea, 0x1f, 0xcc, 0xfd, 0xdf, 0x03, 0x00, 0xe6, 0xf7,
+	0x32, 0x93, 0x17, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params retrieves the msgfilter module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlockedMessages retrieves all the blocked message types, including the
+	// blocks which are not active yet
+	BlockedMessages(ctx context.Context, in *QueryBlockedMessagesRequest, opts ...grpc.CallOption) (*QueryBlockedMessagesResponse, error)
+	// ActiveBlockedMessages retrieves the message types blocked at the current
+	// height
+	ActiveBlockedMessages(ctx context.Context, in *QueryActiveBlockedMessagesRequest, opts ...grpc.CallOption) (*QueryActiveBlockedMessagesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.msgfilter.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedMessages(ctx context.Context, in *QueryBlockedMessagesRequest, opts ...grpc.CallOption) (*QueryBlockedMessagesResponse, error) {
+	out := new(QueryBlockedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/juno.msgfilter.v1.Query/BlockedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveBlockedMessages(ctx context.Context, in *QueryActiveBlockedMessagesRequest, opts ...grpc.CallOption) (*QueryActiveBlockedMessagesResponse, error) {
+	out := new(QueryActiveBlockedMessagesResponse)
+	err := c.cc.Invoke(ctx, "/juno.msgfilter.v1.Query/ActiveBlockedMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params retrieves the msgfilter module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlockedMessages retrieves all the blocked message types, including the
+	// blocks which are not active yet
+	BlockedMessages(context.Context, *QueryBlockedMessagesRequest) (*QueryBlockedMessagesResponse, error)
+	// ActiveBlockedMessages retrieves the message types blocked at the current
+	// height
+	ActiveBlockedMessages(context.Context, *QueryActiveBlockedMessagesRequest) (*QueryActiveBlockedMessagesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BlockedMessages(ctx context.Context, req *QueryBlockedMessagesRequest) (*QueryBlockedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedMessages not implemented")
+}
+func (*UnimplementedQueryServer) ActiveBlockedMessages(ctx context.Context, req *QueryActiveBlockedMessagesRequest) (*QueryActiveBlockedMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveBlockedMessages not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.msgfilter.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.msgfilter.v1.Query/BlockedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedMessages(ctx, req.(*QueryBlockedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveBlockedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveBlockedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveBlockedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.msgfilter.v1.Query/ActiveBlockedMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveBlockedMessages(ctx, req.(*QueryActiveBlockedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.msgfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BlockedMessages",
+			Handler:    _Query_BlockedMessages_Handler,
+		},
+		{
+			MethodName: "ActiveBlockedMessages",
+			Handler:    _Query_ActiveBlockedMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/msgfilter/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedMessages) > 0 {
+		for iNdEx := len(m.BlockedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveBlockedMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveBlockedMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveBlockedMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveBlockedMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveBlockedMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveBlockedMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedMessages) > 0 {
+		for iNdEx := len(m.BlockedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockedMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedMessages) > 0 {
+		for _, e := range m.BlockedMessages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveBlockedMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveBlockedMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedMessages) > 0 {
+		for _, e := range m.BlockedMessages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedMessages = append(m.BlockedMessages, BlockedMessage{})
+			if err := m.BlockedMessages[len(m.BlockedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveBlockedMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveBlockedMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveBlockedMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveBlockedMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveBlockedMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveBlockedMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedMessages = append(m.BlockedMessages, BlockedMessage{})
+			if err := m.BlockedMessages[len(m.BlockedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package bindings
 
 import (
 	"encoding/json"
+	"fmt"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -64,6 +65,24 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
 		}
+		if contractMsg.SetBeforeSendHook != nil {
+			return m.setBeforeSendHook(ctx, contractAddr, contractMsg.SetBeforeSendHook)
+		}
+		if contractMsg.RenounceCapability != nil {
+			return m.renounceCapability(ctx, contractAddr, contractMsg.RenounceCapability)
+		}
+		if contractMsg.GrantRole != nil {
+			return m.grantRole(ctx, contractAddr, contractMsg.GrantRole)
+		}
+		if contractMsg.RevokeRole != nil {
+			return m.revokeRole(ctx, contractAddr, contractMsg.RevokeRole)
+		}
+		if contractMsg.SetPaused != nil {
+			return m.setPaused(ctx, contractAddr, contractMsg.SetPaused)
+		}
+		if contractMsg.SetFrozen != nil {
+			return m.setFrozen(ctx, contractAddr, contractMsg.SetFrozen)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -131,28 +150,25 @@ func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Cont
 		return err
 	}
 
+	if b.BlockedAddr(rcpt) {
+		return wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("minting coins to blocked address %s", rcpt.String())}
+	}
+
 	coin := sdk.Coin{Denom: mint.Denom, Amount: mint.Amount}
-	sdkMsg := tokenfactorytypes.NewMsgMint(contractAddr.String(), coin)
+	sdkMsg := tokenfactorytypes.NewMsgMintTo(contractAddr.String(), coin, rcpt.String())
 
 	if err = sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
-	// Mint through token factory / message server
+	// Mint straight to the recipient through token factory / message server,
+	// like MsgMint, so mints are allowed while the denom is paused
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
 	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), sdkMsg)
 	if err != nil {
 		return errorsmod.Wrap(err, "minting coins from message")
 	}
 
-	if b.BlockedAddr(rcpt) {
-		return errorsmod.Wrapf(err, "minting coins to blocked address %s", rcpt.String())
-	}
-
-	err = b.SendCoins(ctx, contractAddr, rcpt, sdk.NewCoins(coin))
-	if err != nil {
-		return errorsmod.Wrap(err, "sending newly minted coins from message")
-	}
 	return nil
 }
 
@@ -263,6 +279,195 @@ func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contrac
 	return nil
 }
 
+// setBeforeSendHook sets the before send hook of a denom.
+func (m *CustomMessenger) setBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set before send hook")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetBeforeSendHook sets the before send hook of a denom after validating the setBeforeSendHook message.
+func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.CosmwasmAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Set the hook through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHook(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting before send hook from message")
+	}
+	return nil
+}
+
+// renounceCapability renounces a capability of the admin over a denom.
+func (m *CustomMessenger) renounceCapability(ctx sdk.Context, contractAddr sdk.AccAddress, renounceCapability *bindingstypes.RenounceCapability) ([]sdk.Event, [][]byte, error) {
+	err := PerformRenounceCapability(m.tokenFactory, ctx, contractAddr, renounceCapability)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform renounce capability")
+	}
+	return nil, nil, nil
+}
+
+// PerformRenounceCapability renounces a capability over a denom after validating the renounceCapability message.
+func PerformRenounceCapability(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, renounceCapability *bindingstypes.RenounceCapability) error {
+	if renounceCapability == nil {
+		return wasmvmtypes.InvalidRequest{Err: "renounce capability null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRenounceCapability(contractAddr.String(), renounceCapability.Denom, renounceCapability.Capability)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Renounce through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.RenounceCapability(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "renouncing capability from message")
+	}
+	return nil
+}
+
+// grantRole delegates a role over a denom.
+func (m *CustomMessenger) grantRole(ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *bindingstypes.GrantRole) ([]sdk.Event, [][]byte, error) {
+	err := PerformGrantRole(m.tokenFactory, ctx, contractAddr, grantRole)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform grant role")
+	}
+	return nil, nil, nil
+}
+
+// PerformGrantRole delegates a role over a denom after validating the grantRole message.
+func PerformGrantRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *bindingstypes.GrantRole) error {
+	if grantRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "grant role null"}
+	}
+
+	address, err := parseAddress(grantRole.Address)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgGrantRole(contractAddr.String(), grantRole.Denom, address.String(), grantRole.Role, grantRole.Allowance)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Grant through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.GrantRole(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "granting role from message")
+	}
+	return nil
+}
+
+// revokeRole revokes a role over a denom.
+func (m *CustomMessenger) revokeRole(ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *bindingstypes.RevokeRole) ([]sdk.Event, [][]byte, error) {
+	err := PerformRevokeRole(m.tokenFactory, ctx, contractAddr, revokeRole)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform revoke role")
+	}
+	return nil, nil, nil
+}
+
+// PerformRevokeRole revokes a role over a denom after validating the revokeRole message.
+func PerformRevokeRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *bindingstypes.RevokeRole) error {
+	if revokeRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "revoke role null"}
+	}
+
+	address, err := parseAddress(revokeRole.Address)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRevokeRole(contractAddr.String(), revokeRole.Denom, address.String(), revokeRole.Role)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Revoke through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.RevokeRole(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "revoking role from message")
+	}
+	return nil
+}
+
+// setPaused pauses or resumes all the sends of a denom.
+func (m *CustomMessenger) setPaused(ctx sdk.Context, contractAddr sdk.AccAddress, setPaused *bindingstypes.SetPaused) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetPaused(m.tokenFactory, ctx, contractAddr, setPaused)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set paused")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetPaused pauses or resumes all the sends of a denom after validating the setPaused message.
+func PerformSetPaused(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setPaused *bindingstypes.SetPaused) error {
+	if setPaused == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set paused null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetPaused(contractAddr.String(), setPaused.Denom, setPaused.Paused)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Pause through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetPaused(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting paused from message")
+	}
+	return nil
+}
+
+// setFrozen freezes or unfreezes an account for a denom.
+func (m *CustomMessenger) setFrozen(ctx sdk.Context, contractAddr sdk.AccAddress, setFrozen *bindingstypes.SetFrozen) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetFrozen(m.tokenFactory, ctx, contractAddr, setFrozen)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set frozen")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetFrozen freezes or unfreezes an account for a denom after validating the setFrozen message.
+func PerformSetFrozen(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setFrozen *bindingstypes.SetFrozen) error {
+	if setFrozen == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set frozen null"}
+	}
+
+	address, err := parseAddress(setFrozen.Address)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetFrozen(contractAddr.String(), setFrozen.Denom, address.String(), setFrozen.Frozen)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Freeze through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.SetFrozen(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting frozen from message")
+	}
+	return nil
+}
+
 // createDenom creates a new token denom
 func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *bindingstypes.SetMetadata) ([]sdk.Event, [][]byte, error) {
 	err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
@@ -275,13 +480,16 @@ func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
-	// ensure contract address is admin of denom
+	// ensure contract address is admin or metadata updater of denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if auth.Admin != contractAddr.String() && !f.HasDenomRole(ctx, denom, tokenfactorytypes.DenomRoleMetadataUpdater, contractAddr.String()) {
+		return wasmvmtypes.InvalidRequest{Err: "only admin or metadata updater can set metadata"}
+	}
+	if auth.IsCapabilityRenounced(tokenfactorytypes.DenomCapabilityMetadata) {
+		return tokenfactorytypes.ErrCapabilityRenounced.Wrapf("capability %s", tokenfactorytypes.DenomCapabilityMetadata)
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
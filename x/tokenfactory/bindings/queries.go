@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	bindingstypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings/types"
 	tokenfactorykeeper "github.com/CosmosContracts/juno/v23/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
 
 type QueryPlugin struct {
@@ -55,3 +57,66 @@ func (qp QueryPlugin) GetParams(ctx sdk.Context) (*bindingstypes.ParamsResponse,
 		},
 	}, nil
 }
+
+func (qp QueryPlugin) GetBeforeSendHookAddress(ctx sdk.Context, denom string) (*bindingstypes.BeforeSendHookAddressResponse, error) {
+	cosmwasmAddress := qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, denom)
+	return &bindingstypes.BeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (qp QueryPlugin) GetAllDenoms(ctx sdk.Context, pagination *bindingstypes.PageRequest) (*bindingstypes.AllDenomsResponse, error) {
+	req := &tokenfactorytypes.QueryAllDenomsRequest{}
+	if pagination != nil {
+		req.Pagination = &query.PageRequest{
+			Key:     pagination.Key,
+			Limit:   pagination.Limit,
+			Reverse: pagination.Reverse,
+		}
+	}
+
+	res, err := qp.tokenFactoryKeeper.AllDenoms(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindingstypes.AllDenomsResponse{
+		Denoms:     res.Denoms,
+		Pagination: bindingstypes.PageResponse{NextKey: res.Pagination.GetNextKey()},
+	}, nil
+}
+
+func (qp QueryPlugin) GetDenomSupply(ctx sdk.Context, denom string) (*bindingstypes.DenomSupplyResponse, error) {
+	res, err := qp.tokenFactoryKeeper.DenomMaxSupply(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomMaxSupplyRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindingstypes.DenomSupplyResponse{Supply: res.Supply.Amount, MaxSupply: res.MaxSupply}, nil
+}
+
+func (qp QueryPlugin) GetDenomCapabilities(ctx sdk.Context, denom string) (*bindingstypes.DenomCapabilitiesResponse, error) {
+	res, err := qp.tokenFactoryKeeper.DenomCapabilities(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomCapabilitiesRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	renounced := res.RenouncedCapabilities
+	if renounced == nil {
+		renounced = []string{}
+	}
+
+	return &bindingstypes.DenomCapabilitiesResponse{Capabilities: res.Capabilities, RenouncedCapabilities: renounced}, nil
+}
+
+func (qp QueryPlugin) GetDenomAdmins(ctx sdk.Context, denoms []string) (*bindingstypes.DenomAdminsResponse, error) {
+	res, err := qp.tokenFactoryKeeper.DenomAdmins(sdk.WrapSDKContext(ctx), &tokenfactorytypes.QueryDenomAdminsRequest{Denoms: denoms})
+	if err != nil {
+		return nil, err
+	}
+
+	admins := make([]bindingstypes.DenomAdminResponse, 0, len(res.Admins))
+	for _, admin := range res.Admins {
+		admins = append(admins, bindingstypes.DenomAdminResponse{Denom: admin.Denom, Admin: admin.Admin})
+	}
+
+	return &bindingstypes.DenomAdminsResponse{Admins: admins}, nil
+}
@@ -94,6 +94,71 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.BeforeSendHookAddress != nil:
+			res, err := qp.GetBeforeSendHookAddress(ctx, contractQuery.BeforeSendHookAddress.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookAddressResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.AllDenoms != nil:
+			res, err := qp.GetAllDenoms(ctx, contractQuery.AllDenoms.Pagination)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal AllDenomsResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.DenomSupply != nil:
+			res, err := qp.GetDenomSupply(ctx, contractQuery.DenomSupply.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomSupplyResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.DenomCapabilities != nil:
+			res, err := qp.GetDenomCapabilities(ctx, contractQuery.DenomCapabilities.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomCapabilitiesResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.Admins != nil:
+			res, err := qp.GetDenomAdmins(ctx, contractQuery.Admins.Denoms)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal DenomAdminsResponse: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token query variant"}
 		}
@@ -9,16 +9,32 @@ type TokenFactoryMsg struct {
 	/// Contracts can change the admin of a denom that they are the admin of.
 	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
-	/// that they are the admin of.
+	/// that they are the admin or a minter of.
 	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
 	/// Contracts can burn native tokens for an existing factory denom
-	/// that they are the admin of.
+	/// that they are the admin or a burner of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
-	/// Sets the metadata on a denom which the contract controls
+	/// Sets the metadata on a denom which the contract controls or is the
+	/// metadata updater of.
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Sets the contract sudo-called before every send of a denom which the
+	/// contract controls. An empty address removes the hook.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Irrevocably renounces a capability ("mint", "burn_from", "force_transfer",
+	/// "metadata" or "freeze") over a denom which the contract controls.
+	RenounceCapability *RenounceCapability `json:"renounce_capability,omitempty"`
+	/// Delegates a role ("minter", "burner" or "metadata_updater") over a
+	/// denom which the contract controls to another address.
+	GrantRole *GrantRole `json:"grant_role,omitempty"`
+	/// Revokes a role over a denom which the contract controls.
+	RevokeRole *RevokeRole `json:"revoke_role,omitempty"`
+	/// Pauses or resumes all the sends of a denom which the contract controls.
+	SetPaused *SetPaused `json:"set_paused,omitempty"`
+	/// Freezes or unfreezes an account for a denom which the contract controls.
+	SetFrozen *SetFrozen `json:"set_frozen,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -62,3 +78,39 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+type SetBeforeSendHook struct {
+	Denom           string `json:"denom"`
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+type RenounceCapability struct {
+	Denom      string `json:"denom"`
+	Capability string `json:"capability"`
+}
+
+// GrantRole delegates a role over a denom to an address. Allowance is the
+// amount a minter can mint, unset meaning unlimited.
+type GrantRole struct {
+	Denom     string    `json:"denom"`
+	Address   string    `json:"address"`
+	Role      string    `json:"role"`
+	Allowance *math.Int `json:"allowance,omitempty"`
+}
+
+type RevokeRole struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Role    string `json:"role"`
+}
+
+type SetPaused struct {
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+type SetFrozen struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Frozen  bool   `json:"frozen"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/math"
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type TokenFactoryQuery struct {
 	/// Given a subdenom minted by a contract via `OsmosisMsg::MintTokens`,
@@ -9,6 +11,16 @@ type TokenFactoryQuery struct {
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	/// Returns the contract sudo-called before every send of a denom.
+	BeforeSendHookAddress *BeforeSendHookAddress `json:"before_send_hook_address,omitempty"`
+	/// Returns a page of all the denoms created with the token factory.
+	AllDenoms *AllDenoms `json:"all_denoms,omitempty"`
+	/// Returns the total supply and the max supply of a denom.
+	DenomSupply *DenomSupply `json:"denom_supply,omitempty"`
+	/// Returns the capabilities the admin of a denom can still use.
+	DenomCapabilities *DenomCapabilities `json:"denom_capabilities,omitempty"`
+	/// Returns the admins of up to 100 denoms.
+	Admins *DenomAdmins `json:"admins,omitempty"`
 }
 
 // query types
@@ -32,6 +44,34 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type BeforeSendHookAddress struct {
+	Denom string `json:"denom"`
+}
+
+// PageRequest mirrors the Cosmos SDK query.PageRequest. Key is the next_key of
+// the previous page.
+type PageRequest struct {
+	Key     []byte `json:"key,omitempty"`
+	Limit   uint64 `json:"limit,omitempty"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+type AllDenoms struct {
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+type DenomSupply struct {
+	Denom string `json:"denom"`
+}
+
+type DenomCapabilities struct {
+	Denom string `json:"denom"`
+}
+
+type DenomAdmins struct {
+	Denoms []string `json:"denoms"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -53,3 +93,38 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type BeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+// PageResponse mirrors the Cosmos SDK query.PageResponse. An empty NextKey
+// means there are no more results.
+type PageResponse struct {
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+type AllDenomsResponse struct {
+	Denoms     []string     `json:"denoms"`
+	Pagination PageResponse `json:"pagination"`
+}
+
+type DenomSupplyResponse struct {
+	Supply math.Int `json:"supply"`
+	// MaxSupply of zero means the supply is not capped
+	MaxSupply math.Int `json:"max_supply"`
+}
+
+type DenomCapabilitiesResponse struct {
+	Capabilities          []string `json:"capabilities"`
+	RenouncedCapabilities []string `json:"renounced_capabilities"`
+}
+
+type DenomAdminsResponse struct {
+	Admins []DenomAdminResponse `json:"admins"`
+}
+
+type DenomAdminResponse struct {
+	Denom string `json:"denom"`
+	Admin string `json:"admin"`
+}
@@ -10,6 +10,7 @@ import (
 
 	wasmbinding "github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings"
 	bindings "github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings/types"
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v23/x/tokenfactory/keeper"
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
 
@@ -187,8 +188,15 @@ func TestMint(t *testing.T) {
 	_, err = wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, &emptyDenom)
 	require.NoError(t, err)
 
+	cappedDenom := bindings.CreateDenom{
+		Subdenom: "CAPPED",
+	}
+	_, err = wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, &cappedDenom)
+	require.NoError(t, err)
+
 	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
 	emptyDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), emptyDenom.Subdenom)
+	cappedDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), cappedDenom.Subdenom)
 
 	lucky := RandomAccountAddress()
 
@@ -199,6 +207,11 @@ func TestMint(t *testing.T) {
 	amount, ok := sdk.NewIntFromString("8080")
 	require.True(t, ok)
 
+	// cap the supply below the minted amount
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(junoapp.AppKeepers.TokenFactoryKeeper)
+	_, err = msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxSupply(creator.String(), cappedDenomStr, amount.SubRaw(1)))
+	require.NoError(t, err)
+
 	specs := map[string]struct {
 		mint   *bindings.MintTokens
 		expErr bool
@@ -270,6 +283,14 @@ func TestMint(t *testing.T) {
 			mint:   nil,
 			expErr: true,
 		},
+		"above max supply": {
+			mint: &bindings.MintTokens{
+				Denom:         cappedDenomStr,
+				Amount:        amount,
+				MintToAddress: lucky.String(),
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -285,6 +306,36 @@ func TestMint(t *testing.T) {
 	}
 }
 
+func TestMintWhilePaused(t *testing.T) {
+	creator := RandomAccountAddress()
+	junoapp, ctx := SetupCustomApp(t, creator)
+
+	tokenCreationFeeAmt := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount))
+	fundAccount(t, ctx, junoapp, creator, tokenCreationFeeAmt)
+
+	_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, &bindings.CreateDenom{Subdenom: "MOON"})
+	require.NoError(t, err)
+	denom := fmt.Sprintf("factory/%s/%s", creator.String(), "MOON")
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(junoapp.AppKeepers.TokenFactoryKeeper)
+	_, err = msgServer.SetPaused(sdk.WrapSDKContext(ctx), types.NewMsgSetPaused(creator.String(), denom, true))
+	require.NoError(t, err)
+
+	// mints are still allowed while the denom is paused, like with MsgMint
+	lucky := RandomAccountAddress()
+	mint := &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100), MintToAddress: lucky.String()}
+	err = wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), junoapp.AppKeepers.BankKeeper.GetBalance(ctx, lucky, denom).Amount)
+	require.True(t, junoapp.AppKeepers.BankKeeper.GetBalance(ctx, creator, denom).IsZero())
+
+	// but not to frozen accounts
+	_, err = msgServer.SetFrozen(sdk.WrapSDKContext(ctx), types.NewMsgSetFrozen(creator.String(), denom, lucky.String(), true))
+	require.NoError(t, err)
+	err = wasmbinding.PerformMint(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, creator, mint)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+}
+
 func TestBurn(t *testing.T) {
 	creator := RandomAccountAddress()
 	junoapp, ctx := SetupCustomApp(t, creator)
@@ -413,3 +464,209 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+func TestSetBeforeSendHook(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := RandomAccountAddress()
+
+	specs := map[string]struct {
+		actor             sdk.AccAddress
+		setBeforeSendHook *bindings.SetBeforeSendHook
+		expErr            bool
+	}{
+		"valid": {
+			setBeforeSendHook: &bindings.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: RandomBech32AccountAddress(),
+			},
+			actor: tokenCreator,
+		},
+		"remove hook": {
+			setBeforeSendHook: &bindings.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: "",
+			},
+			actor: tokenCreator,
+		},
+		"invalid contract address": {
+			setBeforeSendHook: &bindings.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: "juno1invalid",
+			},
+			actor:  tokenCreator,
+			expErr: true,
+		},
+		"not the admin": {
+			setBeforeSendHook: &bindings.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: RandomBech32AccountAddress(),
+			},
+			actor:  RandomAccountAddress(),
+			expErr: true,
+		},
+		"nil binding": {
+			actor:  tokenCreator,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			junoapp, ctx := SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			fundAccount(t, ctx, junoapp, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, tokenCreator, &bindings.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformSetBeforeSendHook(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, spec.actor, spec.setBeforeSendHook)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			hook := junoapp.AppKeepers.TokenFactoryKeeper.GetBeforeSendHook(ctx, spec.setBeforeSendHook.Denom)
+			require.Equal(t, spec.setBeforeSendHook.CosmwasmAddress, hook)
+		})
+	}
+}
+
+func TestGrantRole(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := RandomAccountAddress()
+	allowance := sdk.NewInt(100)
+
+	specs := map[string]struct {
+		actor     sdk.AccAddress
+		grantRole *bindings.GrantRole
+		expErr    bool
+	}{
+		"valid minter": {
+			grantRole: &bindings.GrantRole{
+				Denom:     fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				Address:   RandomBech32AccountAddress(),
+				Role:      types.DenomRoleMinter,
+				Allowance: &allowance,
+			},
+			actor: tokenCreator,
+		},
+		"valid metadata updater": {
+			grantRole: &bindings.GrantRole{
+				Denom:   fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				Address: RandomBech32AccountAddress(),
+				Role:    types.DenomRoleMetadataUpdater,
+			},
+			actor: tokenCreator,
+		},
+		"allowance for a burner": {
+			grantRole: &bindings.GrantRole{
+				Denom:     fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				Address:   RandomBech32AccountAddress(),
+				Role:      types.DenomRoleBurner,
+				Allowance: &allowance,
+			},
+			actor:  tokenCreator,
+			expErr: true,
+		},
+		"unknown role": {
+			grantRole: &bindings.GrantRole{
+				Denom:   fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				Address: RandomBech32AccountAddress(),
+				Role:    "admin",
+			},
+			actor:  tokenCreator,
+			expErr: true,
+		},
+		"not the admin": {
+			grantRole: &bindings.GrantRole{
+				Denom:   fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				Address: RandomBech32AccountAddress(),
+				Role:    types.DenomRoleMinter,
+			},
+			actor:  RandomAccountAddress(),
+			expErr: true,
+		},
+		"nil binding": {
+			actor:  tokenCreator,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			junoapp, ctx := SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			fundAccount(t, ctx, junoapp, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, tokenCreator, &bindings.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformGrantRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, spec.actor, spec.grantRole)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			role, found := junoapp.AppKeepers.TokenFactoryKeeper.GetDenomRole(ctx, spec.grantRole.Denom, spec.grantRole.Role, spec.grantRole.Address)
+			require.True(t, found)
+			require.Equal(t, spec.grantRole.Allowance, role.Allowance)
+
+			err = wasmbinding.PerformRevokeRole(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, spec.actor, &bindings.RevokeRole{
+				Denom:   spec.grantRole.Denom,
+				Address: spec.grantRole.Address,
+				Role:    spec.grantRole.Role,
+			})
+			require.NoError(t, err)
+			require.False(t, junoapp.AppKeepers.TokenFactoryKeeper.HasDenomRole(ctx, spec.grantRole.Denom, spec.grantRole.Role, spec.grantRole.Address))
+		})
+	}
+}
+
+func TestSetPausedAndFrozen(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := RandomAccountAddress()
+	holder := RandomBech32AccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	junoapp, ctx := SetupCustomApp(t, tokenCreator)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, junoapp, tokenCreator, actorAmount)
+
+	_, err := wasmbinding.PerformCreateDenom(&junoapp.AppKeepers.TokenFactoryKeeper, junoapp.AppKeepers.BankKeeper, ctx, tokenCreator, &bindings.CreateDenom{
+		Subdenom: validDenom,
+	})
+	require.NoError(t, err)
+
+	// only the admin can pause the denom or freeze accounts
+	err = wasmbinding.PerformSetPaused(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, RandomAccountAddress(), &bindings.SetPaused{Denom: denom, Paused: true})
+	require.Error(t, err)
+	err = wasmbinding.PerformSetFrozen(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, RandomAccountAddress(), &bindings.SetFrozen{Denom: denom, Address: holder, Frozen: true})
+	require.Error(t, err)
+	err = wasmbinding.PerformSetFrozen(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, tokenCreator, &bindings.SetFrozen{Denom: denom, Address: "juno1invalid", Frozen: true})
+	require.Error(t, err)
+	err = wasmbinding.PerformSetPaused(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, tokenCreator, nil)
+	require.Error(t, err)
+
+	err = wasmbinding.PerformSetPaused(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, tokenCreator, &bindings.SetPaused{Denom: denom, Paused: true})
+	require.NoError(t, err)
+	require.True(t, junoapp.AppKeepers.TokenFactoryKeeper.IsDenomPaused(ctx, denom))
+
+	err = wasmbinding.PerformSetFrozen(&junoapp.AppKeepers.TokenFactoryKeeper, ctx, tokenCreator, &bindings.SetFrozen{Denom: denom, Address: holder, Frozen: true})
+	require.NoError(t, err)
+	require.True(t, junoapp.AppKeepers.TokenFactoryKeeper.IsAccountFrozen(ctx, denom, holder))
+}
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	wasmbinding "github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings"
+	bindings "github.com/CosmosContracts/juno/v23/x/tokenfactory/bindings/types"
 )
 
 func TestFullDenom(t *testing.T) {
@@ -115,3 +116,51 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomQueries(t *testing.T) {
+	addr := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, addr)
+
+	// set token creation fee to zero to make testing easier
+	tfParams := app.AppKeepers.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	if err := app.AppKeepers.TokenFactoryKeeper.SetParams(ctx, tfParams); err != nil {
+		t.Fatal(err)
+	}
+
+	admin := sdk.AccAddress([]byte("addr1_______________"))
+	tfDenom, err := app.AppKeepers.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "subdenom")
+	require.NoError(t, err)
+	otherDenom, err := app.AppKeepers.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), "other")
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.AppKeepers.BankKeeper, &app.AppKeepers.TokenFactoryKeeper)
+
+	allDenoms, err := queryPlugin.GetAllDenoms(ctx, &bindings.PageRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, allDenoms.Denoms, 1)
+	require.NotEmpty(t, allDenoms.Pagination.NextKey)
+
+	allDenoms, err = queryPlugin.GetAllDenoms(ctx, nil)
+	require.NoError(t, err)
+	require.Subset(t, allDenoms.Denoms, []string{tfDenom, otherDenom})
+
+	supply, err := queryPlugin.GetDenomSupply(ctx, tfDenom)
+	require.NoError(t, err)
+	require.True(t, supply.Supply.IsZero())
+
+	capabilities, err := queryPlugin.GetDenomCapabilities(ctx, tfDenom)
+	require.NoError(t, err)
+	require.NotEmpty(t, capabilities.Capabilities)
+	require.Empty(t, capabilities.RenouncedCapabilities)
+
+	_, err = queryPlugin.GetDenomCapabilities(ctx, "ujuno")
+	require.Error(t, err)
+
+	admins, err := queryPlugin.GetDenomAdmins(ctx, []string{tfDenom, otherDenom})
+	require.NoError(t, err)
+	require.Equal(t, []bindings.DenomAdminResponse{
+		{Denom: tfDenom, Admin: admin.String()},
+		{Denom: otherDenom, Admin: admin.String()},
+	}, admins.Admins)
+}
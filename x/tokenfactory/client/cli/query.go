@@ -26,6 +26,14 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomMaxSupply(),
+		GetCmdBeforeSendHookAddress(),
+		GetCmdDenomRoles(),
+		GetCmdDenomPaused(),
+		GetCmdFrozenAccounts(),
+		GetCmdAllDenoms(),
+		GetCmdDenomCapabilities(),
+		GetCmdDenomAdmins(),
 	)
 
 	return cmd
@@ -115,3 +123,261 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomMaxSupply a command to get the max supply and the current supply of a specific denom
+func GetCmdDenomMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-max-supply [denom] [flags]",
+		Short: "Get the max supply and the current supply of a specific denom. A max supply of zero means the supply is not capped",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomMaxSupply(cmd.Context(), &types.QueryDenomMaxSupplyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBeforeSendHookAddress a command to get the before send hook contract of a specific denom
+func GetCmdBeforeSendHookAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Get the contract sudo-called before every send of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomRoles a command to get the roles delegated over a specific denom
+func GetCmdDenomRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-roles [denom] [minter|burner|metadata_updater] [flags]",
+		Short: "Get the roles delegated by the admin of a specific denom, optionally filtered by role",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role := ""
+			if len(args) > 1 {
+				role = args[1]
+			}
+
+			res, err := queryClient.DenomRoles(cmd.Context(), &types.QueryDenomRolesRequest{
+				Denom:      args[0],
+				Role:       role,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-roles")
+
+	return cmd
+}
+
+// GetCmdDenomPaused a command to get whether all the sends of a specific denom are paused
+func GetCmdDenomPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-paused [denom] [flags]",
+		Short: "Get whether all the sends of a specific denom are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomPaused(cmd.Context(), &types.QueryDenomPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAccounts a command to get the accounts frozen for a specific denom
+func GetCmdFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts [denom] [flags]",
+		Short: "Get the accounts which can neither send nor receive a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-accounts")
+
+	return cmd
+}
+
+// GetCmdAllDenoms a command to get all the denoms created with the tokenfactory module
+func GetCmdAllDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-denoms [flags]",
+		Short: "Returns a list of all the tokens created with the tokenfactory module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllDenoms(cmd.Context(), &types.QueryAllDenomsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-denoms")
+
+	return cmd
+}
+
+// GetCmdDenomCapabilities a command to get the capabilities the admin of a specific denom can still use
+func GetCmdDenomCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-capabilities [denom] [flags]",
+		Short: "Get the capabilities the admin of a specific denom can still use, and the ones it renounced",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomCapabilities(cmd.Context(), &types.QueryDenomCapabilitiesRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomAdmins a command to get the admins of multiple denoms
+func GetCmdDenomAdmins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-admins [denom] [denom...] [flags]",
+		Short: fmt.Sprintf("Get the admins of up to %d denoms", types.MaxDenomAdminsQueryDenoms),
+		Args:  cobra.RangeArgs(1, types.MaxDenomAdminsQueryDenoms),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomAdmins(cmd.Context(), &types.QueryDenomAdminsRequest{
+				Denoms: args,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
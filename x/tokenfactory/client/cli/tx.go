@@ -16,6 +16,9 @@ import (
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
 
+// FlagAllowance defines the flag for the amount a minter can mint
+const FlagAllowance = "allowance"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +38,13 @@ func GetTxCmd() *cobra.Command {
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewRenounceCapabilityCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetPausedCmd(),
+		NewSetFrozenCmd(),
 	)
 
 	return cmd
@@ -299,6 +309,263 @@ func NewChangeAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Caps the total supply of a factory-created denom. Once set, the max supply can only be decreased. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the contract sudo-called before every send of a factory-created denom, which can block the send. An empty address removes the hook. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceCapabilityCmd broadcast MsgRenounceCapability
+func NewRenounceCapabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-capability [denom] [mint|burn_from|force_transfer|metadata|freeze] [flags]",
+		Short: "Irrevocably renounces a capability of the admin over a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRenounceCapability(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [address] [minter|burner|metadata_updater] [flags]",
+		Short: "Delegates a role over a factory-created denom to an address, or updates the allowance of a minter. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			var allowance *sdk.Int
+			allowanceStr, err := cmd.Flags().GetString(FlagAllowance)
+			if err != nil {
+				return err
+			}
+			if allowanceStr != "" {
+				amount, ok := sdk.NewIntFromString(allowanceStr)
+				if !ok {
+					return fmt.Errorf("invalid allowance: %s", allowanceStr)
+				}
+				allowance = &amount
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				allowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAllowance, "", "Amount a minter can mint. Unset means unlimited")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [address] [minter|burner|metadata_updater] [flags]",
+		Short: "Revokes a role over a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetPausedCmd broadcast MsgSetPaused
+func NewSetPausedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paused [denom] [true|false] [flags]",
+		Short: "Pauses or resumes all the sends of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				paused,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetFrozenCmd broadcast MsgSetFrozen
+func NewSetFrozenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-frozen [denom] [address] [true|false] [flags]",
+		Short: "Freezes or unfreezes an account for a factory-created denom. Frozen accounts can neither send nor receive the denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			frozen, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFrozen(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				frozen,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewModifyDenomMetadataCmd broadcast a Bank Metadata modification transaction
 func NewModifyDenomMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -48,3 +48,46 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// GetDenomCapabilities returns the capabilities the admin can still use over a
+// specific denom: the ones enabled on chain which it did not renounce
+func (k Keeper) GetDenomCapabilities(ctx sdk.Context, denom string) ([]string, error) {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := []string{}
+	for _, capability := range types.DenomCapabilities {
+		if types.IsDenomCapabilityEnabled(k.enabledCapabilities, capability) && !metadata.IsCapabilityRenounced(capability) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	return capabilities, nil
+}
+
+// renounceCapability irrevocably removes a capability of the admin over a denom
+func (k Keeper) renounceCapability(ctx sdk.Context, denom string, capability string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.IsCapabilityRenounced(capability) {
+		return types.ErrCapabilityRenounced.Wrapf("capability %s", capability)
+	}
+
+	metadata.RenouncedCapabilities = append(metadata.RenouncedCapabilities, capability)
+
+	// holders of a denom which can no longer be frozen are never left frozen,
+	// nor blocked by a before send hook
+	if capability == types.DenomCapabilityFreeze {
+		k.unfreezeDenom(ctx, denom)
+		if err := k.setBeforeSendHook(ctx, denom, ""); err != nil {
+			return err
+		}
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
@@ -524,3 +524,151 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetMaxSupply() {
+	// Create a denom and mint some supply
+	suite.CreateDefaultDenom()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	_, found := suite.App.AppKeepers.TokenFactoryKeeper.GetMaxSupply(suite.Ctx, suite.defaultDenom)
+	suite.Require().False(found)
+
+	for _, tc := range []struct {
+		desc       string
+		msg        types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			desc:       "not the admin",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[1].String(), suite.defaultDenom, sdk.NewInt(1_000)),
+			expectPass: false,
+		},
+		{
+			desc:       "lower than the current supply",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(99)),
+			expectPass: false,
+		},
+		{
+			desc:       "zero max supply",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.ZeroInt()),
+			expectPass: false,
+		},
+		{
+			desc:       "success case - set max supply",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(1_000)),
+			expectPass: true,
+		},
+		{
+			desc:       "increase max supply",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(1_001)),
+			expectPass: false,
+		},
+		{
+			desc:       "success case - decrease max supply to the current supply",
+			msg:        *types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(100)),
+			expectPass: true,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			tc := tc
+			before, _ := suite.App.AppKeepers.TokenFactoryKeeper.GetMaxSupply(suite.Ctx, suite.defaultDenom)
+
+			_, err := suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), &tc.msg)
+			maxSupply, _ := suite.App.AppKeepers.TokenFactoryKeeper.GetMaxSupply(suite.Ctx, suite.defaultDenom)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.MaxSupply, maxSupply)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(before, maxSupply)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMintAboveMaxSupply() {
+	// Create a denom and cap its supply
+	suite.CreateDefaultDenom()
+	_, err := suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 60)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 41)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 40)))
+	suite.Require().NoError(err)
+
+	// burning frees up supply that can be minted again
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.DenomMaxSupply(suite.Ctx.Context(), &types.QueryDenomMaxSupplyRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), res.MaxSupply)
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 90), res.Supply)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRenounceCapability() {
+	// Create a denom and mint some tokens to a holder
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	holder := suite.TestAccs[1].String()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), holder))
+	suite.Require().NoError(err)
+
+	// only the admin can renounce a capability
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(holder, suite.defaultDenom, types.DenomCapabilityMint))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	for _, capability := range types.DenomCapabilities {
+		_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, suite.defaultDenom, capability))
+		suite.Require().NoError(err)
+	}
+
+	// capabilities can only be renounced once
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, suite.defaultDenom, types.DenomCapabilityMint))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+	res, err := suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomCapabilities, res.AuthorityMetadata.RenouncedCapabilities)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), holder))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), holder, admin))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(admin, banktypes.Metadata{
+		Description: "yeehaw",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    suite.defaultDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    suite.defaultDenom,
+		Display: suite.defaultDenom,
+		Name:    suite.defaultDenom,
+		Symbol:  suite.defaultDenom,
+	}))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+	// the admin can still be changed
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(admin, suite.defaultDenom, holder))
+	suite.Require().NoError(err)
+
+	// renounced capabilities survive an admin change
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(holder, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+var _ bankkeeper.Keeper = HookedBankKeeper{}
+
+// HookedBankKeeper wraps a bank keeper and calls the BankHooks before every
+// send between accounts, except the payouts of module accounts, such as
+// rewards and refunds, which are never blocked. The hooks are shared between all copies of the
+// keeper, so they can be set after the keeper is handed to other modules.
+type HookedBankKeeper struct {
+	bankkeeper.Keeper
+
+	hooks *types.BankHooks
+}
+
+// NewHookedBankKeeper returns a bank keeper that calls the BankHooks set with
+// SetHooks before every send
+func NewHookedBankKeeper(keeper bankkeeper.Keeper) HookedBankKeeper {
+	return HookedBankKeeper{
+		Keeper: keeper,
+		hooks:  new(types.BankHooks),
+	}
+}
+
+// SetHooks sets the hooks called before every send
+func (k HookedBankKeeper) SetHooks(hooks types.BankHooks) {
+	if *k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	*k.hooks = hooks
+}
+
+// blockBeforeSend calls the hooks with the tokenfactory denoms of amount. Sends
+// without any tokenfactory denom return early, without reading the store.
+func (k HookedBankKeeper) blockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if *k.hooks == nil {
+		return nil
+	}
+
+	var factoryCoins sdk.Coins
+	for _, coin := range amount {
+		if strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			factoryCoins = append(factoryCoins, coin)
+		}
+	}
+
+	if len(factoryCoins) == 0 {
+		return nil
+	}
+
+	return (*k.hooks).BlockBeforeSend(ctx, from, to, factoryCoins)
+}
+
+func (k HookedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// SendCoinsWithoutHooks sends coins through the wrapped bank keeper, without
+// calling the hooks. It is only meant for the force transfers of denom admins,
+// which must work on frozen accounts, paused denoms and broken hooks alike.
+func (k HookedBankKeeper) SendCoinsWithoutHooks(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins calls the hooks from the input to every output. With several
+// inputs the sender of each output is unknown, so these sends are rejected
+// when they include any tokenfactory denom.
+func (k HookedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	if len(inputs) != 1 {
+		for _, input := range inputs {
+			for _, coin := range input.Coins {
+				if strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+					return types.ErrMultipleInputs.Wrapf("denom %s", coin.Denom)
+				}
+			}
+		}
+
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	}
+
+	from, err := sdk.AccAddressFromBech32(inputs[0].Address)
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		to, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.blockBeforeSend(ctx, from, to, output.Coins); err != nil {
+			return err
+		}
+	}
+
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+func (k HookedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
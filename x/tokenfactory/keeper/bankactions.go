@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
@@ -13,6 +14,10 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	if err := k.assertMaxSupply(ctx, amount); err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -27,6 +32,12 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return types.ErrModuleAccount
 	}
 
+	// the bank hooks are not called on module payouts, so frozen accounts are
+	// checked here
+	if err := k.assertSendNotFrozen(ctx, authtypes.NewModuleAddress(types.ModuleName), addr, amount.Denom); err != nil {
+		return err
+	}
+
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
@@ -84,7 +95,9 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return types.ErrModuleAccount
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromAcc, toAcc, sdk.NewCoins(amount))
+	// the admin can recover tokens from frozen accounts, while the denom is
+	// paused, or when the before send hook is broken
+	return k.bankKeeper.SendCoinsWithoutHooks(ctx, fromAcc, toAcc, sdk.NewCoins(amount))
 }
 
 // IsModuleAcc checks if a given address is restricted
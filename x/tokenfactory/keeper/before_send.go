@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	helpers "github.com/CosmosContracts/juno/v23/app/helpers"
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+var _ types.BankHooks = Keeper{}
+
+type SudoMsg struct {
+	BlockBeforeSend *BlockBeforeSendMsg `json:"block_before_send,omitempty"`
+}
+
+type BlockBeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// GetBeforeSendHook returns the CosmWasm contract called before every send of
+// a specific denom. An empty string means the denom has no before send hook.
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookAddressKey))
+	return string(bz)
+}
+
+// setBeforeSendHook sets the CosmWasm contract called before every send of a
+// specific denom. An empty address removes the hook.
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) error {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cosmwasmAddress); err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+	return nil
+}
+
+// BlockBeforeSend returns an error if any denom in amount is paused or frozen
+// for from or to, then sudo calls the before send hook contract of every denom
+// in amount, and returns an error if any of them blocks the send. Each
+// contract call is capped at BeforeSendHookGasLimit gas.
+func (k Keeper) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if err := k.assertSendNotFrozen(ctx, from, to, coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range amount {
+		cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
+		if cosmwasmAddress == "" {
+			continue
+		}
+
+		contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+		if err != nil {
+			return err
+		}
+
+		msgBz, err := json.Marshal(SudoMsg{
+			BlockBeforeSend: &BlockBeforeSendMsg{
+				From:   from.String(),
+				To:     to.String(),
+				Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		childCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.BeforeSendHookGasLimit))
+		helpers.ExecuteContract(k.contractKeeper, childCtx, contractAddr, msgBz, &err)
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "tokenfactory before send hook")
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrBeforeSendHookBlocked, "denom %s: %s", coin.Denom, err)
+		}
+	}
+
+	return nil
+}
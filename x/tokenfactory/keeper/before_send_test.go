@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/app/apptesting"
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestSetBeforeSendHook() {
+	// Create a denom
+	suite.CreateDefaultDenom()
+
+	for _, tc := range []struct {
+		desc       string
+		msg        types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			desc:       "not the admin",
+			msg:        *types.NewMsgSetBeforeSendHook(suite.TestAccs[1].String(), suite.defaultDenom, suite.TestAccs[2].String()),
+			expectPass: false,
+		},
+		{
+			desc:       "denom does not exist",
+			msg:        *types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), fmt.Sprintf("factory/%s/litecoin", suite.TestAccs[0]), suite.TestAccs[2].String()),
+			expectPass: false,
+		},
+		{
+			desc:       "success case - set hook",
+			msg:        *types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()),
+			expectPass: true,
+		},
+		{
+			desc:       "success case - remove hook",
+			msg:        *types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""),
+			expectPass: true,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			tc := tc
+			before := suite.App.AppKeepers.TokenFactoryKeeper.GetBeforeSendHook(suite.Ctx, suite.defaultDenom)
+
+			_, err := suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), &tc.msg)
+
+			res, queryErr := suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+			suite.Require().NoError(queryErr)
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.CosmwasmAddress, res.CosmwasmAddress)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(before, res.CosmwasmAddress)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookBlocksSends() {
+	// Create a denom and mint some tokens
+	suite.CreateDefaultDenom()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// a hook which is not a contract fails every sudo call, blocking all sends
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	otherCoins := sdk.NewCoins(sdk.NewCoin(apptesting.SecondaryDenom, sdk.NewInt(10)))
+
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookBlocked)
+
+	err = bankKeeper.InputOutputCoins(suite.Ctx,
+		[]banktypes.Input{banktypes.NewInput(suite.TestAccs[0], factoryCoins)},
+		[]banktypes.Output{banktypes.NewOutput(suite.TestAccs[1], factoryCoins)},
+	)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookBlocked)
+
+	msgSend := banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	_, err = suite.App.MsgServiceRouter().Handler(msgSend)(suite.Ctx, msgSend)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookBlocked)
+
+	err = bankKeeper.SendCoinsFromAccountToModule(suite.Ctx, suite.TestAccs[0], types.ModuleName, factoryCoins)
+	suite.Require().ErrorIs(err, types.ErrBeforeSendHookBlocked)
+
+	// denoms without a hook are not affected
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], otherCoins)
+	suite.Require().NoError(err)
+
+	// removing the hook unblocks sends
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(factoryCoins[0], bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom))
+}
+
+func (suite *KeeperTestSuite) TestMultipleInputsCannotSendFactoryDenoms() {
+	suite.CreateDefaultDenom()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100), suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	otherCoins := sdk.NewCoins(sdk.NewCoin(apptesting.SecondaryDenom, sdk.NewInt(10)))
+
+	// the sender of each output is unknown, so the freezes and hooks could be
+	// bypassed
+	err = bankKeeper.InputOutputCoins(suite.Ctx,
+		[]banktypes.Input{banktypes.NewInput(suite.TestAccs[0], otherCoins), banktypes.NewInput(suite.TestAccs[1], factoryCoins)},
+		[]banktypes.Output{banktypes.NewOutput(suite.TestAccs[2], otherCoins.Add(factoryCoins...))},
+	)
+	suite.Require().ErrorIs(err, types.ErrMultipleInputs)
+
+	// other denoms are not affected
+	err = bankKeeper.InputOutputCoins(suite.Ctx,
+		[]banktypes.Input{banktypes.NewInput(suite.TestAccs[0], otherCoins), banktypes.NewInput(suite.TestAccs[1], otherCoins)},
+		[]banktypes.Output{banktypes.NewOutput(suite.TestAccs[2], otherCoins.Add(otherCoins...))},
+	)
+	suite.Require().NoError(err)
+}
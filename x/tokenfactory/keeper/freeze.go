@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+// IsDenomPaused returns whether all the sends of a specific denom are paused
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+// setDenomPaused pauses or resumes all the sends of a specific denom
+func (k Keeper) setDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if !paused {
+		store.Delete([]byte(types.DenomPausedKey))
+		return
+	}
+
+	store.Set([]byte(types.DenomPausedKey), []byte{0x01})
+}
+
+// GetFrozenAccountsStore returns the store of the accounts frozen for a
+// specific denom
+func (k Keeper) GetFrozenAccountsStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAccountsPrefix())
+}
+
+// IsAccountFrozen returns whether an account can neither send nor receive a
+// specific denom
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, denom string, address string) bool {
+	return k.GetFrozenAccountsStore(ctx, denom).Has([]byte(address))
+}
+
+// GetFrozenAccounts returns all the accounts frozen for a specific denom
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, denom string) []string {
+	iterator := k.GetFrozenAccountsStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()))
+	}
+
+	return addresses
+}
+
+// setAccountFrozen freezes or unfreezes an account for a specific denom
+func (k Keeper) setAccountFrozen(ctx sdk.Context, denom string, address string, frozen bool) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return err
+	}
+
+	store := k.GetFrozenAccountsStore(ctx, denom)
+
+	if !frozen {
+		store.Delete([]byte(address))
+		return nil
+	}
+
+	store.Set([]byte(address), []byte{0x01})
+	return nil
+}
+
+// unfreezeDenom resumes the sends of a specific denom and unfreezes all its
+// frozen accounts
+func (k Keeper) unfreezeDenom(ctx sdk.Context, denom string) {
+	k.setDenomPaused(ctx, denom, false)
+
+	store := k.GetFrozenAccountsStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// assertSendNotFrozen returns an error if the denom is paused, or if from or to
+// is frozen for the denom. Mints and burns are allowed while the denom is
+// paused, and burns are allowed from frozen accounts.
+func (k Keeper) assertSendNotFrozen(ctx sdk.Context, from, to sdk.AccAddress, denom string) error {
+	if !strings.HasPrefix(denom, types.ModuleDenomPrefix+"/") {
+		return nil
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if to.Equals(moduleAddr) {
+		return nil
+	}
+
+	if !from.Equals(moduleAddr) {
+		if k.IsDenomPaused(ctx, denom) {
+			return types.ErrDenomPaused.Wrapf("denom %s", denom)
+		}
+
+		if k.IsAccountFrozen(ctx, denom, from.String()) {
+			return types.ErrAccountFrozen.Wrapf("%s for denom %s", from, denom)
+		}
+	}
+
+	if k.IsAccountFrozen(ctx, denom, to.String()) {
+		return types.ErrAccountFrozen.Wrapf("%s for denom %s", to, denom)
+	}
+
+	return nil
+}
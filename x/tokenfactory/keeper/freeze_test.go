@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestPauseDenom() {
+	// Create a denom and mint some tokens
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	err = bankKeeper.SendCoinsFromAccountToModule(suite.Ctx, suite.TestAccs[0], authtypes.FeeCollectorName, factoryCoins)
+	suite.Require().NoError(err)
+
+	// only the admin can pause a denom
+	_, err = suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(suite.TestAccs[1].String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Paused)
+
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	msgSend := banktypes.NewMsgSend(suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	_, err = suite.App.MsgServiceRouter().Handler(msgSend)(suite.Ctx, msgSend)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// payouts of module accounts are never blocked
+	err = bankKeeper.SendCoinsFromModuleToAccount(suite.Ctx, authtypes.FeeCollectorName, suite.TestAccs[1], factoryCoins)
+	suite.Require().NoError(err)
+
+	// mints and burns are still allowed
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+
+	// resuming the denom unblocks sends
+	_, err = suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(admin, suite.defaultDenom, false))
+	suite.Require().NoError(err)
+
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	// Create a denom and mint some tokens to a holder
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	holder := suite.TestAccs[1].String()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), holder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// only the admin can freeze an account
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(holder, suite.defaultDenom, holder, false))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(admin, suite.defaultDenom, holder, true))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.FrozenAccounts(suite.Ctx.Context(), &types.QueryFrozenAccountsRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder}, res.Addresses)
+
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+	factoryCoins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+
+	// frozen accounts can neither send nor receive the denom
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[1], suite.TestAccs[2], factoryCoins)
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], factoryCoins)
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), holder))
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+	// other accounts are not affected, and the frozen balance can be burned
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[2], factoryCoins)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), holder))
+	suite.Require().NoError(err)
+
+	// unfreezing the account unblocks sends
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(admin, suite.defaultDenom, holder, false))
+	suite.Require().NoError(err)
+	err = bankKeeper.SendCoins(suite.Ctx, suite.TestAccs[1], suite.TestAccs[2], factoryCoins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRenounceFreezeCapability() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	holder := suite.TestAccs[1].String()
+
+	_, err := suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(admin, suite.defaultDenom, holder, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, holder))
+	suite.Require().NoError(err)
+
+	// renouncing the capability resumes the denom, unfreezes its accounts and
+	// removes its before send hook
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, suite.defaultDenom, types.DenomCapabilityFreeze))
+	suite.Require().NoError(err)
+
+	tokenFactoryKeeper := suite.App.AppKeepers.TokenFactoryKeeper
+	suite.Require().False(tokenFactoryKeeper.IsDenomPaused(suite.Ctx, suite.defaultDenom))
+	suite.Require().False(tokenFactoryKeeper.IsAccountFrozen(suite.Ctx, suite.defaultDenom, holder))
+	suite.Require().Empty(tokenFactoryKeeper.GetBeforeSendHook(suite.Ctx, suite.defaultDenom))
+
+	_, err = suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(admin, suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(admin, suite.defaultDenom, holder, true))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, holder))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+}
+
+func (suite *KeeperTestSuite) TestForceTransferIgnoresFreezes() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	holder := suite.TestAccs[1].String()
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), holder))
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+	forceTransfer := types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), holder, admin)
+
+	// the admin can claw tokens back from a frozen account
+	_, err = suite.msgServer.SetFrozen(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetFrozen(admin, suite.defaultDenom, holder, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), forceTransfer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(90), bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// and move tokens while the denom is paused
+	_, err = suite.msgServer.SetPaused(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetPaused(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), forceTransfer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(80), bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// a broken before send hook does not block the recovery either
+	_, err = suite.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(sdk.WrapSDKContext(suite.Ctx), forceTransfer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+}
@@ -31,6 +31,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if !genDenom.MaxSupply.IsNil() && genDenom.MaxSupply.IsPositive() {
+			err = k.setMaxSupply(ctx, genDenom.GetDenom(), genDenom.MaxSupply)
+			if err != nil {
+				panic(err)
+			}
+		}
+		err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.BeforeSendHookAddress)
+		if err != nil {
+			panic(err)
+		}
+		for _, role := range genDenom.Roles {
+			err = k.setDenomRole(ctx, genDenom.GetDenom(), role)
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.setDenomPaused(ctx, genDenom.GetDenom(), genDenom.Paused)
+		for _, address := range genDenom.FrozenAddresses {
+			err = k.setAccountFrozen(ctx, genDenom.GetDenom(), address, true)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -47,9 +70,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		maxSupply, _ := k.GetMaxSupply(ctx, denom)
+		beforeSendHook := k.GetBeforeSendHook(ctx, denom)
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			MaxSupply:             maxSupply,
+			BeforeSendHookAddress: beforeSendHook,
+			Roles:                 k.GetDenomRoles(ctx, denom),
+			Paused:                k.IsDenomPaused(ctx, denom),
+			FrozenAddresses:       k.GetFrozenAccounts(ctx, denom),
 		})
 	}
 
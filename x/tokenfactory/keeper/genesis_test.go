@@ -8,6 +8,7 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
+	allowance := sdk.NewInt(1_000_000)
 	genesisState := types.GenesisState{
 		FactoryDenoms: []types.GenesisDenom{
 			{
@@ -15,18 +16,29 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "juno1t7egva48prqmzl59x5ngv4zx0dtrwewcmjwfym",
 				},
+				MaxSupply: sdk.ZeroInt(),
 			},
 			{
 				Denom: "factory/juno1t7egva48prqmzl59x5ngv4zx0dtrwewcmjwfym/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "juno15czt5nhlnvayqq37xun9s9yus0d6y26dsvkcna",
 				},
+				MaxSupply: sdk.ZeroInt(),
 			},
 			{
 				Denom: "factory/juno1t7egva48prqmzl59x5ngv4zx0dtrwewcmjwfym/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "juno1t7egva48prqmzl59x5ngv4zx0dtrwewcmjwfym",
 				},
+				MaxSupply:             sdk.NewInt(21_000_000),
+				BeforeSendHookAddress: "juno14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9skjuwg8",
+				Roles: []types.DenomRole{
+					types.NewDenomRole("juno15czt5nhlnvayqq37xun9s9yus0d6y26dsvkcna", types.DenomRoleBurner, nil),
+					types.NewDenomRole("juno14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9skjuwg8", types.DenomRoleMinter, &allowance),
+					types.NewDenomRole("juno15czt5nhlnvayqq37xun9s9yus0d6y26dsvkcna", types.DenomRoleMinter, nil),
+				},
+				Paused:          true,
+				FrozenAddresses: []string{"juno15czt5nhlnvayqq37xun9s9yus0d6y26dsvkcna"},
 			},
 		},
 	}
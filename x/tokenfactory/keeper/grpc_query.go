@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
@@ -33,3 +37,144 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	denoms := k.GetDenomsFromCreator(sdkCtx, req.GetCreator())
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) DenomMaxSupply(ctx context.Context, req *types.QueryDenomMaxSupplyRequest) (*types.QueryDenomMaxSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	maxSupply, _ := k.GetMaxSupply(sdkCtx, req.GetDenom())
+	supply := k.bankKeeper.GetSupply(sdkCtx, req.GetDenom())
+
+	return &types.QueryDenomMaxSupplyResponse{MaxSupply: maxSupply, Supply: supply}, nil
+}
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (k Keeper) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	if req.GetRole() != "" && !types.IsDenomRole(req.GetRole()) {
+		return nil, types.ErrInvalidRole.Wrapf("unknown role %s", req.GetRole())
+	}
+
+	roles := []types.DenomRole{}
+	store := k.GetDenomRolesStore(sdkCtx, req.GetDenom(), req.GetRole())
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var role types.DenomRole
+		if err := role.Unmarshal(value); err != nil {
+			return err
+		}
+		roles = append(roles, role)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomRolesResponse{Roles: roles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DenomPaused(ctx context.Context, req *types.QueryDenomPausedRequest) (*types.QueryDenomPausedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomPausedResponse{Paused: k.IsDenomPaused(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	store := k.GetFrozenAccountsStore(sdkCtx, req.GetDenom())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AllDenoms(ctx context.Context, req *types.QueryAllDenomsRequest) (*types.QueryAllDenomsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denoms := []string{}
+	store := k.GetCreatorsPrefixStore(sdkCtx)
+	pageRes, err := query.Paginate(store, req.GetPagination(), func(_, value []byte) error {
+		denoms = append(denoms, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DenomCapabilities(ctx context.Context, req *types.QueryDenomCapabilitiesRequest) (*types.QueryDenomCapabilitiesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, _, err := types.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	capabilities, err := k.GetDenomCapabilities(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomCapabilitiesResponse{
+		Capabilities:          capabilities,
+		RenouncedCapabilities: authorityMetadata.RenouncedCapabilities,
+	}, nil
+}
+
+func (k Keeper) DenomAdmins(ctx context.Context, req *types.QueryDenomAdminsRequest) (*types.QueryDenomAdminsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if len(req.GetDenoms()) > types.MaxDenomAdminsQueryDenoms {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot query more than %d denoms", types.MaxDenomAdminsQueryDenoms)
+	}
+
+	admins := make([]types.DenomAdmin, 0, len(req.GetDenoms()))
+	for _, denom := range req.GetDenoms() {
+		authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, denom)
+		if err != nil {
+			return nil, err
+		}
+
+		admins = append(admins, types.DenomAdmin{Denom: denom, Admin: authorityMetadata.GetAdmin()})
+	}
+
+	return &types.QueryDenomAdminsResponse{Admins: admins}, nil
+}
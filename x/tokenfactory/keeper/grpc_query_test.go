@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestAllDenomsQuery() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.AllDenoms(suite.Ctx.Context(), &types.QueryAllDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denoms)
+
+	var denoms []string
+	for _, creator := range suite.TestAccs[:2] {
+		for i := 0; i < 2; i++ {
+			res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(creator.String(), fmt.Sprintf("denom%d", i)))
+			suite.Require().NoError(err)
+			denoms = append(denoms, res.GetNewTokenDenom())
+		}
+	}
+
+	res, err = suite.queryClient.AllDenoms(suite.Ctx.Context(), &types.QueryAllDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(denoms, res.Denoms)
+
+	// walk the denoms one page at a time
+	var paged []string
+	pagination := &query.PageRequest{Limit: 3}
+	for {
+		res, err := suite.queryClient.AllDenoms(suite.Ctx.Context(), &types.QueryAllDenomsRequest{Pagination: pagination})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Denoms), 3)
+		paged = append(paged, res.Denoms...)
+
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}
+	}
+	suite.Require().ElementsMatch(denoms, paged)
+}
+
+func (suite *KeeperTestSuite) TestDenomCapabilitiesQuery() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	res, err := suite.queryClient.DenomCapabilities(suite.Ctx.Context(), &types.QueryDenomCapabilitiesRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomCapabilities, res.Capabilities)
+	suite.Require().Empty(res.RenouncedCapabilities)
+
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, suite.defaultDenom, types.DenomCapabilityForceTransfer))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.DenomCapabilities(suite.Ctx.Context(), &types.QueryDenomCapabilitiesRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().NotContains(res.Capabilities, types.DenomCapabilityForceTransfer)
+	suite.Require().Len(res.Capabilities, len(types.DenomCapabilities)-1)
+	suite.Require().Equal([]string{types.DenomCapabilityForceTransfer}, res.RenouncedCapabilities)
+
+	// only tokenfactory denoms have capabilities
+	_, err = suite.queryClient.DenomCapabilities(suite.Ctx.Context(), &types.QueryDenomCapabilitiesRequest{Denom: "ujuno"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDenomAdminsQuery() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	res, err := suite.msgServer.CreateDenom(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateDenom(suite.TestAccs[1].String(), "other"))
+	suite.Require().NoError(err)
+	otherDenom := res.GetNewTokenDenom()
+
+	// admins are looked up from the current authority metadata
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(admin, suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.DenomAdmins(suite.Ctx.Context(), &types.QueryDenomAdminsRequest{
+		Denoms: []string{suite.defaultDenom, otherDenom, "ujuno"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomAdmin{
+		{Denom: suite.defaultDenom, Admin: suite.TestAccs[2].String()},
+		{Denom: otherDenom, Admin: suite.TestAccs[1].String()},
+		{Denom: "ujuno", Admin: ""},
+	}, queryRes.Admins)
+
+	denoms := make([]string, types.MaxDenomAdminsQueryDenoms+1)
+	for i := range denoms {
+		denoms[i] = suite.defaultDenom
+	}
+	_, err = suite.queryClient.DenomAdmins(suite.Ctx.Context(), &types.QueryDenomAdminsRequest{Denoms: denoms})
+	suite.Require().Error(err)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	"github.com/cometbft/cometbft/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +26,7 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		contractKeeper      wasmtypes.ContractOpsKeeper
 
 		enabledCapabilities []string
 
@@ -73,6 +76,13 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetContractKeeper sets the x/wasm contract keeper used to call before send
+// hooks. The wasm keeper depends on the tokenfactory bindings, so it can only
+// be set after the tokenfactory keeper is created.
+func (k *Keeper) SetContractKeeper(contractKeeper wasmtypes.ContractOpsKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
 // Logger returns a logger for the x/tokenfactory module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
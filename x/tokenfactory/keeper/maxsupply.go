@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+// GetMaxSupply returns the max supply of a specific denom, and whether the
+// supply of the denom is capped
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (sdkmath.Int, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMaxSupplyKey))
+	if bz == nil {
+		return sdkmath.ZeroInt(), false
+	}
+
+	var maxSupply sdkmath.Int
+	if err := maxSupply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return maxSupply, true
+}
+
+// setMaxSupply caps the supply of a specific denom. Once set, the max supply
+// can only be decreased, and never below the current supply of the denom.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdkmath.Int) error {
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply must be positive: %s", maxSupply)
+	}
+
+	if current, found := k.GetMaxSupply(ctx, denom); found && maxSupply.GT(current) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply can only be decreased: %s > %s", maxSupply, current)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); maxSupply.LT(supply.Amount) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply %s is lower than the current supply %s", maxSupply, supply.Amount)
+	}
+
+	bz, err := maxSupply.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMaxSupplyKey), bz)
+	return nil
+}
+
+// assertMaxSupply returns an error if minting amount would exceed the max
+// supply of its denom
+func (k Keeper) assertMaxSupply(ctx sdk.Context, amount sdk.Coin) error {
+	maxSupply, found := k.GetMaxSupply(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if supply.Amount.Add(amount.Amount).GT(maxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf("supply %s plus %s exceeds the max supply %s", supply.Amount, amount.Amount, maxSupply)
+	}
+
+	return nil
+}
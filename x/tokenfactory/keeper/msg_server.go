@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 
@@ -62,10 +63,21 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	denom := msg.Amount.GetDenom()
+	if !server.Keeper.isAdminOrHasRole(ctx, denom, authorityMetadata, msg.Sender, types.DenomRoleMinter) {
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityMint) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityMint)
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		if err := server.Keeper.spendMintAllowance(ctx, denom, msg.Sender, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
@@ -94,14 +106,21 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !server.Keeper.isAdminOrHasRole(ctx, msg.Amount.GetDenom(), authorityMetadata, msg.Sender, types.DenomRoleBurner) {
 		return nil, types.ErrUnauthorized
 	}
 
+	// burning from other addresses is only allowed for the admin
+	if msg.BurnFromAddress != "" && msg.BurnFromAddress != msg.Sender && msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized.Wrap("burners can only burn their own tokens")
+	}
+
 	if msg.BurnFromAddress == "" {
 		msg.BurnFromAddress = msg.Sender
 	} else if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableBurnFrom) {
 		return nil, types.ErrCapabilityNotEnabled
+	} else if msg.BurnFromAddress != msg.Sender && authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityBurnFrom) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityBurnFrom)
 	}
 
 	err = server.Keeper.burnFrom(ctx, msg.Amount, msg.BurnFromAddress)
@@ -136,6 +155,10 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityForceTransfer) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityForceTransfer)
+	}
+
 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
 	if err != nil {
 		return nil, err
@@ -180,6 +203,229 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 	return &types.MsgChangeAdminResponse{}, nil
 }
 
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	// a before send hook can block sends like a freeze
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityFreeze) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityFreeze)
+	}
+
+	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.CosmwasmAddress),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) RenounceCapability(goCtx context.Context, msg *types.MsgRenounceCapability) (*types.MsgRenounceCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.renounceCapability(ctx, msg.Denom, msg.Capability)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceCapability,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeCapability, msg.Capability),
+		),
+	})
+
+	return &types.MsgRenounceCapabilityResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomRole(ctx, msg.Denom, types.NewDenomRole(msg.Address, msg.Role, msg.Allowance))
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := ""
+	if msg.Allowance != nil {
+		allowance = msg.Allowance.String()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role),
+			sdk.NewAttribute(types.AttributeRoleAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeAllowance, allowance),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.removeDenomRole(ctx, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeRole, msg.Role),
+			sdk.NewAttribute(types.AttributeRoleAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableFreeze) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityFreeze) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityFreeze)
+	}
+
+	server.Keeper.setDenomPaused(ctx, msg.Denom, msg.Paused)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetPausedResponse{}, nil
+}
+
+func (server msgServer) SetFrozen(goCtx context.Context, msg *types.MsgSetFrozen) (*types.MsgSetFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableFreeze) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityFreeze) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityFreeze)
+	}
+
+	err = server.Keeper.setAccountFrozen(ctx, msg.Denom, msg.Address, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeFrozenAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetFrozenResponse{}, nil
+}
+
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -198,10 +444,14 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !server.Keeper.isAdminOrHasRole(ctx, msg.Metadata.Base, authorityMetadata, msg.Sender, types.DenomRoleMetadataUpdater) {
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.IsCapabilityRenounced(types.DenomCapabilityMetadata) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability %s", types.DenomCapabilityMetadata)
+	}
+
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -248,3 +248,34 @@ func (suite *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 		})
 	}
 }
+
+// TestSetMaxSupplyMsg tests TypeMsgSetMaxSupply message is emitted on a successful max supply change
+func (suite *KeeperTestSuite) TestSetMaxSupplyMsg() {
+	// Create a denom
+	suite.CreateDefaultDenom()
+
+	for _, tc := range []struct {
+		desc                  string
+		maxSupply             int64
+		expectedMessageEvents int
+	}{
+		{
+			desc:      "zero max supply",
+			maxSupply: 0,
+		},
+		{
+			desc:                  "success case",
+			maxSupply:             1_000,
+			expectedMessageEvents: 1,
+		},
+	} {
+		suite.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().Equal(0, len(ctx.EventManager().Events()))
+			// Test set max supply message
+			suite.msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdk.NewInt(tc.maxSupply))) //nolint:errcheck
+			// Ensure current number and type of event is emitted
+			suite.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, tc.expectedMessageEvents)
+		})
+	}
+}
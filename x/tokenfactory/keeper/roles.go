@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+// GetDenomRole returns the role delegated to an address over a specific denom,
+// and whether the address has it
+func (k Keeper) GetDenomRole(ctx sdk.Context, denom, role, address string) (types.DenomRole, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetDenomRoleKey(role, address))
+	if bz == nil {
+		return types.DenomRole{}, false
+	}
+
+	var denomRole types.DenomRole
+	if err := proto.Unmarshal(bz, &denomRole); err != nil {
+		panic(err)
+	}
+
+	return denomRole, true
+}
+
+// HasDenomRole returns whether an address was delegated a role over a
+// specific denom
+func (k Keeper) HasDenomRole(ctx sdk.Context, denom, role, address string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetDenomRoleKey(role, address))
+}
+
+// GetDenomRolesStore returns the store of the addresses delegated a specific
+// role over a denom. An empty role returns the store of all the roles.
+func (k Keeper) GetDenomRolesStore(ctx sdk.Context, denom, role string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetDenomRolesPrefix(role))
+}
+
+// GetDenomRoles returns all the roles delegated over a specific denom
+func (k Keeper) GetDenomRoles(ctx sdk.Context, denom string) []types.DenomRole {
+	iterator := k.GetDenomRolesStore(ctx, denom, "").Iterator(nil, nil)
+	defer iterator.Close()
+
+	var roles []types.DenomRole
+	for ; iterator.Valid(); iterator.Next() {
+		var denomRole types.DenomRole
+		if err := proto.Unmarshal(iterator.Value(), &denomRole); err != nil {
+			panic(err)
+		}
+		roles = append(roles, denomRole)
+	}
+
+	return roles
+}
+
+// setDenomRole delegates a role over a specific denom to an address, replacing
+// the allowance of a minter if it already has the role
+func (k Keeper) setDenomRole(ctx sdk.Context, denom string, denomRole types.DenomRole) error {
+	if err := denomRole.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := proto.Marshal(&denomRole)
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetDenomRoleKey(denomRole.Role, denomRole.Address), bz)
+	return nil
+}
+
+// removeDenomRole revokes a role over a specific denom from an address
+func (k Keeper) removeDenomRole(ctx sdk.Context, denom, role, address string) error {
+	if !k.HasDenomRole(ctx, denom, role, address) {
+		return types.ErrInvalidRole.Wrapf("%s does not have the %s role", address, role)
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetDenomRoleKey(role, address))
+	return nil
+}
+
+// isAdminOrHasRole returns whether sender is the admin of the denom or was
+// delegated the role by the admin
+func (k Keeper) isAdminOrHasRole(ctx sdk.Context, denom string, authorityMetadata types.DenomAuthorityMetadata, sender, role string) bool {
+	if sender == authorityMetadata.GetAdmin() {
+		return true
+	}
+
+	return k.HasDenomRole(ctx, denom, role, sender)
+}
+
+// spendMintAllowance deducts amount from the allowance of a minter, returning
+// an error if it would exceed it. Minters without allowance mint unlimited
+// amounts.
+func (k Keeper) spendMintAllowance(ctx sdk.Context, denom, minter string, amount sdkmath.Int) error {
+	denomRole, found := k.GetDenomRole(ctx, denom, types.DenomRoleMinter, minter)
+	if !found {
+		return types.ErrUnauthorized
+	}
+
+	if !denomRole.HasAllowance() {
+		return nil
+	}
+
+	if amount.GT(*denomRole.Allowance) {
+		return types.ErrMintAllowanceExceeded.Wrapf("minting %s exceeds the allowance %s of %s", amount, denomRole.Allowance, minter)
+	}
+
+	allowance := denomRole.Allowance.Sub(amount)
+	denomRole.Allowance = &allowance
+
+	return k.setDenomRole(ctx, denom, denomRole)
+}
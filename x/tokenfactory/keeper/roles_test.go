@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGrantRevokeRole() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+	other := suite.TestAccs[2].String()
+
+	// only the admin can grant roles
+	_, err := suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(minter, suite.defaultDenom, minter, types.DenomRoleMinter, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.DenomRoleMinter, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, other, types.DenomRoleMinter, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, other, types.DenomRoleBurner, nil))
+	suite.Require().NoError(err)
+
+	// roles are paginated and can be filtered
+	res, err := suite.queryClient.DenomRoles(suite.Ctx.Context(), &types.QueryDenomRolesRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Roles, 3)
+
+	res, err = suite.queryClient.DenomRoles(suite.Ctx.Context(), &types.QueryDenomRolesRequest{Denom: suite.defaultDenom, Role: types.DenomRoleMinter, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Roles, 1)
+	suite.Require().Equal(types.DenomRoleMinter, res.Roles[0].Role)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = suite.queryClient.DenomRoles(suite.Ctx.Context(), &types.QueryDenomRolesRequest{Denom: suite.defaultDenom, Role: "admin"})
+	suite.Require().Error(err)
+
+	// only the admin can revoke roles, and only roles that were granted
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(other, suite.defaultDenom, minter, types.DenomRoleMinter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(admin, suite.defaultDenom, minter, types.DenomRoleBurner))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	_, err = suite.msgServer.RevokeRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRevokeRole(admin, suite.defaultDenom, minter, types.DenomRoleMinter))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.App.AppKeepers.TokenFactoryKeeper.HasDenomRole(suite.Ctx, suite.defaultDenom, types.DenomRoleMinter, minter))
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMinterRole() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+	bankKeeper := suite.App.AppKeepers.BankKeeper
+
+	allowance := sdk.NewInt(100)
+	_, err := suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.DenomRoleMinter, &allowance))
+	suite.Require().NoError(err)
+
+	// minters can mint up to their allowance
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, 60), admin))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), bankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], suite.defaultDenom).Amount.Int64())
+
+	role, found := suite.App.AppKeepers.TokenFactoryKeeper.GetDenomRole(suite.Ctx, suite.defaultDenom, types.DenomRoleMinter, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(40), *role.Allowance)
+
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 41)))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	// the admin is not limited by allowances
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	// granting the role again replaces the allowance, nil being unlimited
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.DenomRoleMinter, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	// minters cannot use the other powers of the admin
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(minter, suite.defaultDenom, minter, types.DenomRoleBurner, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// roles are subject to the renounced capabilities of the denom
+	_, err = suite.msgServer.RenounceCapability(sdk.WrapSDKContext(suite.Ctx), types.NewMsgRenounceCapability(admin, suite.defaultDenom, types.DenomCapabilityMint))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+}
+
+func (suite *KeeperTestSuite) TestBurnerAndMetadataUpdaterRoles() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	delegate := suite.TestAccs[1].String()
+
+	_, err := suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), delegate))
+	suite.Require().NoError(err)
+
+	metadata := banktypes.Metadata{
+		Description: "yeehaw",
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    suite.defaultDenom,
+				Exponent: 0,
+			},
+		},
+		Base:    suite.defaultDenom,
+		Display: suite.defaultDenom,
+		Name:    suite.defaultDenom,
+		Symbol:  suite.defaultDenom,
+	}
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(delegate, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(delegate, metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, delegate, types.DenomRoleBurner, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(sdk.WrapSDKContext(suite.Ctx), types.NewMsgGrantRole(admin, suite.defaultDenom, delegate, types.DenomRoleMetadataUpdater, nil))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(delegate, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(90), suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	// burners can only burn their own tokens
+	_, err = suite.msgServer.Mint(sdk.WrapSDKContext(suite.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurnFrom(delegate, sdk.NewInt64Coin(suite.defaultDenom, 10), suite.TestAccs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().Equal(int64(100), suite.App.AppKeepers.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], suite.defaultDenom).Amount.Int64())
+
+	_, err = suite.msgServer.SetDenomMetadata(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetDenomMetadata(delegate, metadata))
+	suite.Require().NoError(err)
+	res, err := suite.bankQueryClient.DenomMetadata(suite.Ctx.Context(), &banktypes.QueryDenomMetadataRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal("yeehaw", res.Metadata.Description)
+
+	// roles are kept when the admin changes
+	_, err = suite.msgServer.ChangeAdmin(sdk.WrapSDKContext(suite.Ctx), types.NewMsgChangeAdmin(admin, suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(sdk.WrapSDKContext(suite.Ctx), types.NewMsgBurn(delegate, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +13,28 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	seenCapabilities := map[string]bool{}
+	for _, capability := range metadata.RenouncedCapabilities {
+		if !IsDenomCapability(capability) {
+			return errorsmod.Wrapf(ErrInvalidCapability, "unknown capability %s", capability)
+		}
+		if seenCapabilities[capability] {
+			return errorsmod.Wrapf(ErrInvalidCapability, "duplicate renounced capability %s", capability)
+		}
+		seenCapabilities[capability] = true
+	}
+
 	return nil
 }
+
+// IsCapabilityRenounced returns whether the admin renounced a capability over the denom
+func (metadata DenomAuthorityMetadata) IsCapabilityRenounced(capability string) bool {
+	for _, v := range metadata.RenouncedCapabilities {
+		if v == capability {
+			return true
+		}
+	}
+
+	return false
+}
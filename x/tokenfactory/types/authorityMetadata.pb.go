@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// renounced_capabilities are the capabilities the admin irrevocably gave up
+	// over the denom: "mint", "burn_from", "force_transfer", "metadata" or
+	// "freeze".
+	RenouncedCapabilities []string `protobuf:"bytes,2,rep,name=renounced_capabilities,json=renouncedCapabilities,proto3" json:"renounced_capabilities,omitempty" yaml:"renounced_capabilities"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +78,73 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetRenouncedCapabilities() []string {
+	if m != nil {
+		return m.RenouncedCapabilities
+	}
+	return nil
+}
+
+// DenomRole is a power over a token factory denom delegated by its admin to
+// another address: "minter", "burner" or "metadata_updater".
+type DenomRole struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty" yaml:"role"`
+	// allowance is the amount a minter can still mint. Unset means the minter
+	// can mint an unlimited amount. Only minters can have an allowance.
+	Allowance *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance,omitempty" yaml:"allowance"`
+}
+
+func (m *DenomRole) Reset()         { *m = DenomRole{} }
+func (m *DenomRole) String() string { return proto.CompactTextString(m) }
+func (*DenomRole) ProtoMessage()    {}
+func (*DenomRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+func (m *DenomRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRole.Merge(m, src)
+}
+func (m *DenomRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRole proto.InternalMessageInfo
+
+func (m *DenomRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DenomRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomRole)(nil), "osmosis.tokenfactory.v1beta1.DenomRole")
 }
 
 func init() {
@@ -81,23 +152,34 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x93, 0xdf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x3b, 0x83, 0x1d, 0xe3, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c,
-	0x52, 0xac, 0x9f, 0x55, 0x9a, 0x97, 0xaf, 0x5f, 0x81, 0x1a, 0x0a, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0xe7, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0x00, 0x78, 0xbe, 0x2a,
-	0x01, 0x00, 0x00,
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x6e, 0xd4, 0x40,
+	0x10, 0x86, 0x6f, 0x93, 0x00, 0xba, 0x05, 0x41, 0x64, 0x91, 0xe8, 0x88, 0xc0, 0x0e, 0x8b, 0x84,
+	0x52, 0x80, 0x57, 0x11, 0xa9, 0xd2, 0x20, 0xee, 0x68, 0x52, 0x40, 0xe1, 0x0a, 0x21, 0xa1, 0x68,
+	0xbc, 0x5e, 0xee, 0x96, 0xd8, 0x3b, 0x27, 0xef, 0x1c, 0x70, 0x6f, 0xc1, 0x23, 0xe4, 0x21, 0x78,
+	0x03, 0x9a, 0x94, 0x11, 0x15, 0xa2, 0xb0, 0xd0, 0x5d, 0x43, 0xed, 0x27, 0x40, 0xec, 0xfa, 0x42,
+	0x40, 0x74, 0x9e, 0xf9, 0xff, 0x6f, 0xe6, 0xf7, 0xd8, 0xfc, 0x00, 0x5d, 0x85, 0xce, 0x38, 0x49,
+	0x78, 0xa2, 0xed, 0x5b, 0x50, 0x84, 0xf5, 0x5c, 0xbe, 0xdf, 0xcf, 0x35, 0xc1, 0xbe, 0x84, 0x19,
+	0x4d, 0xb0, 0x36, 0x34, 0x7f, 0xa1, 0x09, 0x0a, 0x20, 0x48, 0xa7, 0x35, 0x12, 0x46, 0x77, 0x3b,
+	0x2a, 0xbd, 0x4c, 0xa5, 0x1d, 0xb5, 0x73, 0x7b, 0x8c, 0x63, 0xf4, 0x46, 0xf9, 0xfb, 0x29, 0x30,
+	0x3b, 0x77, 0x94, 0x87, 0x8e, 0x83, 0x10, 0x8a, 0x4e, 0x8a, 0x43, 0x25, 0x73, 0x70, 0xfa, 0x62,
+	0xb7, 0x42, 0x63, 0x83, 0x2e, 0x4e, 0x19, 0xdf, 0x7e, 0xae, 0x2d, 0x56, 0xcf, 0xfe, 0xcd, 0x13,
+	0x3d, 0xe4, 0x57, 0xa0, 0xa8, 0x8c, 0x1d, 0xb0, 0x5d, 0xb6, 0xd7, 0x1f, 0x6e, 0xb6, 0x4d, 0x72,
+	0x63, 0x0e, 0x55, 0x79, 0x28, 0x7c, 0x5b, 0x64, 0x41, 0x8e, 0x5e, 0xf1, 0xed, 0x5a, 0x5b, 0x9c,
+	0x59, 0xa5, 0x8b, 0x63, 0x05, 0x53, 0xc8, 0x4d, 0x69, 0xc8, 0x68, 0x37, 0x58, 0xdb, 0x5d, 0xdf,
+	0xeb, 0x0f, 0xef, 0xb7, 0x4d, 0x72, 0x2f, 0x80, 0xff, 0xf7, 0x89, 0x6c, 0xeb, 0x42, 0x18, 0x5d,
+	0xea, 0x1f, 0x6e, 0xfc, 0x3c, 0x4d, 0x98, 0xf8, 0xc2, 0x78, 0xdf, 0x47, 0xcc, 0xb0, 0xd4, 0xd1,
+	0x23, 0x7e, 0x0d, 0x8a, 0xa2, 0xd6, 0xce, 0x75, 0xb9, 0xa2, 0xb6, 0x49, 0x6e, 0xae, 0x72, 0x79,
+	0x41, 0x64, 0x2b, 0x4b, 0xf4, 0x80, 0x6f, 0xd4, 0x58, 0xea, 0xc1, 0x9a, 0xb7, 0xde, 0x6a, 0x9b,
+	0xe4, 0x7a, 0x97, 0x04, 0x4b, 0x2d, 0x32, 0x2f, 0x46, 0x6f, 0x78, 0x1f, 0xca, 0x12, 0x3f, 0x80,
+	0x55, 0x7a, 0xb0, 0xee, 0x9d, 0x4f, 0xcf, 0x9a, 0x84, 0x7d, 0x6f, 0x92, 0xad, 0x70, 0x3e, 0x57,
+	0x9c, 0xa4, 0x06, 0x65, 0x05, 0x34, 0x49, 0x8f, 0x2c, 0xb5, 0x4d, 0xb2, 0xd9, 0x6d, 0x5c, 0x71,
+	0xe2, 0xeb, 0xe7, 0xc7, 0xbc, 0xbb, 0xfc, 0x91, 0xa5, 0xec, 0xcf, 0xc4, 0xf0, 0x16, 0xc3, 0x97,
+	0x67, 0x8b, 0x98, 0x9d, 0x2f, 0x62, 0xf6, 0x63, 0x11, 0xb3, 0x4f, 0xcb, 0xb8, 0x77, 0xbe, 0x8c,
+	0x7b, 0xdf, 0x96, 0x71, 0xef, 0xf5, 0xc1, 0xd8, 0xd0, 0x64, 0x96, 0xa7, 0x0a, 0x2b, 0x39, 0xf2,
+	0x13, 0x46, 0x68, 0xa9, 0x06, 0x45, 0x4e, 0xbe, 0x9b, 0x59, 0x94, 0x1f, 0xff, 0xfe, 0x83, 0x68,
+	0x3e, 0xd5, 0x2e, 0xbf, 0xea, 0xbf, 0xdf, 0x93, 0x5f, 0x03, 0x00, 0x05, 0xf5, 0x5e, 0xca, 0x66,
+	0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -122,6 +204,48 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.RenouncedCapabilities) != len(that1.RenouncedCapabilities) {
+		return false
+	}
+	for i := range this.RenouncedCapabilities {
+		if this.RenouncedCapabilities[i] != that1.RenouncedCapabilities[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomRole) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRole)
+	if !ok {
+		that2, ok := that.(DenomRole)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if that1.Allowance == nil {
+		if this.Allowance != nil {
+			return false
+		}
+	} else if !this.Allowance.Equal(*that1.Allowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -144,6 +268,15 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RenouncedCapabilities) > 0 {
+		for iNdEx := len(m.RenouncedCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RenouncedCapabilities[iNdEx])
+			copy(dAtA[i:], m.RenouncedCapabilities[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.RenouncedCapabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -154,6 +287,55 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		{
+			size := m.Allowance.Size()
+			i -= size
+			if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -175,6 +357,33 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.RenouncedCapabilities) > 0 {
+		for _, s := range m.RenouncedCapabilities {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -245,6 +454,188 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenouncedCapabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenouncedCapabilities = append(m.RenouncedCapabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Allowance = &v
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
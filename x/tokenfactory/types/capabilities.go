@@ -4,8 +4,58 @@ const (
 	EnableSetMetadata   = "enable_metadata"
 	EnableForceTransfer = "enable_force_transfer"
 	EnableBurnFrom      = "enable_burn_from"
+	EnableFreeze        = "enable_freeze"
 )
 
+// Capabilities of the admin over a single denom, which it can irrevocably
+// renounce with MsgRenounceCapability
+const (
+	DenomCapabilityMint          = "mint"
+	DenomCapabilityBurnFrom      = "burn_from"
+	DenomCapabilityForceTransfer = "force_transfer"
+	DenomCapabilityMetadata      = "metadata"
+	DenomCapabilityFreeze        = "freeze"
+)
+
+// DenomCapabilities is the list of capabilities an admin can renounce
+var DenomCapabilities = []string{
+	DenomCapabilityMint,
+	DenomCapabilityBurnFrom,
+	DenomCapabilityForceTransfer,
+	DenomCapabilityMetadata,
+	DenomCapabilityFreeze,
+}
+
+// denomCapabilityChainFlags are the chain-wide capabilities which must be
+// enabled for the admin to use a capability over a denom
+var denomCapabilityChainFlags = map[string]string{
+	DenomCapabilityBurnFrom:      EnableBurnFrom,
+	DenomCapabilityForceTransfer: EnableForceTransfer,
+	DenomCapabilityMetadata:      EnableSetMetadata,
+	DenomCapabilityFreeze:        EnableFreeze,
+}
+
+// IsDenomCapabilityEnabled returns whether the chain-wide capabilities allow
+// the admin to use a capability over a denom
+func IsDenomCapabilityEnabled(enabledCapabilities []string, capability string) bool {
+	flag, ok := denomCapabilityChainFlags[capability]
+	if !ok {
+		return true
+	}
+
+	return IsCapabilityEnabled(enabledCapabilities, flag)
+}
+
+func IsDenomCapability(capability string) bool {
+	for _, v := range DenomCapabilities {
+		if v == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
 func IsCapabilityEnabled(enabledCapabilities []string, capability string) bool {
 	if len(enabledCapabilities) == 0 {
 		return true
@@ -24,12 +24,19 @@ var (
 
 const (
 	// Amino names
-	createTFDenom        = "osmosis/tokenfactory/create-denom"
-	mintTFDenom          = "osmosis/tokenfactory/mint"
-	burnTFDenom          = "osmosis/tokenfactory/burn"
-	forceTransferTFDenom = "osmosis/tokenfactory/force-transfer"
-	changeAdminTFDenom   = "osmosis/tokenfactory/change-admin"
-	updateTFparams       = "osmosis/tokenfactory/msg-update-params"
+	createTFDenom             = "osmosis/tokenfactory/create-denom"
+	mintTFDenom               = "osmosis/tokenfactory/mint"
+	burnTFDenom               = "osmosis/tokenfactory/burn"
+	forceTransferTFDenom      = "osmosis/tokenfactory/force-transfer"
+	changeAdminTFDenom        = "osmosis/tokenfactory/change-admin"
+	setMaxSupplyTFDenom       = "osmosis/tokenfactory/set-max-supply"
+	setBeforeSendHookTFDenom  = "osmosis/tokenfactory/set-bef-send-hook"
+	renounceCapabilityTFDenom = "osmosis/tokenfactory/renounce-cap"
+	grantRoleTFDenom          = "osmosis/tokenfactory/grant-role"
+	revokeRoleTFDenom         = "osmosis/tokenfactory/revoke-role"
+	setPausedTFDenom          = "osmosis/tokenfactory/set-paused"
+	setFrozenTFDenom          = "osmosis/tokenfactory/set-frozen"
+	updateTFparams            = "osmosis/tokenfactory/msg-update-params"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -56,6 +63,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetMaxSupply{},
+		&MsgSetBeforeSendHook{},
+		&MsgRenounceCapability{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetPaused{},
+		&MsgSetFrozen{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,5 +81,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, burnTFDenom, nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, forceTransferTFDenom, nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, setMaxSupplyTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, setBeforeSendHookTFDenom, nil)
+	cdc.RegisterConcrete(&MsgRenounceCapability{}, renounceCapabilityTFDenom, nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, grantRoleTFDenom, nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, revokeRoleTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetPaused{}, setPausedTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetFrozen{}, setFrozenTFDenom, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateTFparams, nil)
 }
@@ -10,6 +10,9 @@ import (
 
 const (
 	ModuleDenomPrefix = "factory"
+	// MaxDenomAdminsQueryDenoms is the maximum number of denoms in a single
+	// DenomAdmins query
+	MaxDenomAdminsQueryDenoms = 100
 	// See the TokenFactory readme for a derivation of these.
 	// TL;DR, MaxSubdenomLength + MaxHrpLength = 60 comes from SDK max denom length = 128
 	// and the structure of tokenfactory denoms.
@@ -21,4 +21,14 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
 	ErrModuleAccount            = errorsmod.Register(ModuleName, 12, "interacting with module accounts not allowed")
+	ErrInvalidMaxSupply         = errorsmod.Register(ModuleName, 13, "invalid max supply")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 14, "minting would exceed the max supply")
+	ErrBeforeSendHookBlocked    = errorsmod.Register(ModuleName, 15, "send blocked by the before send hook of the denom")
+	ErrInvalidCapability        = errorsmod.Register(ModuleName, 16, "invalid denom capability")
+	ErrCapabilityRenounced      = errorsmod.Register(ModuleName, 17, "this capability has been renounced for the denom")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 18, "invalid denom role")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 19, "minting would exceed the allowance of the minter")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 20, "all the sends of the denom are paused")
+	ErrAccountFrozen            = errorsmod.Register(ModuleName, 21, "the account is frozen for the denom")
+	ErrMultipleInputs           = errorsmod.Register(ModuleName, 22, "tokenfactory denoms cannot be sent from multiple inputs")
 )
@@ -15,4 +15,13 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeMaxSupply           = "max_supply"
+	AttributeBeforeSendHook      = "before_send_hook_address"
+	AttributeCapability          = "capability"
+	AttributeRole                = "role"
+	AttributeRoleAddress         = "role_address"
+	AttributeAllowance           = "allowance"
+	AttributePaused              = "paused"
+	AttributeFrozenAddress       = "frozen_address"
+	AttributeFrozen              = "frozen"
 )
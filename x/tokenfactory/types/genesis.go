@@ -46,6 +46,52 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if !denom.MaxSupply.IsNil() && denom.MaxSupply.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidMaxSupply, "negative max supply for denom %s", denom.GetDenom())
+		}
+
+		if denom.BeforeSendHookAddress != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.BeforeSendHookAddress); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid before send hook address (%s)", err)
+			}
+		}
+
+		seenRoles := map[string]bool{}
+		for _, role := range denom.Roles {
+			if err := role.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid role for denom %s (%s)", denom.GetDenom(), err)
+			}
+
+			key := string(GetDenomRoleKey(role.Role, role.Address))
+			if seenRoles[key] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate role %s for address %s", role.Role, role.Address)
+			}
+			seenRoles[key] = true
+		}
+
+		seenFrozen := map[string]bool{}
+		for _, address := range denom.FrozenAddresses {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid frozen address (%s)", err)
+			}
+			if seenFrozen[address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen address %s", address)
+			}
+			seenFrozen[address] = true
+		}
+
+		if (denom.Paused || len(denom.FrozenAddresses) > 0) && denom.AuthorityMetadata.IsCapabilityRenounced(DenomCapabilityFreeze) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s is frozen but renounced the %s capability", denom.GetDenom(), DenomCapabilityFreeze)
+		}
+
+		if denom.BeforeSendHookAddress != "" && denom.AuthorityMetadata.IsCapabilityRenounced(DenomCapabilityFreeze) {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom %s has a before send hook but renounced the %s capability", denom.GetDenom(), DenomCapabilityFreeze)
+		}
 	}
 
 	return nil
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -79,10 +81,24 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the denom's max supply, its before send hook, the roles
+// delegated by the admin and whether the denom or some of its holders are
+// frozen.
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// max_supply is the maximum total supply of the denom. Zero means the supply
+	// is not capped.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// before_send_hook_address is the CosmWasm contract called before every send
+	// of the denom. Empty means the denom has no before send hook.
+	BeforeSendHookAddress string `protobuf:"bytes,4,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	// roles are the powers over the denom delegated by the admin.
+	Roles []DenomRole `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles" yaml:"roles"`
+	// paused is whether all the sends of the denom are paused.
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// frozen_addresses are the accounts that cannot send nor receive the denom.
+	FrozenAddresses []string `protobuf:"bytes,7,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +148,34 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetRoles() []DenomRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,31 +186,43 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xf2, 0x40,
-	0x14, 0x85, 0x3b, 0xfc, 0xfc, 0x24, 0x16, 0x34, 0xda, 0x68, 0x82, 0x44, 0x5b, 0x6c, 0x8c, 0x41,
-	0x16, 0x6d, 0x40, 0x56, 0xec, 0x2c, 0x24, 0xae, 0x34, 0xa6, 0xee, 0xdc, 0x90, 0x01, 0xc6, 0x52,
-	0xb5, 0x9d, 0xa6, 0x73, 0x31, 0xf6, 0x05, 0x5c, 0xfb, 0x08, 0x3e, 0x8c, 0x26, 0x2c, 0x59, 0xba,
-	0x22, 0x06, 0x36, 0xae, 0x79, 0x02, 0xc3, 0xcc, 0x44, 0x45, 0x92, 0xee, 0xda, 0x3b, 0xdf, 0x39,
-	0x73, 0xee, 0x1c, 0xb5, 0x4a, 0x59, 0x40, 0x99, 0xcf, 0x6c, 0xa0, 0x77, 0x24, 0xbc, 0xc1, 0x3d,
-	0xa0, 0x71, 0x62, 0x3f, 0xd4, 0xba, 0x04, 0x70, 0xcd, 0xf6, 0x48, 0x48, 0x98, 0xcf, 0xac, 0x28,
-	0xa6, 0x40, 0xb5, 0x3d, 0xc9, 0x5a, 0xbf, 0x59, 0x4b, 0xb2, 0xa5, 0x6d, 0x8f, 0x7a, 0x94, 0x83,
-	0xf6, 0xe2, 0x4b, 0x68, 0x4a, 0x8d, 0x54, 0x7f, 0x3c, 0x84, 0x01, 0x8d, 0x7d, 0x48, 0xce, 0x09,
-	0xe0, 0x3e, 0x06, 0x2c, 0x55, 0xc7, 0xa9, 0xaa, 0x08, 0xc7, 0x38, 0x90, 0xa1, 0xcc, 0x57, 0xa4,
-	0x16, 0xce, 0x44, 0xcc, 0x2b, 0xc0, 0x40, 0x34, 0x47, 0xcd, 0x09, 0xa0, 0x88, 0xca, 0xa8, 0x92,
-	0xaf, 0x1f, 0x5a, 0x69, 0xb1, 0xad, 0x4b, 0xce, 0x3a, 0xd9, 0xd1, 0xc4, 0x50, 0x5c, 0xa9, 0xd4,
-	0x22, 0x75, 0x43, 0x72, 0x9d, 0x3e, 0x09, 0x69, 0xc0, 0x8a, 0x99, 0xf2, 0xbf, 0x4a, 0xbe, 0x5e,
-	0x4d, 0xf7, 0x92, 0x39, 0xda, 0x0b, 0x89, 0xb3, 0xbf, 0x70, 0x9c, 0x4f, 0x8c, 0x9d, 0x04, 0x07,
-	0xf7, 0x4d, 0x73, 0xd9, 0xcf, 0x74, 0xd7, 0xe5, 0xa0, 0x2d, 0xfe, 0xdf, 0x7e, 0xd6, 0xe0, 0x13,
-	0xed, 0x48, 0xfd, 0xcf, 0x51, 0xbe, 0xc5, 0x9a, 0xb3, 0x39, 0x9f, 0x18, 0x05, 0xe1, 0xc4, 0xc7,
-	0xa6, 0x2b, 0x8e, 0xb5, 0x27, 0xa4, 0x6a, 0xdf, 0xcf, 0xd8, 0x09, 0xe4, 0x3b, 0x16, 0x33, 0x7c,
-	0xf7, 0x46, 0x7a, 0x5e, 0x7e, 0xd3, 0xe9, 0xdf, 0x0e, 0x9c, 0x03, 0x99, 0x7c, 0x57, 0xdc, 0xb7,
-	0xea, 0x6e, 0xba, 0x5b, 0x2b, 0xcd, 0x35, 0xb3, 0x9f, 0x2f, 0x06, 0x72, 0x2e, 0x46, 0x53, 0x1d,
-	0x8d, 0xa7, 0x3a, 0xfa, 0x98, 0xea, 0xe8, 0x79, 0xa6, 0x2b, 0xe3, 0x99, 0xae, 0xbc, 0xcf, 0x74,
-	0xe5, 0xba, 0xe1, 0xf9, 0x30, 0x18, 0x76, 0xad, 0x1e, 0x0d, 0xec, 0x16, 0x8f, 0xd5, 0xa2, 0x21,
-	0xc4, 0xb8, 0x07, 0xcc, 0xbe, 0x1d, 0x86, 0xd4, 0x7e, 0x5c, 0x6e, 0x1b, 0x92, 0x88, 0xb0, 0x6e,
-	0x8e, 0xb7, 0x7c, 0xf2, 0x15, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x2e, 0xfa, 0xa4, 0xa8, 0x02, 0x00,
-	0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x18, 0x8d, 0x9b, 0xcb, 0xff, 0x67, 0xda, 0x42, 0x33, 0x6a, 0x84, 0x5b, 0xc0, 0x0e, 0x06, 0x41,
+	0x5a, 0x09, 0x5b, 0x2d, 0x59, 0x75, 0x45, 0xdc, 0x0a, 0xe8, 0x02, 0x84, 0x9c, 0x1d, 0x42, 0xb2,
+	0x26, 0xf1, 0xe4, 0x42, 0x62, 0x7f, 0x96, 0x67, 0x82, 0x12, 0x1e, 0x80, 0x35, 0x8f, 0xc0, 0x43,
+	0xf0, 0x08, 0x2c, 0xba, 0x60, 0x51, 0xb1, 0x42, 0x2c, 0x2c, 0x94, 0x6c, 0x58, 0xfb, 0x09, 0x50,
+	0x66, 0xa6, 0x85, 0xb6, 0x22, 0x62, 0x67, 0x9f, 0xef, 0x9c, 0xf3, 0x5d, 0x07, 0xed, 0x02, 0x0b,
+	0x81, 0x0d, 0x98, 0xc3, 0x61, 0x48, 0xa3, 0x2e, 0xe9, 0x70, 0x48, 0xa6, 0xce, 0xdb, 0xbd, 0x36,
+	0xe5, 0x64, 0xcf, 0xe9, 0xd1, 0x88, 0xb2, 0x01, 0xb3, 0xe3, 0x04, 0x38, 0xe0, 0x5b, 0x8a, 0x6b,
+	0xff, 0xc9, 0xb5, 0x15, 0x77, 0x7b, 0xb3, 0x07, 0x3d, 0x10, 0x44, 0x67, 0xf1, 0x25, 0x35, 0xdb,
+	0x5b, 0x1d, 0x21, 0xf2, 0x65, 0x40, 0xfe, 0xa8, 0x50, 0x63, 0x69, 0x6a, 0x32, 0xe6, 0x7d, 0x48,
+	0x06, 0x7c, 0xfa, 0x9c, 0x72, 0x12, 0x10, 0x4e, 0x94, 0x6a, 0x67, 0xa9, 0x2a, 0x26, 0x09, 0x09,
+	0x55, 0x02, 0xeb, 0xb3, 0x86, 0xd6, 0x9e, 0xca, 0x0e, 0x5a, 0x9c, 0x70, 0x8a, 0x5d, 0x54, 0x92,
+	0x04, 0x5d, 0xab, 0x69, 0xf5, 0xd5, 0xfd, 0x7b, 0xf6, 0xb2, 0x8e, 0xec, 0x97, 0x82, 0xeb, 0x16,
+	0x4e, 0x52, 0x33, 0xe7, 0x29, 0x25, 0x8e, 0xd1, 0x35, 0xc5, 0xf3, 0x03, 0x1a, 0x41, 0xc8, 0xf4,
+	0x95, 0x5a, 0xbe, 0xbe, 0xba, 0xbf, 0xbb, 0xdc, 0x4b, 0xd5, 0x71, 0xb4, 0x90, 0xb8, 0xb7, 0x17,
+	0x8e, 0x59, 0x6a, 0x56, 0xa7, 0x24, 0x1c, 0x1d, 0x58, 0x17, 0xfd, 0x2c, 0x6f, 0x5d, 0x01, 0x47,
+	0xf2, 0xff, 0x4b, 0xe1, 0xbc, 0x0d, 0x81, 0xe0, 0xfb, 0xa8, 0x28, 0xa8, 0xa2, 0x8b, 0xb2, 0xbb,
+	0x91, 0xa5, 0xe6, 0x9a, 0x74, 0x12, 0xb0, 0xe5, 0xc9, 0x30, 0x7e, 0xaf, 0x21, 0x7c, 0x3e, 0x46,
+	0x3f, 0x54, 0x73, 0xd4, 0x57, 0x44, 0xef, 0x8d, 0xe5, 0xf5, 0x8a, 0x4c, 0xcd, 0xcb, 0x3b, 0x70,
+	0xef, 0xa8, 0xca, 0xb7, 0x64, 0xbe, 0xab, 0xee, 0x96, 0x57, 0xb9, 0xb2, 0x39, 0xec, 0x23, 0x14,
+	0x92, 0x89, 0xcf, 0xc6, 0x71, 0x3c, 0x9a, 0xea, 0x79, 0x51, 0xf5, 0xe3, 0x85, 0xd3, 0xf7, 0xd4,
+	0xac, 0xca, 0x9b, 0x60, 0xc1, 0xd0, 0x1e, 0x80, 0x13, 0x12, 0xde, 0xb7, 0x8f, 0x23, 0x9e, 0xa5,
+	0x66, 0x45, 0xa6, 0xf8, 0x2d, 0xb4, 0xbe, 0x7e, 0x7a, 0x88, 0xd4, 0x05, 0x1d, 0x47, 0xdc, 0x2b,
+	0x87, 0x64, 0xd2, 0x12, 0x11, 0xfc, 0x1a, 0xe9, 0x6d, 0xda, 0x85, 0x84, 0xfa, 0x8c, 0x46, 0x81,
+	0xdf, 0x07, 0x18, 0xfa, 0x24, 0x08, 0x12, 0xca, 0x98, 0x5e, 0x10, 0xe9, 0xee, 0x66, 0xa9, 0x69,
+	0x4a, 0xc7, 0xbf, 0x31, 0x2d, 0xaf, 0x2a, 0x43, 0x2d, 0x1a, 0x05, 0xcf, 0x00, 0x86, 0x4d, 0x89,
+	0xe3, 0x16, 0x2a, 0x26, 0x30, 0xa2, 0x4c, 0x2f, 0x8a, 0x4d, 0x3f, 0xf8, 0x87, 0xc9, 0x79, 0x30,
+	0xa2, 0xee, 0xa6, 0x1a, 0x96, 0x5a, 0x8e, 0xf0, 0xb0, 0x3c, 0xe9, 0x85, 0x77, 0x16, 0xb7, 0x38,
+	0x66, 0x34, 0xd0, 0x4b, 0x35, 0xad, 0xfe, 0xbf, 0x5b, 0xc9, 0x52, 0x73, 0x5d, 0x12, 0x25, 0x6e,
+	0x79, 0x8a, 0x80, 0x9f, 0xa0, 0x8d, 0x6e, 0x02, 0xef, 0x68, 0x74, 0x56, 0x29, 0x65, 0xfa, 0x7f,
+	0xb5, 0x7c, 0xbd, 0xec, 0xde, 0xcc, 0x52, 0xf3, 0x86, 0x3a, 0xa2, 0x4b, 0x0c, 0xcb, 0xbb, 0x2e,
+	0xa1, 0xe6, 0x19, 0x72, 0x50, 0xf8, 0xf9, 0xd1, 0xd4, 0xdc, 0x17, 0x27, 0x33, 0x43, 0x3b, 0x9d,
+	0x19, 0xda, 0x8f, 0x99, 0xa1, 0x7d, 0x98, 0x1b, 0xb9, 0xd3, 0xb9, 0x91, 0xfb, 0x36, 0x37, 0x72,
+	0xaf, 0x1a, 0xbd, 0x01, 0xef, 0x8f, 0xdb, 0x76, 0x07, 0x42, 0xe7, 0x50, 0xf4, 0x78, 0x08, 0x11,
+	0x4f, 0x48, 0x87, 0x33, 0xe7, 0xcd, 0x38, 0x02, 0x67, 0x72, 0xf1, 0xd1, 0xf1, 0x69, 0x4c, 0x59,
+	0xbb, 0x24, 0x1e, 0xdb, 0xa3, 0x5f, 0x03, 0x00, 0xde, 0xd3, 0xbf, 0x77, 0x4a, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -194,6 +250,31 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +344,56 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -323,6 +454,27 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -543,6 +695,158 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, DenomRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
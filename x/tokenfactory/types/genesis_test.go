@@ -5,10 +5,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/CosmosContracts/juno/v23/x/tokenfactory/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	allowance := sdkmath.NewInt(100)
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -86,6 +89,152 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "renounced capabilities",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:                 "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							RenouncedCapabilities: []string{types.DenomCapabilityMint, types.DenomCapabilityForceTransfer},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "unknown renounced capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:                 "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							RenouncedCapabilities: []string{"change_admin"},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate renounced capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:                 "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							RenouncedCapabilities: []string{types.DenomCapabilityMint, types.DenomCapabilityMint},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Roles: []types.DenomRole{
+							types.NewDenomRole("cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", types.DenomRoleMinter, &allowance),
+							types.NewDenomRole("cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", types.DenomRoleBurner, nil),
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "allowance for a role other than minter",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Roles: []types.DenomRole{
+							types.NewDenomRole("cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", types.DenomRoleBurner, &allowance),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Roles: []types.DenomRole{
+							types.NewDenomRole("cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", types.DenomRoleMinter, nil),
+							types.NewDenomRole("cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8", types.DenomRoleMinter, &allowance),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozen denom",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+						},
+						Paused:          true,
+						FrozenAddresses: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "frozen denom with renounced freeze capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:                 "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							RenouncedCapabilities: []string{types.DenomCapabilityFreeze},
+						},
+						FrozenAddresses: []string{"cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook with renounced freeze capability",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:                 "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							RenouncedCapabilities: []string{types.DenomCapabilityFreeze},
+						},
+						BeforeSendHookAddress: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
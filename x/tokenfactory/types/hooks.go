@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeforeSendHookGasLimit is the maximum amount of gas a before send hook
+// contract can consume on a single send
+const BeforeSendHookGasLimit = 500_000
+
+// BankHooks defines the hooks the bank keeper calls before moving coins
+// between accounts
+type BankHooks interface {
+	BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error
+}
@@ -28,9 +28,14 @@ const KeySeparator = "|"
 
 var (
 	DenomAuthorityMetadataKey = "authoritymetadata"
+	DenomMaxSupplyKey         = "maxsupply"
+	BeforeSendHookAddressKey  = "beforesendhook"
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
+	DenomRolesPrefixKey       = "roles"
+	DenomPausedKey            = "paused"
+	FrozenAccountsPrefixKey   = "frozen"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -49,3 +54,25 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetDenomRolesPrefix returns the prefix, within the store of a denom, where
+// the addresses delegated a specific role are stored. An empty role returns
+// the prefix of all the roles.
+func GetDenomRolesPrefix(role string) []byte {
+	if role == "" {
+		return []byte(strings.Join([]string{DenomRolesPrefixKey, ""}, KeySeparator))
+	}
+	return []byte(strings.Join([]string{DenomRolesPrefixKey, role, ""}, KeySeparator))
+}
+
+// GetDenomRoleKey returns the key, within the store of a denom, of the role
+// delegated to an address
+func GetDenomRoleKey(role, address string) []byte {
+	return append(GetDenomRolesPrefix(role), []byte(address)...)
+}
+
+// GetFrozenAccountsPrefix returns the prefix, within the store of a denom,
+// where the frozen accounts are stored
+func GetFrozenAccountsPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAccountsPrefixKey, ""}, KeySeparator))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,12 +10,19 @@ import (
 )
 
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom        = "create_denom"
+	TypeMsgMint               = "tf_mint"
+	TypeMsgBurn               = "tf_burn"
+	TypeMsgForceTransfer      = "force_transfer"
+	TypeMsgChangeAdmin        = "change_admin"
+	TypeMsgSetDenomMetadata   = "set_denom_metadata"
+	TypeMsgSetMaxSupply       = "set_max_supply"
+	TypeMsgSetBeforeSendHook  = "set_before_send_hook"
+	TypeMsgRenounceCapability = "renounce_capability"
+	TypeMsgGrantRole          = "grant_role"
+	TypeMsgRevokeRole         = "revoke_role"
+	TypeMsgSetPaused          = "set_paused"
+	TypeMsgSetFrozen          = "set_frozen"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -277,6 +285,297 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to cap the supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply sdkmath.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply must be positive: %s", m.MaxSupply)
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set or remove the before send hook of a denom
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceCapability{}
+
+// NewMsgRenounceCapability creates a message to irrevocably renounce a capability over a denom
+func NewMsgRenounceCapability(sender, denom, capability string) *MsgRenounceCapability {
+	return &MsgRenounceCapability{
+		Sender:     sender,
+		Denom:      denom,
+		Capability: capability,
+	}
+}
+
+func (m MsgRenounceCapability) Route() string { return RouterKey }
+func (m MsgRenounceCapability) Type() string  { return TypeMsgRenounceCapability }
+func (m MsgRenounceCapability) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !IsDenomCapability(m.Capability) {
+		return errorsmod.Wrapf(ErrInvalidCapability, "unknown capability %s", m.Capability)
+	}
+
+	return nil
+}
+
+func (m MsgRenounceCapability) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRenounceCapability) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to delegate a role over a denom. A nil
+// allowance lets a minter mint an unlimited amount.
+func NewMsgGrantRole(sender, denom, address, role string, allowance *sdkmath.Int) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:    sender,
+		Denom:     denom,
+		Address:   address,
+		Role:      role,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRoleAllowance(m.Role, m.Allowance)
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a role over a denom
+func NewMsgRevokeRole(sender, denom, address, role string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !IsDenomRole(m.Role) {
+		return errorsmod.Wrapf(ErrInvalidRole, "unknown role %s", m.Role)
+	}
+
+	return nil
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPaused{}
+
+// NewMsgSetPaused creates a message to pause or resume all the sends of a denom
+func NewMsgSetPaused(sender, denom string, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetPaused) Route() string { return RouterKey }
+func (m MsgSetPaused) Type() string  { return TypeMsgSetPaused }
+func (m MsgSetPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetFrozen{}
+
+// NewMsgSetFrozen creates a message to freeze or unfreeze an account for a denom
+func NewMsgSetFrozen(sender, denom, address string, frozen bool) *MsgSetFrozen {
+	return &MsgSetFrozen{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetFrozen) Route() string { return RouterKey }
+func (m MsgSetFrozen) Type() string  { return TypeMsgSetFrozen }
+func (m MsgSetFrozen) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid frozen address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
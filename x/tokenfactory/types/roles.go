@@ -0,0 +1,76 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Roles the admin can delegate over a single denom with MsgGrantRole
+const (
+	DenomRoleMinter          = "minter"
+	DenomRoleBurner          = "burner"
+	DenomRoleMetadataUpdater = "metadata_updater"
+)
+
+// DenomRoles is the list of roles an admin can delegate
+var DenomRoles = []string{
+	DenomRoleMinter,
+	DenomRoleBurner,
+	DenomRoleMetadataUpdater,
+}
+
+func IsDenomRole(role string) bool {
+	for _, v := range DenomRoles {
+		if v == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewDenomRole returns a role over a denom. A nil allowance means unlimited.
+func NewDenomRole(address, role string, allowance *sdkmath.Int) DenomRole {
+	return DenomRole{
+		Address:   address,
+		Role:      role,
+		Allowance: allowance,
+	}
+}
+
+// HasAllowance returns whether the role is a minter with a limited allowance
+func (r DenomRole) HasAllowance() bool {
+	return r.Allowance != nil
+}
+
+func (r DenomRole) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid address (%s)", err)
+	}
+
+	return ValidateRoleAllowance(r.Role, r.Allowance)
+}
+
+// ValidateRoleAllowance checks that the role exists and that only minters have
+// a non negative allowance
+func ValidateRoleAllowance(role string, allowance *sdkmath.Int) error {
+	if !IsDenomRole(role) {
+		return errorsmod.Wrapf(ErrInvalidRole, "unknown role %s", role)
+	}
+
+	if allowance == nil {
+		return nil
+	}
+
+	if role != DenomRoleMinter {
+		return errorsmod.Wrapf(ErrInvalidRole, "only the %s role can have an allowance", DenomRoleMinter)
+	}
+
+	if allowance.IsNil() || allowance.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid allowance %s", allowance)
+	}
+
+	return nil
+}
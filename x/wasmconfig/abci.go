@@ -0,0 +1,20 @@
+package wasmconfig
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/keeper"
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
+)
+
+// BeginBlocker applies the params to the gas register and the x/wasm size
+// limits, so params updated in a block take effect from the next block on every
+// validator.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.ApplyParams(ctx)
+}
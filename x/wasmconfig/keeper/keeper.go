@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
+)
+
+// Keeper of this module keeps the wasm configuration set by governance, the
+// gas register x/wasm prices contract execution with, and the size limits of
+// the x/wasm messages.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	gasRegister *types.GasRegister
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates new instances of the Keeper. The gas register starts with
+// the default params until the params of the store are applied.
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:    storeKey,
+		cdc:         cdc,
+		gasRegister: types.NewGasRegister(types.DefaultGasRegisterParams()),
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/wasmconfig module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetGasRegister returns the gas register to pass to the x/wasm keeper.
+func (k Keeper) GetGasRegister() *types.GasRegister {
+	return k.gasRegister
+}
+
+// ApplyParams sets the costs of the gas register and the x/wasm size limits
+// from the current params. The defaults are kept until the params are set in
+// genesis or in the upgrade adding the module.
+func (k Keeper) ApplyParams(ctx sdk.Context) {
+	if !ctx.KVStore(k.storeKey).Has(types.ParamsKey) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	k.gasRegister.SetParams(params.GasRegister)
+	params.SetWasmLimits()
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
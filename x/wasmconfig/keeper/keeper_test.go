@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v23/app"
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig"
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/keeper"
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	ctx                 sdk.Context
+	app                 *app.App
+	queryClient         types.QueryClient
+	wasmConfigMsgServer types.MsgServer
+}
+
+func (s *IntegrationTestSuite) SetupTest() {
+	isCheckTx := false
+	s.app = app.Setup(s.T())
+
+	s.ctx = s.app.BaseApp.NewContext(isCheckTx, tmproto.Header{
+		ChainID: "testing",
+		Height:  9,
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQuerier(s.app.AppKeepers.WasmConfigKeeper))
+
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.wasmConfigMsgServer = s.app.AppKeepers.WasmConfigKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerAppliesParams() {
+	k := s.app.AppKeepers.WasmConfigKeeper
+	register := k.GetGasRegister()
+
+	s.Require().Equal(types.DefaultInstanceCost, register.NewContractInstanceCosts(false, 0))
+
+	params := types.DefaultParams()
+	params.GasRegister.InstanceCost = 100_000
+	params.GasRegister.EventPerAttributeCost = 25
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	// the new costs only apply from the next block
+	s.Require().Equal(types.DefaultInstanceCost, register.NewContractInstanceCosts(false, 0))
+
+	wasmconfig.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), k)
+
+	s.Require().Equal(uint64(100_000), register.NewContractInstanceCosts(false, 0))
+	s.Require().Equal(uint64(0), register.NewContractInstanceCosts(true, 0))
+	s.Require().Equal(types.NewGasRegister(params.GasRegister).EventCosts(nil, nil), register.EventCosts(nil, nil))
+}
+
+func (s *IntegrationTestSuite) TestBeginBlockerAppliesWasmLimits() {
+	k := s.app.AppKeepers.WasmConfigKeeper
+	defer types.DefaultParams().SetWasmLimits()
+
+	sender := sdk.AccAddress([]byte("wasmconfig_sender___")).String()
+	storeCode := &wasmtypes.MsgStoreCode{
+		Sender:       sender,
+		WASMByteCode: append([]byte("\x00asm"), make([]byte, 1020)...),
+	}
+	instantiate := &wasmtypes.MsgInstantiateContract{
+		Sender: sender,
+		CodeID: 1,
+		Label:  strings.Repeat("a", 64),
+		Msg:    []byte(`{}`),
+	}
+
+	s.Require().NoError(storeCode.ValidateBasic())
+	s.Require().NoError(instantiate.ValidateBasic())
+
+	params := k.GetParams(s.ctx)
+	params.MaxWasmSize = 1000
+	params.MaxLabelSize = 32
+	s.Require().NoError(k.SetParams(s.ctx, params))
+
+	// the new limits only apply from the next block
+	s.Require().NoError(storeCode.ValidateBasic())
+
+	wasmconfig.BeginBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), k)
+
+	s.Require().ErrorContains(storeCode.ValidateBasic(), "cannot be longer than 1000 bytes")
+	s.Require().ErrorContains(instantiate.ValidateBasic(), "cannot be longer than 32 characters")
+	s.Require().Equal(int(types.DefaultMaxProposalWasmSize), wasmtypes.MaxProposalWasmSize)
+}
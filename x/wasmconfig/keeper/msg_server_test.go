@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CosmosContracts/juno/v23/x/wasmconfig/types"
+)
+
+func (s *IntegrationTestSuite) TestUpdateParamsMsg() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, _, other := testdata.KeyTestPubAddr()
+
+	params := types.DefaultParams()
+	params.GasRegister.CompileCost = 10
+
+	invalid := types.DefaultParams()
+	invalid.GasRegister.UncompressCostDenominator = 0
+
+	for _, tc := range []struct {
+		desc      string
+		authority string
+		params    types.Params
+		success   bool
+	}{
+		{
+			desc:      "Success - gov updates the params",
+			authority: govAddr,
+			params:    params,
+			success:   true,
+		},
+		{
+			desc:      "Fail - not the authority",
+			authority: other.String(),
+			params:    types.DefaultParams(),
+			success:   false,
+		},
+		{
+			desc:      "Fail - invalid params",
+			authority: govAddr,
+			params:    invalid,
+			success:   false,
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			_, err := s.wasmConfigMsgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    tc.params,
+			})
+
+			if !tc.success {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.params, res.Params)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidGasRegister = errorsmod.Register(ModuleName, 1, "invalid gas register")
+	ErrInvalidWasmLimit   = errorsmod.Register(ModuleName, 2, "invalid wasm limit")
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"sync/atomic"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ wasmtypes.GasRegister = &GasRegister{}
+
+// GasRegister is the x/wasm gas register of the app. Its costs are replaced
+// from the module params at the beginning of every block, so every validator
+// switches to new costs at the same height without a binary release.
+//
+// Queries and simulations may run concurrently with the block, so the
+// register is swapped atomically.
+type GasRegister struct {
+	register atomic.Pointer[wasmtypes.WasmGasRegister]
+}
+
+// NewGasRegister creates a new GasRegister with the costs of params.
+func NewGasRegister(params GasRegisterParams) *GasRegister {
+	g := &GasRegister{}
+	g.SetParams(params)
+	return g
+}
+
+// SetParams replaces the costs of the register.
+func (g *GasRegister) SetParams(params GasRegisterParams) {
+	register := wasmtypes.NewWasmGasRegister(params.WasmGasRegisterConfig())
+	g.register.Store(&register)
+}
+
+func (g *GasRegister) load() wasmtypes.WasmGasRegister {
+	return *g.register.Load()
+}
+
+// NewContractInstanceCosts costs to create a new contract instance from code
+func (g *GasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
+	return g.load().NewContractInstanceCosts(pinned, msgLen)
+}
+
+// CompileCosts costs to persist and "compile" a new wasm contract
+func (g *GasRegister) CompileCosts(byteLength int) sdk.Gas {
+	return g.load().CompileCosts(byteLength)
+}
+
+// UncompressCosts costs to unpack a new wasm contract
+func (g *GasRegister) UncompressCosts(byteLength int) sdk.Gas {
+	return g.load().UncompressCosts(byteLength)
+}
+
+// InstantiateContractCosts costs when interacting with a wasm contract
+func (g *GasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
+	return g.load().InstantiateContractCosts(pinned, msgLen)
+}
+
+// ReplyCosts costs to to handle a message reply
+func (g *GasRegister) ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+	return g.load().ReplyCosts(pinned, reply)
+}
+
+// EventCosts costs to persist an event
+func (g *GasRegister) EventCosts(attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas {
+	return g.load().EventCosts(attrs, events)
+}
+
+// ToWasmVMGas converts from Cosmos SDK gas units to CosmWasm gas
+func (g *GasRegister) ToWasmVMGas(source sdk.Gas) uint64 {
+	return g.load().ToWasmVMGas(source)
+}
+
+// FromWasmVMGas converts from CosmWasm gas to Cosmos SDK gas units
+func (g *GasRegister) FromWasmVMGas(source uint64) sdk.Gas {
+	return g.load().FromWasmVMGas(source)
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// module name, prefixed since the x/wasm store key would collide with it
+	ModuleName = "junowasmconfig"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	ParamsKey = []byte{0x00} // Prefix for params key
+)
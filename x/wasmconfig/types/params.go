@@ -0,0 +1,141 @@
+package types
+
+import (
+	"math"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// DefaultInstanceCost is initially set the same as in wasmd
+	DefaultInstanceCost uint64 = 60_000
+	// DefaultCompileCost is initially set the same as in wasmd
+	DefaultCompileCost uint64 = 3
+
+	// DefaultMaxWasmSize is the wasmd default of 800 KiB
+	DefaultMaxWasmSize uint64 = 800 * 1024
+	// DefaultMaxProposalWasmSize is the wasmd default of 3 MiB
+	DefaultMaxProposalWasmSize uint64 = 3 * 1024 * 1024
+	// DefaultMaxLabelSize is the wasmd default of 128 characters
+	DefaultMaxLabelSize uint64 = 128
+)
+
+// NewParams creates a new Params object
+func NewParams(gasRegister GasRegisterParams, maxWasmSize, maxProposalWasmSize, maxLabelSize uint64) Params {
+	return Params{
+		GasRegister:         gasRegister,
+		MaxWasmSize:         maxWasmSize,
+		MaxProposalWasmSize: maxProposalWasmSize,
+		MaxLabelSize:        maxLabelSize,
+	}
+}
+
+// DefaultParams returns default x/wasmconfig module parameters.
+func DefaultParams() Params {
+	return Params{
+		GasRegister:         DefaultGasRegisterParams(),
+		MaxWasmSize:         DefaultMaxWasmSize,
+		MaxProposalWasmSize: DefaultMaxProposalWasmSize,
+		MaxLabelSize:        DefaultMaxLabelSize,
+	}
+}
+
+// Validate performs basic validation of the params.
+func (p Params) Validate() error {
+	if err := validateSize("max wasm size", p.MaxWasmSize); err != nil {
+		return err
+	}
+
+	if err := validateSize("max proposal wasm size", p.MaxProposalWasmSize); err != nil {
+		return err
+	}
+
+	if err := validateSize("max label size", p.MaxLabelSize); err != nil {
+		return err
+	}
+
+	return p.GasRegister.Validate()
+}
+
+// SetWasmLimits sets the size limits x/wasm validates messages and genesis
+// with. They are globals of wasmd, so they must only be set from the state of
+// the chain to stay the same on every node.
+//
+// The globals are plain ints which wasmd reads without any lock, including
+// from the gRPC and simulation goroutines, so they cannot be guarded here.
+// They are only written when a limit changes, which only happens at genesis,
+// at startup and in the block after a governance update, so a concurrent
+// query sees either the previous or the new limit.
+func (p Params) SetWasmLimits() {
+	if wasmtypes.MaxWasmSize != int(p.MaxWasmSize) {
+		wasmtypes.MaxWasmSize = int(p.MaxWasmSize)
+	}
+
+	if wasmtypes.MaxProposalWasmSize != int(p.MaxProposalWasmSize) {
+		wasmtypes.MaxProposalWasmSize = int(p.MaxProposalWasmSize)
+	}
+
+	if wasmtypes.MaxLabelSize != int(p.MaxLabelSize) {
+		wasmtypes.MaxLabelSize = int(p.MaxLabelSize)
+	}
+}
+
+func validateSize(name string, size uint64) error {
+	if size == 0 {
+		return ErrInvalidWasmLimit.Wrapf("%s cannot be zero", name)
+	}
+
+	if size > math.MaxInt32 {
+		return ErrInvalidWasmLimit.Wrapf("%s cannot be greater than %d", name, math.MaxInt32)
+	}
+
+	return nil
+}
+
+// DefaultGasRegisterParams returns the wasmd gas register defaults with the
+// Juno instance and compile costs.
+func DefaultGasRegisterParams() GasRegisterParams {
+	cfg := wasmtypes.DefaultGasRegisterConfig()
+
+	return GasRegisterParams{
+		InstanceCost:               DefaultInstanceCost,
+		CompileCost:                DefaultCompileCost,
+		UncompressCostNumerator:    cfg.UncompressCost.Numerator,
+		UncompressCostDenominator:  cfg.UncompressCost.Denominator,
+		ContractMessageDataCost:    cfg.ContractMessageDataCost,
+		EventPerAttributeCost:      cfg.EventPerAttributeCost,
+		EventAttributeDataCost:     cfg.EventAttributeDataCost,
+		EventAttributeDataFreeTier: cfg.EventAttributeDataFreeTier,
+		CustomEventCost:            cfg.CustomEventCost,
+	}
+}
+
+// Validate performs basic validation of the gas register params.
+func (p GasRegisterParams) Validate() error {
+	if p.UncompressCostDenominator == 0 {
+		return ErrInvalidGasRegister.Wrap("uncompress cost denominator cannot be zero")
+	}
+
+	return nil
+}
+
+// WasmGasRegisterConfig returns the wasmd gas register config of the params.
+// The gas multiplier between the SDK and the wasm VM is not configurable.
+func (p GasRegisterParams) WasmGasRegisterConfig() wasmtypes.WasmGasRegisterConfig {
+	cfg := wasmtypes.DefaultGasRegisterConfig()
+	cfg.InstanceCost = p.InstanceCost
+	cfg.CompileCost = p.CompileCost
+	cfg.UncompressCost = wasmvmtypes.UFraction{
+		Numerator:   p.UncompressCostNumerator,
+		Denominator: p.UncompressCostDenominator,
+	}
+	cfg.ContractMessageDataCost = p.ContractMessageDataCost
+	cfg.EventPerAttributeCost = p.EventPerAttributeCost
+	cfg.EventAttributeDataCost = p.EventAttributeDataCost
+	cfg.EventAttributeDataFreeTier = p.EventAttributeDataFreeTier
+	cfg.CustomEventCost = p.CustomEventCost
+
+	return cfg
+}
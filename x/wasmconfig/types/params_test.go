@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	zeroDenominator := DefaultGasRegisterParams()
+	zeroDenominator.UncompressCostDenominator = 0
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid: zero costs", NewParams(GasRegisterParams{UncompressCostDenominator: 1}, DefaultMaxWasmSize, DefaultMaxProposalWasmSize, DefaultMaxLabelSize), false},
+		{"invalid: zero uncompress cost denominator", NewParams(zeroDenominator, DefaultMaxWasmSize, DefaultMaxProposalWasmSize, DefaultMaxLabelSize), true},
+		{"invalid: zero max wasm size", NewParams(DefaultGasRegisterParams(), 0, DefaultMaxProposalWasmSize, DefaultMaxLabelSize), true},
+		{"invalid: zero max proposal wasm size", NewParams(DefaultGasRegisterParams(), DefaultMaxWasmSize, 0, DefaultMaxLabelSize), true},
+		{"invalid: zero max label size", NewParams(DefaultGasRegisterParams(), DefaultMaxWasmSize, DefaultMaxProposalWasmSize, 0), true},
+		{"invalid: max wasm size overflows", NewParams(DefaultGasRegisterParams(), math.MaxInt32+1, DefaultMaxProposalWasmSize, DefaultMaxLabelSize), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestDefaultGasRegisterParams(t *testing.T) {
+	require.Equal(t, wasmtypes.DefaultGasRegisterConfig(), DefaultGasRegisterParams().WasmGasRegisterConfig())
+}
+
+func TestDefaultWasmLimits(t *testing.T) {
+	// the wasmd globals are only changed by the params
+	require.Equal(t, int(DefaultMaxWasmSize), wasmtypes.MaxWasmSize)
+	require.Equal(t, int(DefaultMaxProposalWasmSize), wasmtypes.MaxProposalWasmSize)
+	require.Equal(t, int(DefaultMaxLabelSize), wasmtypes.MaxLabelSize)
+}
+
+func TestGasRegisterSetParams(t *testing.T) {
+	register := NewGasRegister(DefaultGasRegisterParams())
+	require.Equal(t, DefaultInstanceCost, register.NewContractInstanceCosts(false, 0))
+	require.Equal(t, 3*DefaultCompileCost, register.CompileCosts(3))
+
+	params := DefaultGasRegisterParams()
+	params.InstanceCost = 80_000
+	params.CompileCost = 5
+	register.SetParams(params)
+
+	require.Equal(t, uint64(80_000), register.NewContractInstanceCosts(false, 0))
+	require.Equal(t, uint64(15), register.CompileCosts(3))
+	// the multiplier between SDK and wasm VM gas is kept
+	require.Equal(t, wasmtypes.DefaultGasMultiplier, register.ToWasmVMGas(1))
+}
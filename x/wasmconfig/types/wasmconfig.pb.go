@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: juno/wasmconfig/v1/wasmconfig.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the wasmconfig module params
+type Params struct {
+	// gas_register defines the gas charged by x/wasm on top of the contract
+	// execution
+	GasRegister GasRegisterParams `protobuf:"bytes,1,opt,name=gas_register,json=gasRegister,proto3" json:"gas_register"`
+	// max_wasm_size is the largest a contract code can be when stored with
+	// MsgStoreCode, in bytes
+	MaxWasmSize uint64 `protobuf:"varint,2,opt,name=max_wasm_size,json=maxWasmSize,proto3" json:"max_wasm_size,omitempty"`
+	// max_proposal_wasm_size is the largest a contract code can be when stored
+	// through governance, in bytes
+	MaxProposalWasmSize uint64 `protobuf:"varint,3,opt,name=max_proposal_wasm_size,json=maxProposalWasmSize,proto3" json:"max_proposal_wasm_size,omitempty"`
+	// max_label_size is the longest label a contract can be instantiated with
+	MaxLabelSize uint64 `protobuf:"varint,4,opt,name=max_label_size,json=maxLabelSize,proto3" json:"max_label_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc95c3ac2bed252, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGasRegister() GasRegisterParams {
+	if m != nil {
+		return m.GasRegister
+	}
+	return GasRegisterParams{}
+}
+
+func (m *Params) GetMaxWasmSize() uint64 {
+	if m != nil {
+		return m.MaxWasmSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProposalWasmSize() uint64 {
+	if m != nil {
+		return m.MaxProposalWasmSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLabelSize() uint64 {
+	if m != nil {
+		return m.MaxLabelSize
+	}
+	return 0
+}
+
+// GasRegisterParams defines the costs of the x/wasm gas register, in SDK gas
+type GasRegisterParams struct {
+	// instance_cost is charged when interacting with a contract which is not
+	// pinned
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty"`
+	// compile_cost is charged per byte to persist and compile a contract
+	CompileCost uint64 `protobuf:"varint,2,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty"`
+	// uncompress_cost_numerator and uncompress_cost_denominator define the cost
+	// per byte to unpack a gzipped contract
+	UncompressCostNumerator   uint64 `protobuf:"varint,3,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty"`
+	UncompressCostDenominator uint64 `protobuf:"varint,4,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty"`
+	// contract_message_data_cost is charged per byte of the message sent to a
+	// contract
+	ContractMessageDataCost uint64 `protobuf:"varint,5,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty"`
+	// event_per_attribute_cost is charged per attribute of the events emitted by
+	// a contract
+	EventPerAttributeCost uint64 `protobuf:"varint,6,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty"`
+	// event_attribute_data_cost is charged per byte of the attributes emitted by
+	// a contract, above the free tier
+	EventAttributeDataCost uint64 `protobuf:"varint,7,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty"`
+	// event_attribute_data_free_tier is the number of attribute bytes which are
+	// free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,8,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty"`
+	// custom_event_cost is charged per custom event emitted by a contract
+	CustomEventCost uint64 `protobuf:"varint,9,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty"`
+}
+
+func (m *GasRegisterParams) Reset()         { *m = GasRegisterParams{} }
+func (m *GasRegisterParams) String() string { return proto.CompactTextString(m) }
+func (*GasRegisterParams) ProtoMessage()    {}
+func (*GasRegisterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfc95c3ac2bed252, []int{1}
+}
+func (m *GasRegisterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasRegisterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRegisterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasRegisterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRegisterParams.Merge(m, src)
+}
+func (m *GasRegisterParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasRegisterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRegisterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRegisterParams proto.InternalMessageInfo
+
+func (m *GasRegisterParams) GetInstanceCost() uint64 {
+	if m != nil {
+		return m.InstanceCost
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetCompileCost() uint64 {
+	if m != nil {
+		return m.CompileCost
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetUncompressCostNumerator() uint64 {
+	if m != nil {
+		return m.UncompressCostNumerator
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetUncompressCostDenominator() uint64 {
+	if m != nil {
+		return m.UncompressCostDenominator
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetContractMessageDataCost() uint64 {
+	if m != nil {
+		return m.ContractMessageDataCost
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetEventPerAttributeCost() uint64 {
+	if m != nil {
+		return m.EventPerAttributeCost
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetEventAttributeDataCost() uint64 {
+	if m != nil {
+		return m.EventAttributeDataCost
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetEventAttributeDataFreeTier() uint64 {
+	if m != nil {
+		return m.EventAttributeDataFreeTier
+	}
+	return 0
+}
+
+func (m *GasRegisterParams) GetCustomEventCost() uint64 {
+	if m != nil {
+		return m.CustomEventCost
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "juno.wasmconfig.v1.Params")
+	proto.RegisterType((*GasRegisterParams)(nil), "juno.wasmconfig.v1.GasRegisterParams")
+}
+
+func init() {
+	proto.RegisterFile("juno/wasmconfig/v1/wasmconfig.proto", fileDescriptor_dfc95c3ac2bed252)
+}
+
+var fileDescriptor_dfc95c3ac2bed252 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0x86, 0x33, 0x5f, 0xf3, 0x05, 0x70, 0x52, 0x50, 0x07, 0x28, 0x49, 0x90, 0x86, 0x92, 0x82,
+	0x54, 0xb1, 0x48, 0xd4, 0x76, 0x81, 0x00, 0x09, 0x89, 0xa4, 0xc0, 0xa6, 0x54, 0x51, 0x40, 0x42,
+	0x62, 0x63, 0x9d, 0x4c, 0x4f, 0x07, 0xa3, 0x78, 0x3c, 0xf2, 0xf1, 0x84, 0xd0, 0xab, 0xe0, 0xb2,
+	0xba, 0xec, 0x0e, 0x56, 0x08, 0x25, 0x37, 0xc1, 0x12, 0xd9, 0x9e, 0xfc, 0x40, 0xd8, 0x25, 0xef,
+	0xfb, 0x3c, 0x3e, 0xc7, 0x23, 0x99, 0xed, 0x7e, 0xca, 0x53, 0xd5, 0xf9, 0x0c, 0x24, 0x63, 0x95,
+	0x9e, 0x89, 0xa4, 0x33, 0xde, 0x5f, 0xf9, 0xd7, 0xce, 0xb4, 0x32, 0x2a, 0x0c, 0x2d, 0xd4, 0x5e,
+	0x89, 0xc7, 0xfb, 0xcd, 0x5b, 0x89, 0x4a, 0x94, 0xab, 0x3b, 0xf6, 0x97, 0x27, 0x5b, 0xdf, 0x02,
+	0x56, 0xe9, 0x83, 0x06, 0x49, 0xe1, 0x09, 0xab, 0x25, 0x40, 0x5c, 0x63, 0x22, 0xc8, 0xa0, 0xae,
+	0x07, 0x3b, 0xc1, 0x5e, 0xf5, 0xe0, 0x61, 0x7b, 0xfd, 0xac, 0xf6, 0x6b, 0xa0, 0x41, 0x81, 0x79,
+	0xb9, 0x5b, 0xbe, 0xf8, 0x71, 0xaf, 0x34, 0xa8, 0x26, 0xcb, 0x22, 0x6c, 0xb1, 0x4d, 0x09, 0x13,
+	0x6e, 0x4d, 0x4e, 0xe2, 0x1c, 0xeb, 0xff, 0xed, 0x04, 0x7b, 0xe5, 0x41, 0x55, 0xc2, 0xe4, 0x3d,
+	0x90, 0x7c, 0x2b, 0xce, 0x31, 0x3c, 0x64, 0xdb, 0x96, 0xc9, 0xb4, 0xca, 0x14, 0xc1, 0x68, 0x05,
+	0xde, 0x70, 0xf0, 0x4d, 0x09, 0x93, 0x7e, 0x51, 0x2e, 0xa4, 0x07, 0xec, 0xba, 0x95, 0x46, 0x30,
+	0xc4, 0x91, 0x87, 0xcb, 0x0e, 0xae, 0x49, 0x98, 0x1c, 0xdb, 0xd0, 0x52, 0xad, 0x5f, 0x1b, 0x6c,
+	0x6b, 0x6d, 0xcf, 0x70, 0x97, 0x6d, 0x8a, 0x94, 0x0c, 0xa4, 0x31, 0xf2, 0x58, 0x91, 0x71, 0xb7,
+	0x2c, 0x0f, 0x6a, 0xf3, 0xb0, 0xa7, 0xc8, 0x84, 0xf7, 0x59, 0x2d, 0x56, 0x32, 0x13, 0xa3, 0x82,
+	0x29, 0x16, 0x2f, 0x32, 0x87, 0x3c, 0x65, 0x8d, 0x3c, 0xb5, 0x81, 0x46, 0x22, 0x47, 0xf1, 0x34,
+	0x97, 0xa8, 0xc1, 0x28, 0x5d, 0xec, 0x7e, 0x67, 0x09, 0x58, 0xe5, 0x64, 0x5e, 0x87, 0xcf, 0xd9,
+	0xdd, 0xbf, 0xdd, 0x53, 0x4c, 0x95, 0x14, 0xa9, 0xb3, 0xfd, 0x65, 0x1a, 0x7f, 0xda, 0x47, 0x4b,
+	0x20, 0x7c, 0xc6, 0x9a, 0xb1, 0x4a, 0x8d, 0x86, 0xd8, 0x70, 0x89, 0x44, 0x90, 0x20, 0x3f, 0x05,
+	0x03, 0x7e, 0xd9, 0xff, 0xfd, 0xf0, 0x39, 0xf1, 0xc6, 0x03, 0x47, 0x60, 0xc0, 0x2d, 0xfe, 0x98,
+	0xd5, 0x71, 0x8c, 0xa9, 0xe1, 0x19, 0x6a, 0x0e, 0xc6, 0x68, 0x31, 0xcc, 0x4d, 0x71, 0xcf, 0x8a,
+	0x53, 0x6f, 0xbb, 0xbe, 0x8f, 0xfa, 0xc5, 0xbc, 0x75, 0xe2, 0x13, 0xd6, 0xf0, 0xe2, 0x52, 0x5a,
+	0x0e, 0xbd, 0xe2, 0xcc, 0x6d, 0x07, 0x2c, 0xb4, 0xc5, 0xcc, 0x2e, 0x8b, 0xfe, 0xa9, 0x9e, 0x69,
+	0x44, 0x6e, 0x04, 0xea, 0xfa, 0x55, 0xe7, 0x37, 0xd7, 0xfd, 0x57, 0x1a, 0xf1, 0x9d, 0x40, 0x1d,
+	0x3e, 0x62, 0x5b, 0x71, 0x4e, 0x46, 0x49, 0xee, 0x8f, 0x72, 0x63, 0xaf, 0x39, 0xed, 0x86, 0x2f,
+	0x5e, 0xda, 0xdc, 0xce, 0xeb, 0x1e, 0x5f, 0x4c, 0xa3, 0xe0, 0x72, 0x1a, 0x05, 0x3f, 0xa7, 0x51,
+	0xf0, 0x75, 0x16, 0x95, 0x2e, 0x67, 0x51, 0xe9, 0xfb, 0x2c, 0x2a, 0x7d, 0x38, 0x48, 0x84, 0xf9,
+	0x98, 0x0f, 0xdb, 0xb1, 0x92, 0x9d, 0x9e, 0x22, 0xa9, 0xa8, 0x57, 0x7c, 0x27, 0xea, 0xb8, 0x87,
+	0x35, 0x59, 0x7d, 0x5a, 0xe6, 0x4b, 0x86, 0x34, 0xac, 0xb8, 0x97, 0x72, 0xf8, 0x7b, 0x00, 0x5b,
+	0xd7, 0x4a, 0xaf, 0x7a, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLabelSize != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.MaxLabelSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxProposalWasmSize != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.MaxProposalWasmSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxWasmSize != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.MaxWasmSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GasRegister.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWasmconfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasRegisterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRegisterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRegisterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CustomEventCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.EventAttributeDataFreeTier))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintWasmconfig(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasmconfig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasmconfig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasRegister.Size()
+	n += 1 + l + sovWasmconfig(uint64(l))
+	if m.MaxWasmSize != 0 {
+		n += 1 + sovWasmconfig(uint64(m.MaxWasmSize))
+	}
+	if m.MaxProposalWasmSize != 0 {
+		n += 1 + sovWasmconfig(uint64(m.MaxProposalWasmSize))
+	}
+	if m.MaxLabelSize != 0 {
+		n += 1 + sovWasmconfig(uint64(m.MaxLabelSize))
+	}
+	return n
+}
+
+func (m *GasRegisterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.InstanceCost))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovWasmconfig(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovWasmconfig(uint64(m.UncompressCostDenominator))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.ContractMessageDataCost))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovWasmconfig(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovWasmconfig(uint64(m.CustomEventCost))
+	}
+	return n
+}
+
+func sovWasmconfig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWasmconfig(x uint64) (n int) {
+	return sovWasmconfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRegister", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasmconfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasmconfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRegister.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWasmSize", wireType)
+			}
+			m.MaxWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalWasmSize", wireType)
+			}
+			m.MaxProposalWasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalWasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelSize", wireType)
+			}
+			m.MaxLabelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmconfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasRegisterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasmconfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasRegisterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasRegisterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
+			}
+			m.CustomEventCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomEventCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasmconfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasmconfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWasmconfig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWasmconfig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWasmconfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWasmconfig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWasmconfig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWasmconfig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWasmconfig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWasmconfig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWasmconfig = fmt.Errorf("proto: unexpected end of group")
+)